@@ -0,0 +1,228 @@
+package account
+
+import (
+	"api/crypto"
+	"api/mailer"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"log"
+	"time"
+)
+
+type MailTokenPurpose string
+
+const (
+	VerifyEmailPurpose   MailTokenPurpose = "verify_email"
+	ResetPasswordPurpose MailTokenPurpose = "reset_password"
+)
+
+// Время жизни одноразовых ссылок из писем, переопределяется из config.toml в main.
+var (
+	VerifyEmailTokenTTL   = 24 * time.Hour
+	ResetPasswordTokenTTL = time.Hour
+)
+
+var (
+	ErrInvalidEmail    = errors.New("invalid email")
+	ErrEmailTaken      = errors.New("email taken")
+	ErrInvalidPassword = errors.New("invalid password")
+)
+
+// MailToken - одноразовый токен из письма. Как и Token, хранится только его SHA-256.
+type MailToken struct {
+	TokenHash  string           `db:"token_hash"`
+	AccountID  int32            `db:"account_id"`
+	Purpose    MailTokenPurpose `db:"purpose"`
+	Email      string           `db:"email"`
+	ExpireTime time.Time        `db:"expire_time"`
+}
+
+func GetByEmail(email string) (*Account, error) {
+	var account Account
+	err := DB.Get(&account, "SELECT * FROM account WHERE email=$1", email)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// ExistsEmail сообщает, что адрес уже привязан к другому аккаунту. В account.email попадают
+// только подтверждённые адреса, см. RequestEmailVerification.
+func ExistsEmail(email string, exceptID int32) (bool, error) {
+	var exists bool
+	err := DB.Get(&exists, "SELECT EXISTS(SELECT 1 FROM account WHERE email=$1 AND id<>$2)", email, exceptID)
+	return exists, err
+}
+
+// issueMailToken выдаёт новый токен и отзывает прежние токены того же назначения.
+func (a *Account) issueMailToken(purpose MailTokenPurpose, email string, ttl time.Duration) (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := DB.Beginx()
+	if err != nil {
+		return "", err
+	}
+	if _, err := tx.Exec("DELETE FROM mail_token WHERE account_id=$1 AND purpose=$2", a.ID, purpose); err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+	_, err = tx.Exec("INSERT INTO mail_token (token_hash, account_id, purpose, email, expire_time) VALUES ($1, $2, $3, $4, $5)",
+		hashToken(token), a.ID, purpose, email, time.Now().UTC().Add(ttl))
+	if err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+	return token, tx.Commit()
+}
+
+// consumeMailToken удаляет токен при первом же использовании, повторно его предъявить нельзя.
+func consumeMailToken(token string, purpose MailTokenPurpose) (*Account, *MailToken, error) {
+	var t MailToken
+	err := DB.Get(&t, "DELETE FROM mail_token WHERE token_hash=$1 AND purpose=$2 RETURNING *", hashToken(token), purpose)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !t.ExpireTime.After(time.Now().UTC()) {
+		return nil, nil, ErrInvalidToken
+	}
+
+	account, err := GetByID(t.AccountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, &t, nil
+}
+
+// RequestEmailVerification отправляет на адрес ссылку для подтверждения. До подтверждения адрес
+// хранится только в mail_token: иначе чужой адрес можно было бы занять, не владея им.
+func (a *Account) RequestEmailVerification(email string) error {
+	if !crypto.ValidateEmail(email) {
+		return ErrInvalidEmail
+	}
+	taken, err := ExistsEmail(email, a.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrEmailTaken
+	}
+
+	token, err := a.issueMailToken(VerifyEmailPurpose, email, VerifyEmailTokenTTL)
+	if err != nil {
+		return err
+	}
+	return mailer.Send(mailer.Message{
+		To:      email,
+		Subject: "Подтверждение электронной почты",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\nЧтобы подтвердить адрес, перейдите по ссылке:\n%s\n\nСсылка действует %s.",
+			a.Username, mailer.Link("verify-email", token), VerifyEmailTokenTTL),
+	})
+}
+
+// PendingEmail возвращает адрес, ждущий подтверждения, nil - такого нет.
+func (a *Account) PendingEmail() (*string, error) {
+	var email string
+	err := DB.Get(&email, "SELECT email FROM mail_token WHERE account_id=$1 AND purpose=$2", a.ID, VerifyEmailPurpose)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &email, nil
+}
+
+// VerifyEmail подтверждает адрес по токену из письма. Если адрес успели подтвердить
+// в другом аккаунте, возвращается ErrEmailTaken. При смене адреса старый получает уведомление.
+func VerifyEmail(token string) (*Account, error) {
+	account, t, err := consumeMailToken(token, VerifyEmailPurpose)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEmail := account.Email
+	if _, err := DB.Exec("UPDATE account SET email=$1, email_verified=true WHERE id=$2", t.Email, account.ID); err != nil {
+		return nil, emailErr(err)
+	}
+	account.Email = &t.Email
+	account.EmailVerified = true
+
+	if oldEmail != nil && *oldEmail != t.Email {
+		notify(*oldEmail, "Адрес электронной почты изменён",
+			fmt.Sprintf("Здравствуйте, %s!\n\nАдрес электронной почты аккаунта изменён на %s.", account.Username, t.Email))
+	}
+	return account, nil
+}
+
+// RequestPasswordReset отправляет ссылку для сброса пароля. Отсутствие аккаунта с таким
+// подтверждённым адресом не считается ошибкой, чтобы по ответу нельзя было перебирать адреса.
+func RequestPasswordReset(email string) error {
+	var account Account
+	err := DB.Get(&account, "SELECT * FROM account WHERE email=$1 AND email_verified", email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := account.issueMailToken(ResetPasswordPurpose, email, ResetPasswordTokenTTL)
+	if err != nil {
+		return err
+	}
+	return mailer.Send(mailer.Message{
+		To:      email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\nСсылка действует %s. Если вы не запрашивали сброс, просто проигнорируйте это письмо.",
+			account.Username, mailer.Link("reset-password", token), ResetPasswordTokenTTL),
+	})
+}
+
+// ResetPassword задаёт новый пароль по токену из письма и завершает все сессии аккаунта.
+func ResetPassword(token, newPassword string) (*Account, error) {
+	// Слабый пароль не должен сжигать одноразовую ссылку
+	if !crypto.ValidatePassword(newPassword) {
+		return nil, ErrInvalidPassword
+	}
+
+	account, t, err := consumeMailToken(token, ResetPasswordPurpose)
+	if err != nil {
+		return nil, err
+	}
+	if err := account.storePassword(newPassword); err != nil {
+		return nil, err
+	}
+	if err := account.RevokeTokens(); err != nil {
+		return nil, err
+	}
+
+	notify(t.Email, "Пароль изменён",
+		fmt.Sprintf("Здравствуйте, %s!\n\nПароль вашего аккаунта был изменён. Если это были не вы, сбросьте пароль ещё раз.", account.Username))
+	return account, nil
+}
+
+// notify отправляет уведомление, ошибка отправки не отменяет уже выполненное действие.
+func notify(email, subject, body string) {
+	if err := mailer.Send(mailer.Message{To: email, Subject: subject, Body: body}); err != nil {
+		log.Printf("Failed to send mail to %s: %v", email, err)
+	}
+}
+
+func emailErr(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrEmailTaken
+	}
+	return err
+}
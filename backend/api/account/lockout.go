@@ -0,0 +1,141 @@
+package account
+
+import (
+	"api/audit"
+	"api/limiter"
+	"api/utils"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Счётчики неудачных проверок пароля по аккаунту и по IP клиента. У IP порог выше:
+// за одним адресом может сидеть целый класс. main заменяет их на limiter.PostgresLimiter,
+// если экземпляров API несколько.
+var (
+	AccountLimiter limiter.Limiter = limiter.NewMemoryLimiter(limiter.DefaultPolicy)
+	IPLimiter      limiter.Limiter = limiter.NewMemoryLimiter(IPPolicy)
+)
+
+var IPPolicy = limiter.Policy{
+	FreeAttempts: 20,
+	BaseDelay:    limiter.DefaultPolicy.BaseDelay,
+	MaxDelay:     limiter.DefaultPolicy.MaxDelay,
+	Window:       limiter.DefaultPolicy.Window,
+}
+
+type lockoutKey struct {
+	limiter limiter.Limiter
+	key     string
+}
+
+func lockoutKeys(c *gin.Context, account *Account) []lockoutKey {
+	keys := []lockoutKey{{IPLimiter, "ip:" + c.ClientIP()}}
+	if account != nil {
+		keys = append(keys, lockoutKey{AccountLimiter, accountLockoutKey(account)})
+	}
+	return keys
+}
+
+func accountLockoutKey(account *Account) string {
+	return fmt.Sprintf("account:%d", account.ID)
+}
+
+func tooManyAttempts(c *gin.Context, retryAfter time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Too many attempts"})
+}
+
+// checkLockout отвечает 429, если аккаунт или IP клиента заблокированы. account может быть nil.
+func checkLockout(c *gin.Context, account *Account) bool {
+	for _, k := range lockoutKeys(c, account) {
+		retryAfter, err := k.limiter.Check(k.key)
+		if err != nil {
+			utils.InternalErr(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+			return false
+		}
+		if retryAfter > 0 {
+			tooManyAttempts(c, retryAfter)
+			return false
+		}
+	}
+	return true
+}
+
+// passwordFailed учитывает неудачную попытку и пишет её в журнал аудита, как и начало блокировки.
+func passwordFailed(c *gin.Context, account *Account, reason string) {
+	var accountID *int32
+	if account != nil {
+		accountID = &account.ID
+	}
+	ip := c.ClientIP()
+
+	audit.Record(audit.LoginFailed, accountID, ip, reason)
+	for _, k := range lockoutKeys(c, account) {
+		delay, err := k.limiter.Fail(k.key)
+		if err != nil {
+			utils.InternalErr(err)
+			continue
+		}
+		if delay > 0 {
+			audit.Record(audit.LoginLocked, accountID, ip, fmt.Sprintf("%s locked for %s", k.key, delay))
+		}
+	}
+}
+
+func passwordSucceeded(account *Account) {
+	// Счётчик IP не сбрасывается: иначе один свой аккаунт позволял бы перебирать чужие
+	if err := AccountLimiter.Reset(accountLockoutKey(account)); err != nil {
+		utils.InternalErr(err)
+	}
+}
+
+// verifyPasswordLimited проверяет пароль с учётом блокировок. ok=false означает,
+// что ответ уже записан (429 или 500) и обработчик должен просто вернуться.
+func verifyPasswordLimited(c *gin.Context, account *Account, password string) (valid bool, ok bool) {
+	valid, ok = checkPassword(c, account, password)
+	if valid {
+		passwordSucceeded(account)
+	}
+	return valid, ok
+}
+
+// verifyLogin проверяет пароль и, если он включён, второй фактор. Счётчик неудач сбрасывается
+// только после обоих: иначе знающий пароль мог бы перебирать коды без блокировки.
+// При false ответ уже записан.
+func verifyLogin(c *gin.Context, account *Account, password, code string) bool {
+	valid, ok := checkPassword(c, account, password)
+	if !ok {
+		return false
+	}
+	if !valid {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+		return false
+	}
+	if !requireSecondFactor(c, account, code) {
+		return false
+	}
+	passwordSucceeded(account)
+	return true
+}
+
+func checkPassword(c *gin.Context, account *Account, password string) (valid bool, ok bool) {
+	if !checkLockout(c, account) {
+		return false, false
+	}
+
+	valid, err := account.VerifyPassword(password)
+	if err != nil {
+		utils.InternalErr(err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return false, false
+	}
+	if !valid {
+		passwordFailed(c, account, "invalid password")
+	}
+	return valid, true
+}
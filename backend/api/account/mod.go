@@ -1,6 +1,7 @@
 package account
 
 import (
+	"api/authz"
 	"api/crypto"
 	"api/media"
 	"errors"
@@ -18,6 +19,7 @@ const (
 	Student Type = "student"
 	Parent  Type = "parent"
 	Teacher Type = "teacher"
+	Admin   Type = authz.AdminRole
 )
 
 type Error struct {
@@ -55,6 +57,8 @@ type Account struct {
 	PasswordHash       string     `db:"password_hash"`
 	ProfilePictureUUID *uuid.UUID `db:"profile_picture_uuid"` // sql.NullString
 	AccountType        string     `db:"account_type"`
+	Email              *string    `db:"email"`
+	EmailVerified      bool       `db:"email_verified"`
 }
 
 // DB Глобальная переменная для подключения к БД (аналог POOL в Rust).
@@ -145,6 +149,8 @@ func (t Type) String() string {
 		return "parent"
 	case Teacher:
 		return "teacher"
+	case Admin:
+		return "admin"
 	default:
 		return "unknown"
 	}
@@ -192,7 +198,10 @@ func New(username, password string, accountType Type, profilePicture *media.NewM
 		return nil, errors.New("username taken")
 	}
 
-	passwordHash := crypto.EncodePassword(password)
+	passwordHash, err := crypto.EncodePassword(password)
+	if err != nil {
+		return nil, err
+	}
 
 	var profilePictureUUID *uuid.UUID
 	if profilePicture != nil {
@@ -211,7 +220,8 @@ func New(username, password string, accountType Type, profilePicture *media.NewM
 		AccountType:        accountType.String(),
 		ProfilePictureUUID: profilePictureUUID,
 	}
-	_, err := DB.NamedExec("INSERT INTO account (username, password_hash, account_type, profile_picture_uuid) VALUES (:username, :password_hash, :account_type, :profile_picture_uuid)", account)
+	err = DB.QueryRow("INSERT INTO account (username, password_hash, account_type, profile_picture_uuid) VALUES ($1, $2, $3, $4) RETURNING id",
+		account.Username, account.PasswordHash, account.AccountType, account.ProfilePictureUUID).Scan(&account.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -223,16 +233,26 @@ func New(username, password string, accountType Type, profilePicture *media.NewM
 }
 
 func (a *Account) UpdatePassword(newPassword string) (bool, error) {
-	log.Printf("Attempting to validate password: '%s'", newPassword)
 	if !crypto.ValidatePassword(newPassword) {
-		log.Printf("Validation failed for password: '%s'", newPassword)
 		return false, nil
 	}
 
-	passwordHash := crypto.EncodePassword(newPassword)
+	return true, a.storePassword(newPassword)
+}
+
+// storePassword перехеширует пароль без проверки сложности: старые пароли могли быть
+// заданы до появления ValidatePassword.
+func (a *Account) storePassword(password string) error {
+	passwordHash, err := crypto.EncodePassword(password)
+	if err != nil {
+		return err
+	}
 	row := DB.QueryRow("UPDATE account SET password_hash=$1 WHERE id=$2 RETURNING password_hash", passwordHash, a.ID)
-	err := row.Scan(&a.PasswordHash)
-	return err == nil, err
+	return row.Scan(&a.PasswordHash)
+}
+
+func (a *Account) IsAdmin() bool {
+	return a.AccountType == Admin.String()
 }
 
 func (a *Account) UpdateAccountType(newAccountType Type) error {
@@ -291,13 +311,18 @@ func (a *Account) Delete() error {
 	return nil
 }
 
+// VerifyPassword проверяет пароль и при успехе прозрачно переводит хеш на текущие параметры KDF.
 func (a *Account) VerifyPassword(password string) (bool, error) {
-	isValid := crypto.VerifyPassword(password, a.PasswordHash)
+	if !crypto.VerifyPassword(password, a.PasswordHash) {
+		return false, nil
+	}
 
-	if isValid {
-		_, updateErr := a.UpdatePassword(password)
-		return updateErr == nil, updateErr
+	if crypto.NeedsRehash(a.PasswordHash) {
+		// Неудачное перехеширование не должно мешать входу, повторим при следующем
+		if err := a.storePassword(password); err != nil {
+			log.Printf("Failed to rehash password for account %d: %v", a.ID, err)
+		}
 	}
 
-	return false, nil
+	return true, nil
 }
@@ -1,15 +1,20 @@
 package account
 
 import (
+	"api/authz"
 	"api/config"
+	"api/crypto"
 	"api/media"
 	"api/utils"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 func MediaToGetMediaData(mediaInstance *media.Media) *media.GetMediaData {
@@ -25,36 +30,88 @@ func MediaToGetMediaData(mediaInstance *media.Media) *media.GetMediaData {
 func accountInfo(c *gin.Context) {
 	c.String(200, `
 enum AccountType ( "Student", "Parent", "Teacher" )
+Тип "admin" назначает только администратор через PATCH /account/<id>, администратор может действовать над любым ресурсом.
+Запрещённое действие возвращает 403 { "error": "Forbidden" }.
 
 GET /account/<id> - get account data by id
 GET /account/<username> - get account data by username
 
+POST /account/login - get access and refresh tokens
+username: String - required
+password: String - required
+code: String - required if two-factor authentication is enabled, TOTP or a recovery code
+Без кода при включённой 2FA ответ 401 { "error": ..., "two_factor_required": true }.
+После нескольких неверных паролей подряд аккаунт и IP временно блокируются:
+/account/verify, /account/login и смена пароля отвечают 429 с заголовком Retry-After.
+
+POST /account/refresh - exchange a refresh token for a new token pair
+refresh_token: String - required
+
+POST /account/logout - revoke tokens of the current session
+Authorization: Bearer <access_token> - required
+
 POST /account - create an account
 username: String - required
 password: String - required
 account_type: AccountType - required
+email: String - optional, a confirmation link is sent to it
 profile_picture: {
 	data: String - required
 	media_type: MediaType - required
 } - optional
 
 PATCH /account/<id> - update account data
-password: String - required
+Authorization: Bearer <access_token> - required
+password: String - required with new_password
 new_password: String - optional
 new_account_type: AccountType - optional
+new_email: String - optional, changed after confirmation by the link sent to it
 new_profile_picture: {
 	data: String - required
 	media_type: MediaType - required
 } - optional
 
 DELETE /account/<id> - delete account
+Authorization: Bearer <access_token> - required
+
+POST /account/2fa - start two-factor enrollment (teacher accounts), returns secret and otpauth_uri
+Authorization: Bearer <access_token> - required
+
+POST /account/2fa/confirm - enable two-factor authentication, returns one-time recovery_codes
+Authorization: Bearer <access_token> - required
+code: String - required
+409 if two-factor authentication is already enabled or was enrolled again with another secret meanwhile
+
+DELETE /account/2fa - disable two-factor authentication
+Authorization: Bearer <access_token> - required
 password: String - required
+code: String - required, TOTP or a recovery code
+
+POST /account/email/resend - send the confirmation link again
+Authorization: Bearer <access_token> - required
+
+POST /account/email/verify - confirm an email by the token from the link
+token: String - required
+
+POST /account/password/reset - send a password reset link to a confirmed email
+email: String - required
+
+POST /account/password/reset/confirm - set a new password by the token from the link
+token: String - required
+new_password: String - required
 
 GET /account/<id>/classes - get student/teacher account classes
-password: String - required
+Authorization: Bearer <access_token> - required
 
 GET /account/<id>/assignments - get student assignments
-password: String - required
+Authorization: Bearer <access_token> - required
+Returns: Vector of { id, qwiz_id, class_id, open_time, close_time, pass_threshold, max_attempts - null if unlimited,
+	score_policy: best/last/average, duration: Integer - seconds per attempt or null, late_penalty: Float - null if late
+	submissions are rejected, completed }
+
+GET /account/<id>/attempts?<page> - qwiz attempts of the account, newest first, 50 per page from 0
+Authorization: Bearer <access_token> - required, the account itself or its linked parent
+Returns: Vector of Attempt (see GET /qwiz/attempts)
 `)
 }
 
@@ -142,6 +199,7 @@ func getAccount(c *gin.Context) {
 type VerifyPasswordData struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Code     string `json:"code"` // TOTP или код восстановления, если включена 2FA
 }
 
 func verifyPassword(c *gin.Context) {
@@ -150,22 +208,88 @@ func verifyPassword(c *gin.Context) {
 		c.JSON(400, gin.H{"error": "Bad Request"})
 		return
 	}
+	if !checkLockout(c, nil) {
+		return
+	}
 	account, err := GetByUsername(data.Username)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			passwordFailed(c, nil, "unknown username "+data.Username)
+		}
 		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": err.Error()})
 		return
 	}
-	isValid, err := account.VerifyPassword(data.Password)
+	if verifyLogin(c, account, data.Password, data.Code) {
+		c.Status(http.StatusOK)
+	}
+}
+
+func login(c *gin.Context) {
+	var data VerifyPasswordData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
+		return
+	}
+	if !checkLockout(c, nil) {
+		return
+	}
+	account, err := GetByUsername(data.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			passwordFailed(c, nil, "unknown username "+data.Username)
+		}
+		c.JSON(utils.DbErrToStatus(err, http.StatusUnauthorized), gin.H{"error": "Invalid credentials"})
+		return
+	}
+	if !verifyLogin(c, account, data.Password, data.Code) {
+		return
+	}
+
+	tokens, err := account.IssueTokens()
 	if err != nil {
 		utils.InternalErr(err)
-		c.JSON(500, gin.H{"error": "Internal Server Error"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
 		return
 	}
-	if isValid {
-		c.Status(http.StatusOK)
-	} else {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+	c.JSON(http.StatusOK, tokens)
+}
+
+type RefreshTokenData struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func refreshTokens(c *gin.Context) {
+	var data RefreshTokenData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
+		return
 	}
+
+	tokens, err := Refresh(data.RefreshToken)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		} else {
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+func logout(c *gin.Context) {
+	session, ok := currentSession(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	if err := RevokeSession(session); err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+	c.Status(http.StatusOK)
 }
 
 type PostAccountData struct {
@@ -173,6 +297,7 @@ type PostAccountData struct {
 	Password       string              `json:"password"`
 	AccountType    string              `json:"account_type"`
 	ProfilePicture *media.NewMediaData `json:"profile_picture"`
+	Email          *string             `json:"email"`
 }
 
 func createAccount(c *gin.Context) {
@@ -194,6 +319,22 @@ func createAccount(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный тип аккаунта"})
 		return
 	}
+	if data.Email != nil {
+		if !crypto.ValidateEmail(*data.Email) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidEmail.Error()})
+			return
+		}
+		taken, err := ExistsEmail(*data.Email, 0)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+			utils.InternalErr(err)
+			return
+		}
+		if taken {
+			c.JSON(http.StatusConflict, gin.H{"error": ErrEmailTaken.Error()})
+			return
+		}
+	}
 	account, err := New(data.Username, data.Password, accountType, data.ProfilePicture)
 	if err != nil {
 		utils.InternalErr(err)
@@ -201,6 +342,13 @@ func createAccount(c *gin.Context) {
 		return
 	}
 
+	// Аккаунт уже создан, письмо можно запросить повторно через /account/email/resend
+	if data.Email != nil {
+		if err := account.RequestEmailVerification(*data.Email); err != nil {
+			utils.InternalErr(err)
+		}
+	}
+
 	c.JSON(201, gin.H{"location": fmt.Sprintf("%s/account/%d", config.BaseURL, account.ID)})
 }
 
@@ -208,6 +356,7 @@ type PatchAccountData struct {
 	Password          string              `json:"password"`
 	NewPassword       *string             `json:"new_password"`
 	NewAccountType    *Type               `json:"new_account_type"`
+	NewEmail          *string             `json:"new_email"`
 	NewProfilePicture *media.NewMediaData `json:"new_profile_picture"`
 }
 
@@ -226,32 +375,51 @@ func updateAccount(c *gin.Context) {
 
 	account, err := GetByID(int32(id))
 	if err != nil {
-		utils.DbErrToStatus(err, http.StatusNotFound)
-		c.JSON(404, gin.H{"error": "Account not found"})
-		return
-	}
-	if account == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Аккаунт не найден"})
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Account not found"})
 		return
 	}
+	caller := Current(c)
 
-	isValid, err := account.VerifyPassword(data.Password)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(500, gin.H{"error": "Internal server error"}) // Or another appropriate error message
-		return
-	}
-	if !isValid {
-		c.JSON(401, gin.H{"error": "Unauthorized"})
+	// Назначить администратора может только администратор
+	if data.NewAccountType != nil && strings.EqualFold(string(*data.NewAccountType), Admin.String()) && !caller.IsAdmin() {
+		authz.Forbidden(c)
 		return
 	}
 
 	if data.NewPassword != nil {
+		// Смена своего пароля по-прежнему требует текущий пароль, одного токена недостаточно.
+		if caller.ID == account.ID {
+			isValid, ok := verifyPasswordLimited(c, account, data.Password)
+			if !ok {
+				return
+			}
+			if !isValid {
+				c.JSON(401, gin.H{"error": "Unauthorized"})
+				return
+			}
+		}
+
 		success, err := account.UpdatePassword(*data.NewPassword)
 		if err != nil || !success {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось обновить пароль"})
 			return
 		}
+		if err := account.RevokeTokens(); err != nil {
+			utils.InternalErr(err)
+			c.JSON(500, gin.H{"error": "Failed to revoke tokens"})
+			return
+		}
+		if account.Email != nil && account.EmailVerified {
+			notify(*account.Email, "Пароль изменён",
+				fmt.Sprintf("Здравствуйте, %s!\n\nПароль вашего аккаунта был изменён.", account.Username))
+		}
+	}
+
+	if data.NewEmail != nil {
+		if err := account.RequestEmailVerification(*data.NewEmail); err != nil {
+			c.JSON(emailErrToStatus(err), gin.H{"error": err.Error()})
+			return
+		}
 	}
 
 	if data.NewAccountType != nil {
@@ -276,48 +444,206 @@ func updateAccount(c *gin.Context) {
 	c.JSON(200, gin.H{"message": "Account updated successfully"})
 }
 
-type DeleteAccountData struct {
-	Password string `json:"password"`
+func deleteAccount(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(400, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	account, err := GetByID(int32(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Account not found"})
+		return
+	}
+
+	if err := account.Delete(); err != nil {
+		utils.InternalErr(err)
+		c.JSON(500, gin.H{"error": "Failed to delete account"})
+		return
+	}
+
+	c.JSON(200, gin.H{"message": "Account deleted successfully"})
 }
 
-func deleteAccount(c *gin.Context) {
-	var data DeleteAccountData
+type TwoFactorCodeData struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type DeleteTwoFactorData struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+func enrollTwoFactor(c *gin.Context) {
+	account := Current(c)
+	tf, err := account.EnrollTwoFactor()
+	if errors.Is(err, ErrTwoFactorEnabled) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"secret": tf.Secret, "otpauth_uri": tf.URI(account.Username)})
+}
+
+func confirmTwoFactor(c *gin.Context) {
+	var data TwoFactorCodeData
 	if err := c.ShouldBindJSON(&data); err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
 		return
 	}
 
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		c.JSON(400, gin.H{"error": "Invalid ID"})
+	codes, err := Current(c).ConfirmTwoFactor(data.Code)
+	switch {
+	case errors.Is(err, ErrInvalidCode):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid two-factor code"})
+	case errors.Is(err, ErrTwoFactorNotEnabled):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Two-factor enrollment not started"})
+	case errors.Is(err, ErrTwoFactorEnabled), errors.Is(err, ErrTwoFactorChanged):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case err != nil:
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"recovery_codes": codes})
+	}
+}
+
+func disableTwoFactor(c *gin.Context) {
+	var data DeleteTwoFactorData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
 		return
 	}
 
-	account, err := GetByID(int32(id))
+	account := Current(c)
+	enabled, err := account.TwoFactorEnabled()
 	if err != nil {
 		utils.InternalErr(err)
-		c.JSON(404, gin.H{"error": "Account not found"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+	if !enabled {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrTwoFactorNotEnabled.Error()})
+		return
+	}
+	// Украденного токена недостаточно: нужны пароль и второй фактор
+	if !verifyLogin(c, account, data.Password, data.Code) {
 		return
 	}
 
-	isValid, err := account.VerifyPassword(data.Password)
+	if err := account.DisableTwoFactor(); err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+func emailErrToStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrInvalidEmail):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrEmailTaken):
+		return http.StatusConflict
+	default:
+		return utils.InternalErr(err)
+	}
+}
+
+func resendVerification(c *gin.Context) {
+	account := Current(c)
+	pending, err := account.PendingEmail()
 	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
 		utils.InternalErr(err)
-		c.JSON(500, gin.H{"error": "Internal server error"}) // Or another appropriate error message
 		return
 	}
-	if !isValid {
-		c.JSON(401, gin.H{"error": "Unauthorized"})
+	if pending == nil {
+		if account.Email != nil && account.EmailVerified {
+			c.JSON(http.StatusConflict, gin.H{"error": "Email already verified"})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "No email"})
+		}
 		return
 	}
+	if err := account.RequestEmailVerification(*pending); err != nil {
+		c.JSON(emailErrToStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
 
-	if err := account.Delete(); err != nil {
+type MailTokenData struct {
+	Token string `json:"token" binding:"required"`
+}
+
+func verifyEmail(c *gin.Context) {
+	var data MailTokenData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
+		return
+	}
+
+	if _, err := VerifyEmail(data.Token); err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+		} else {
+			c.JSON(emailErrToStatus(err), gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Email verified"})
+}
+
+type PasswordResetData struct {
+	Email string `json:"email" binding:"required"`
+}
+
+func requestPasswordReset(c *gin.Context) {
+	var data PasswordResetData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
+		return
+	}
+
+	if err := RequestPasswordReset(data.Email); err != nil {
 		utils.InternalErr(err)
-		c.JSON(500, gin.H{"error": "Failed to delete account"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
 		return
 	}
+	// Ответ не зависит от того, есть ли аккаунт с таким адресом
+	c.Status(http.StatusAccepted)
+}
 
-	c.JSON(200, gin.H{"message": "Account deleted successfully"})
+type PasswordResetConfirmData struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+func confirmPasswordReset(c *gin.Context) {
+	var data PasswordResetConfirmData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad Request"})
+		return
+	}
+
+	_, err := ResetPassword(data.Token, data.NewPassword)
+	switch {
+	case errors.Is(err, ErrInvalidPassword):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось обновить пароль"})
+	case errors.Is(err, ErrInvalidToken):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+	case err != nil:
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"message": "Password updated"})
+	}
 }
 
 // RegisterRoutes добавляет маршруты модуля account к роутеру Gin.
@@ -327,8 +653,18 @@ func RegisterRoutes(r *gin.Engine) {
 		accountGroup.GET("", accountInfo)              // Получение информации об аккаунтах
 		accountGroup.GET("/:accountParam", getAccount) // Получение аккаунта
 		accountGroup.POST("/verify", verifyPassword)
-		accountGroup.POST("", createAccount)       // Создание аккаунта
-		accountGroup.PATCH("/:id", updateAccount)  // Обновление аккаунта
-		accountGroup.DELETE("/:id", deleteAccount) // Удаление аккаунта
+		accountGroup.POST("/login", login)                  // Выдача токенов
+		accountGroup.POST("/refresh", refreshTokens)        // Обновление токенов
+		accountGroup.POST("/logout", RequireAuth(), logout) // Отзыв токенов сессии
+		accountGroup.POST("/2fa", RequireAuth(), authz.Require(authz.Role(Teacher.String())), enrollTwoFactor)
+		accountGroup.POST("/2fa/confirm", RequireAuth(), confirmTwoFactor)
+		accountGroup.DELETE("/2fa", RequireAuth(), disableTwoFactor)
+		accountGroup.POST("/email/resend", RequireAuth(), resendVerification)
+		accountGroup.POST("/email/verify", verifyEmail)
+		accountGroup.POST("/password/reset", requestPasswordReset)
+		accountGroup.POST("/password/reset/confirm", confirmPasswordReset)
+		accountGroup.POST("", createAccount)                                                                    // Создание аккаунта
+		accountGroup.PATCH("/:id", RequireAuth(), authz.Require(authz.Self(authz.Param("id"))), updateAccount)  // Обновление аккаунта
+		accountGroup.DELETE("/:id", RequireAuth(), authz.Require(authz.Self(authz.Param("id"))), deleteAccount) // Удаление аккаунта
 	}
 }
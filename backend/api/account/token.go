@@ -0,0 +1,249 @@
+package account
+
+import (
+	"api/authz"
+	"api/utils"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type TokenKind string
+
+const (
+	AccessToken  TokenKind = "access"
+	RefreshToken TokenKind = "refresh"
+)
+
+// Время жизни токенов, переопределяется из config.toml в main.
+var (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Ключи, под которыми RequireAuth кладёт данные в gin.Context.
+const (
+	accountContextKey = "account"
+	sessionContextKey = "session"
+)
+
+// Token хранит только SHA-256 от выданного токена, сам токен знает лишь клиент.
+type Token struct {
+	TokenHash   string    `db:"token_hash"`
+	SessionUUID uuid.UUID `db:"session_uuid"`
+	AccountID   int32     `db:"account_id"`
+	Kind        string    `db:"kind"`
+	ExpireTime  time.Time `db:"expire_time"`
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// IssueTokens открывает новую сессию и выдаёт для неё пару access/refresh токенов.
+func (a *Account) IssueTokens() (*TokenPair, error) {
+	return a.issueTokens(uuid.New())
+}
+
+func (a *Account) issueTokens(session uuid.UUID) (*TokenPair, error) {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	pair, err := insertTokens(tx, a.ID, session)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// insertTokens записывает новую пару токенов сессии в транзакции tx.
+func insertTokens(tx *sqlx.Tx, accountID int32, session uuid.UUID) (*TokenPair, error) {
+	accessToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	const query = `INSERT INTO token (token_hash, session_uuid, account_id, kind, expire_time) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.Exec(query, hashToken(accessToken), session, accountID, AccessToken, now.Add(AccessTokenTTL)); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(query, hashToken(refreshToken), session, accountID, RefreshToken, now.Add(RefreshTokenTTL)); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(AccessTokenTTL / time.Second),
+	}, nil
+}
+
+// GetByToken возвращает владельца действующего токена указанного вида.
+func GetByToken(token string, kind TokenKind) (*Account, *Token, error) {
+	var t Token
+	err := DB.Get(&t, "SELECT * FROM token WHERE token_hash=$1 AND kind=$2 AND expire_time > (now() AT TIME ZONE 'UTC')", hashToken(token), kind)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := GetByID(t.AccountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, &t, nil
+}
+
+// Refresh обменивает refresh-токен на новую пару в той же сессии, старая пара отзывается.
+// Токен удаляется в той же транзакции, что выдаёт новую пару, поэтому из двух одновременных
+// запросов с одним токеном новую пару получит только один, второй - ErrInvalidToken.
+func Refresh(refreshToken string) (*TokenPair, error) {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var t Token
+	err = tx.Get(&t, `DELETE FROM token WHERE token_hash=$1 AND kind=$2 AND expire_time > (now() AT TIME ZONE 'UTC')
+		RETURNING *`, hashToken(refreshToken), RefreshToken)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec("DELETE FROM token WHERE session_uuid=$1", t.SessionUUID); err != nil {
+		return nil, err
+	}
+
+	pair, err := insertTokens(tx, t.AccountID, t.SessionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// RevokeSession отзывает все токены одной сессии (выход из аккаунта).
+func RevokeSession(session uuid.UUID) error {
+	_, err := DB.Exec("DELETE FROM token WHERE session_uuid=$1", session)
+	return err
+}
+
+// RevokeTokens отзывает все токены аккаунта во всех сессиях.
+func (a *Account) RevokeTokens() error {
+	_, err := DB.Exec("DELETE FROM token WHERE account_id=$1", a.ID)
+	return err
+}
+
+// RequireAuth проверяет заголовок "Authorization: Bearer <token>" и кладёт аккаунт в контекст.
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			return
+		}
+		authenticate(c)
+	}
+}
+
+// OptionalAuth пропускает анонимные запросы, но при наличии заголовка требует валидный токен.
+func OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
+
+func authenticate(c *gin.Context) {
+	token, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !found || token == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	account, t, err := GetByToken(token, AccessToken)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		} else {
+			utils.InternalErr(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.Set(accountContextKey, account)
+	c.Set(sessionContextKey, t.SessionUUID)
+	authz.SetSubject(c, authz.Subject{ID: account.ID, Role: account.AccountType})
+	c.Next()
+}
+
+// Current возвращает аккаунт, установленный RequireAuth, или nil для анонимного запроса.
+func Current(c *gin.Context) *Account {
+	value, exists := c.Get(accountContextKey)
+	if !exists {
+		return nil
+	}
+	account, _ := value.(*Account)
+	return account
+}
+
+func currentSession(c *gin.Context) (uuid.UUID, bool) {
+	value, exists := c.Get(sessionContextKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+	session, ok := value.(uuid.UUID)
+	return session, ok
+}
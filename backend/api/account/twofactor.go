@@ -0,0 +1,247 @@
+package account
+
+import (
+	"api/crypto"
+	"api/utils"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TOTPIssuer - имя сервиса в приложении-аутентификаторе.
+var TOTPIssuer = "Qwiz"
+
+const recoveryCodeCount = 10
+
+var (
+	ErrTwoFactorEnabled    = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication not enabled")
+	ErrInvalidCode         = errors.New("invalid code")
+	// ErrTwoFactorChanged - пока подтверждался код, 2FA включили или начали заново с другим секретом
+	ErrTwoFactorChanged = errors.New("two-factor enrollment changed")
+)
+
+// TwoFactor - секрет TOTP аккаунта. До подтверждения первым кодом Enabled=false и вход его не требует.
+type TwoFactor struct {
+	AccountID    int32     `db:"account_id"`
+	Secret       string    `db:"secret"`
+	Enabled      bool      `db:"enabled"`
+	LastUsedStep int64     `db:"last_used_step"`
+	CreateTime   time.Time `db:"create_time"`
+}
+
+func (a *Account) getTwoFactor() (*TwoFactor, error) {
+	var tf TwoFactor
+	err := DB.Get(&tf, "SELECT * FROM two_factor WHERE account_id=$1", a.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+// TwoFactorEnabled сообщает, требует ли вход второй фактор.
+func (a *Account) TwoFactorEnabled() (bool, error) {
+	var enabled bool
+	err := DB.Get(&enabled, "SELECT EXISTS(SELECT 1 FROM two_factor WHERE account_id=$1 AND enabled)", a.ID)
+	return enabled, err
+}
+
+// EnrollTwoFactor создаёт новый секрет. Неподтверждённый секрет перезаписывается.
+func (a *Account) EnrollTwoFactor() (*TwoFactor, error) {
+	secret, err := crypto.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var tf TwoFactor
+	err = DB.Get(&tf, `
+		INSERT INTO two_factor (account_id, secret, enabled, last_used_step, create_time) VALUES ($1, $2, false, 0, $3)
+		ON CONFLICT (account_id) DO UPDATE SET secret=EXCLUDED.secret, last_used_step=0, create_time=EXCLUDED.create_time
+		WHERE NOT two_factor.enabled
+		RETURNING *
+	`, a.ID, secret, time.Now().UTC())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTwoFactorEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+func (tf *TwoFactor) URI(accountName string) string {
+	return crypto.TOTPURI(TOTPIssuer, accountName, tf.Secret)
+}
+
+// useTOTP принимает код не старше последнего использованного, чтобы перехваченный код нельзя было повторить.
+func (tf *TwoFactor) useTOTP(code string) (bool, error) {
+	step, ok := crypto.VerifyTOTP(tf.Secret, code, time.Now())
+	if !ok || step <= tf.LastUsedStep {
+		return false, nil
+	}
+	result, err := DB.Exec("UPDATE two_factor SET last_used_step=$1 WHERE account_id=$2 AND last_used_step<$1", step, tf.AccountID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	tf.LastUsedStep = step
+	return rows == 1, nil
+}
+
+// ConfirmTwoFactor включает 2FA первым кодом из приложения и возвращает одноразовые коды восстановления.
+// Коды показываются только один раз, в базе хранится их SHA-256. Включается только секрет,
+// к которому подошёл код: если его успели заменить, возвращается ErrTwoFactorChanged.
+func (a *Account) ConfirmTwoFactor(code string) ([]string, error) {
+	tf, err := a.getTwoFactor()
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tf.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	valid, err := tf.useTOTP(code)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, ErrInvalidCode
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		if codes[i], err = generateRecoveryCode(); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.Exec("UPDATE two_factor SET enabled=true WHERE account_id=$1 AND NOT enabled AND secret=$2", a.ID, tf.Secret)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err == nil && rows == 0 {
+		err = ErrTwoFactorChanged
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if _, err := tx.Exec("DELETE FROM recovery_code WHERE account_id=$1", a.ID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	for _, code := range codes {
+		if _, err := tx.Exec("INSERT INTO recovery_code (account_id, code_hash) VALUES ($1, $2)", a.ID, hashToken(normalizeRecoveryCode(code))); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	return codes, tx.Commit()
+}
+
+// DisableTwoFactor удаляет секрет и коды восстановления.
+func (a *Account) DisableTwoFactor() error {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM recovery_code WHERE account_id=$1", a.ID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM two_factor WHERE account_id=$1", a.ID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// VerifySecondFactor принимает код TOTP или один из кодов восстановления (он сгорает).
+func (a *Account) VerifySecondFactor(code string) (bool, error) {
+	tf, err := a.getTwoFactor()
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return false, err
+	}
+	if !tf.Enabled {
+		return false, ErrTwoFactorNotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if valid, err := tf.useTOTP(code); err != nil || valid {
+		return valid, err
+	}
+
+	result, err := DB.Exec("DELETE FROM recovery_code WHERE account_id=$1 AND code_hash=$2", a.ID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	return rows == 1, err
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCode возвращает код вида xxxxx-xxxxx.
+func generateRecoveryCode() (string, error) {
+	bytes := make([]byte, 7)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryEncoding.EncodeToString(bytes))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
+
+// requireSecondFactor вызывается после верного пароля. Если у аккаунта включена 2FA,
+// без верного кода отвечает 401 с two_factor_required. Неверный код считается неудачной попыткой входа.
+func requireSecondFactor(c *gin.Context, account *Account, code string) bool {
+	enabled, err := account.TwoFactorEnabled()
+	if err != nil {
+		utils.InternalErr(err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return false
+	}
+	if !enabled {
+		return true
+	}
+	if code == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Two-factor code required", "two_factor_required": true})
+		return false
+	}
+
+	valid, err := account.VerifySecondFactor(code)
+	if err != nil {
+		utils.InternalErr(err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+		return false
+	}
+	if !valid {
+		passwordFailed(c, account, "invalid two-factor code")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid two-factor code", "two_factor_required": true})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+package assignment
+
+import (
+	"encoding/csv"
+	"github.com/lib/pq"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GradebookAssignment - задание в журнале класса.
+type GradebookAssignment struct {
+	ID        int32      `db:"id"`
+	QwizID    int32      `db:"qwiz_id"`
+	QwizName  string     `db:"qwiz_name"`
+	OpenTime  *time.Time `db:"open_time"`
+	CloseTime *time.Time `db:"close_time"`
+}
+
+// GradebookCell - успехи ученика по одному заданию. BestScore - лучшая доля от максимума баллов
+// среди отправленных попыток, nil - отправленных нет. SubmitTime - последняя отправка.
+type GradebookCell struct {
+	AssignmentID int32
+	Completed    bool
+	BestScore    *float64
+	Attempts     int32
+	SubmitTime   *time.Time
+}
+
+type GradebookStudent struct {
+	ID       int32  `db:"id"`
+	Username string `db:"username"`
+	// Cells - по одной на задание, в порядке Gradebook.Assignments
+	Cells []GradebookCell
+}
+
+// Gradebook - журнал класса: ученики по алфавиту и задания по порядку выдачи.
+type Gradebook struct {
+	Assignments []GradebookAssignment
+	Students    []GradebookStudent
+}
+
+// GetGradebook собирает журнал класса. from и to (nil - без границы) отбирают задания,
+// открытые хотя бы часть промежутка [from, to).
+func GetGradebook(classID int32, from, to *time.Time) (*Gradebook, error) {
+	g := &Gradebook{Assignments: []GradebookAssignment{}, Students: []GradebookStudent{}}
+	err := DB.Select(&g.Assignments, `
+		SELECT a.id, a.qwiz_id, q.name AS qwiz_name, a.open_time, a.close_time
+		FROM assignment a JOIN qwiz q ON q.id=a.qwiz_id
+		WHERE a.class_id=$1 AND ($2::timestamp IS NULL OR a.close_time IS NULL OR a.close_time >= $2)
+			AND ($3::timestamp IS NULL OR a.open_time IS NULL OR a.open_time < $3)
+		ORDER BY a.id
+	`, classID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	err = DB.Select(&g.Students, `SELECT a.id, a.username FROM student s JOIN account a ON a.id=s.student_id
+		WHERE s.class_id=$1 ORDER BY a.username, a.id`, classID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(pq.Int32Array, len(g.Assignments))
+	column := make(map[int32]int, len(g.Assignments))
+	for i, a := range g.Assignments {
+		ids[i] = a.ID
+		column[a.ID] = i
+	}
+	row := make(map[int32]int, len(g.Students))
+	for i := range g.Students {
+		row[g.Students[i].ID] = i
+		g.Students[i].Cells = make([]GradebookCell, len(g.Assignments))
+		for j, a := range g.Assignments {
+			g.Students[i].Cells[j].AssignmentID = a.ID
+		}
+	}
+	cell := func(studentID, assignmentID int32) *GradebookCell {
+		i, ok := row[studentID]
+		if !ok {
+			// Ученик уже не в классе
+			return nil
+		}
+		return &g.Students[i].Cells[column[assignmentID]]
+	}
+
+	var stats []struct {
+		AssignmentID int32      `db:"assignment_id"`
+		StudentID    int32      `db:"student_id"`
+		Attempts     int32      `db:"attempts"`
+		BestScore    *float64   `db:"best_score"`
+		SubmitTime   *time.Time `db:"submit_time"`
+	}
+	err = DB.Select(&stats, `
+		SELECT assignment_id, account_id AS student_id, count(*) AS attempts,
+			max(CASE WHEN max_score > 0 THEN score / max_score ELSE 1 END) FILTER (WHERE submit_time IS NOT NULL) AS best_score,
+			max(submit_time) AS submit_time
+		FROM attempt WHERE assignment_id = ANY($1) AND account_id IS NOT NULL
+		GROUP BY assignment_id, account_id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stats {
+		if c := cell(s.StudentID, s.AssignmentID); c != nil {
+			c.Attempts, c.BestScore, c.SubmitTime = s.Attempts, s.BestScore, s.SubmitTime
+		}
+	}
+
+	var completed []struct {
+		AssignmentID int32 `db:"assignment_id"`
+		StudentID    int32 `db:"student_id"`
+	}
+	err = DB.Select(&completed, "SELECT assignment_id, student_id FROM completed_assignment WHERE assignment_id = ANY($1)", ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, done := range completed {
+		if c := cell(done.StudentID, done.AssignmentID); c != nil {
+			c.Completed = true
+		}
+	}
+	return g, nil
+}
+
+// csvText экранирует текст, который электронная таблица иначе приняла бы за формулу.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// WriteCSV выгружает журнал по строке на пару ученик-задание: такую таблицу проще
+// свести в электронной таблице, чем широкую. Время - RFC 3339 в UTC. Имена учеников
+// и квизов, начинающиеся с = + - @, получают префикс ' и не исполняются как формулы.
+func (g *Gradebook) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	err := out.Write([]string{"student_id", "username", "assignment_id", "qwiz_name", "completed", "best_score", "attempts", "submit_time"})
+	if err != nil {
+		return err
+	}
+	for _, s := range g.Students {
+		for i, c := range s.Cells {
+			var bestScore, submitTime string
+			if c.BestScore != nil {
+				bestScore = strconv.FormatFloat(*c.BestScore, 'f', -1, 64)
+			}
+			if c.SubmitTime != nil {
+				submitTime = c.SubmitTime.UTC().Format(time.RFC3339)
+			}
+			err := out.Write([]string{
+				strconv.Itoa(int(s.ID)), csvText(s.Username), strconv.Itoa(int(c.AssignmentID)), csvText(g.Assignments[i].QwizName),
+				strconv.FormatBool(c.Completed), bestScore, strconv.Itoa(int(c.Attempts)), submitTime,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
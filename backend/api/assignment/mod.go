@@ -2,30 +2,206 @@ package assignment
 
 import (
 	"api/optbool"
+	"database/sql"
+	"errors"
 	"github.com/jmoiron/sqlx"
 	"time"
 )
 
+// DefaultPassThreshold - какую долю баллов нужно набрать без задания: все.
+const DefaultPassThreshold = 1.0
+
+// DurationGrace - запас к Duration на задержку между нажатием "отправить" и приходом запроса.
+var DurationGrace = 5 * time.Second
+
+// ScorePolicy - какой из отправленных попыток засчитывается результат задания.
+type ScorePolicy string
+
+const (
+	ScoreBest    ScorePolicy = "best"
+	ScoreLast    ScorePolicy = "last"
+	ScoreAverage ScorePolicy = "average"
+)
+
+var (
+	ErrNotOpen        = errors.New("assignment is not open yet")
+	ErrClosed         = errors.New("assignment is closed")
+	ErrNoAttemptsLeft = errors.New("no attempts left")
+	ErrTimeOver       = errors.New("attempt time is over")
+)
+
+// ParseScorePolicy принимает название политики, пустая строка - ScoreBest.
+func ParseScorePolicy(name string) (ScorePolicy, error) {
+	switch ScorePolicy(name) {
+	case "", ScoreBest:
+		return ScoreBest, nil
+	case ScoreLast, ScoreAverage:
+		return ScorePolicy(name), nil
+	default:
+		return "", &Error{Message: "invalid score policy"}
+	}
+}
+
+// Error - недопустимые настройки задания.
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NewAssignmentData - задание, которое учитель выдаёт классу. Время - в миллисекундах Unix,
+// остальные поля как в Assignment; nil - без ограничения, PassThreshold nil - DefaultPassThreshold.
+type NewAssignmentData struct {
+	QwizID        int         `json:"qwiz_id"`
+	ClassID       int         `json:"class_id"`
+	OpenTime      *int64      `json:"open_time"`
+	CloseTime     *int64      `json:"close_time"`
+	PassThreshold *float64    `json:"pass_threshold"`
+	MaxAttempts   *int32      `json:"max_attempts"`
+	ScorePolicy   ScorePolicy `json:"score_policy"`
+	Duration      *int32      `json:"duration"`
+	LatePenalty   *float64    `json:"late_penalty"`
+}
+
 type Assignment struct {
-	ID        int             `db:"id"`
-	QwizID    int             `db:"qwiz_id"`
-	ClassID   int             `db:"class_id"`
-	OpenTime  *time.Time      `db:"open_time"`
-	CloseTime *time.Time      `db:"close_time"`
-	Completed optbool.OptBool `db:"completed"`
+	ID        int        `db:"id"`
+	QwizID    int        `db:"qwiz_id"`
+	ClassID   int        `db:"class_id"`
+	OpenTime  *time.Time `db:"open_time"`
+	CloseTime *time.Time `db:"close_time"`
+	// PassThreshold - доля от максимума баллов (от 0 до 1), с которой задание считается выполненным
+	PassThreshold float64 `db:"pass_threshold"`
+	// MaxAttempts - сколько попыток можно начать, nil - без ограничения
+	MaxAttempts *int32      `db:"max_attempts"`
+	ScorePolicy ScorePolicy `db:"score_policy"`
+	// Duration - сколько секунд даётся на попытку с её начала, nil - без ограничения
+	Duration *int32 `db:"duration"`
+	// LatePenalty - доля баллов, которая снимается за отправку после CloseTime; nil - после срока не принимается
+	LatePenalty *float64        `db:"late_penalty"`
+	Completed   optbool.OptBool `db:"completed"`
 }
 
+// GetByID загружает задание. Выполнено ли оно, зависит от ученика - см. CompletedBy.
 func GetByID(id int) (*Assignment, error) {
-	const query = `
-		SELECT *, 
-		EXISTS(SELECT * FROM completed_assignment WHERE assignment_id=id AND student_id=$1) AS completed 
-		FROM assignment WHERE id=$1
-	`
+	const query = `SELECT *, false AS completed FROM assignment WHERE id=$1`
 	a := &Assignment{}
 	err := DB.Get(a, query, id)
 	return a, err
 }
 
+// GetAllByClassID возвращает задания класса, Completed - выполнено ли каждое учеником studentID.
+func GetAllByClassID(classID int, studentID int32) ([]*Assignment, error) {
+	const query = `
+		SELECT *,
+		EXISTS(SELECT * FROM completed_assignment WHERE assignment_id=id AND student_id=$2) AS completed
+		FROM assignment WHERE class_id=$1
+		ORDER BY id
+	`
+	assignments := []*Assignment{}
+	err := DB.Select(&assignments, query, classID, studentID)
+	return assignments, err
+}
+
+// FromAssignmentData выдаёт задание классу. Права учителя на класс и викторину проверяет вызывающий.
+func FromAssignmentData(data *NewAssignmentData) (*Assignment, error) {
+	a := &Assignment{
+		QwizID:        data.QwizID,
+		ClassID:       data.ClassID,
+		OpenTime:      millisToTime(data.OpenTime),
+		CloseTime:     millisToTime(data.CloseTime),
+		PassThreshold: DefaultPassThreshold,
+		MaxAttempts:   data.MaxAttempts,
+		Duration:      data.Duration,
+		LatePenalty:   data.LatePenalty,
+	}
+	if data.PassThreshold != nil {
+		a.PassThreshold = *data.PassThreshold
+	}
+	policy, err := ParseScorePolicy(string(data.ScorePolicy))
+	if err != nil {
+		return nil, err
+	}
+	a.ScorePolicy = policy
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	err = DB.Get(a, `
+		INSERT INTO assignment (qwiz_id, class_id, open_time, close_time, pass_threshold, max_attempts, score_policy,
+			duration, late_penalty)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING *, false AS completed
+	`, a.QwizID, a.ClassID, a.OpenTime, a.CloseTime, a.PassThreshold, a.MaxAttempts, a.ScorePolicy, a.Duration,
+		a.LatePenalty)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Update записывает изменённые сроки и настройки задания. Класс и викторина не меняются.
+// Уже отправленные попытки не пересчитываются.
+func (a *Assignment) Update() error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	_, err := DB.Exec(`
+		UPDATE assignment SET open_time=$1, close_time=$2, pass_threshold=$3, max_attempts=$4, score_policy=$5,
+			duration=$6, late_penalty=$7
+		WHERE id=$8
+	`, a.OpenTime, a.CloseTime, a.PassThreshold, a.MaxAttempts, a.ScorePolicy, a.Duration, a.LatePenalty, a.ID)
+	return err
+}
+
+// Delete отменяет задание. Попытки, решённые в его рамках, остаются в истории без задания.
+func (a *Assignment) Delete() error {
+	_, err := DB.Exec("DELETE FROM assignment WHERE id=$1", a.ID)
+	return err
+}
+
+// CompletedBy сообщает, выполнил ли задание ученик studentID.
+func (a *Assignment) CompletedBy(studentID int32) (bool, error) {
+	var completed bool
+	err := DB.Get(&completed, "SELECT EXISTS(SELECT 1 FROM completed_assignment WHERE assignment_id=$1 AND student_id=$2)",
+		a.ID, studentID)
+	return completed, err
+}
+
+// validate повторяет ограничения таблицы assignment, чтобы ответить понятной ошибкой.
+func (a *Assignment) validate() error {
+	switch {
+	case a.OpenTime != nil && a.CloseTime != nil && !a.OpenTime.Before(*a.CloseTime):
+		return &Error{Message: "open_time must be before close_time"}
+	case a.PassThreshold < 0 || a.PassThreshold > 1:
+		return &Error{Message: "pass_threshold must be between 0 and 1"}
+	case a.MaxAttempts != nil && *a.MaxAttempts <= 0:
+		return &Error{Message: "max_attempts must be positive"}
+	case a.Duration != nil && *a.Duration <= 0:
+		return &Error{Message: "duration must be positive"}
+	case a.LatePenalty != nil && (*a.LatePenalty < 0 || *a.LatePenalty > 1):
+		return &Error{Message: "late_penalty must be between 0 and 1"}
+	}
+	return nil
+}
+
+func millisToTime(millis *int64) *time.Time {
+	if millis == nil {
+		return nil
+	}
+	t := time.UnixMilli(*millis).UTC()
+	return &t
+}
+
+func timeToMillis(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	millis := t.UnixMilli()
+	return &millis
+}
+
 func GetAllByStudentID(studentID int) ([]*Assignment, error) {
 	const query = `
 		SELECT *, 
@@ -38,27 +214,121 @@ func GetAllByStudentID(studentID int) ([]*Assignment, error) {
 	return assignments, err
 }
 
-func (a *Assignment) CompleteByStudentID(studentID int) (bool, error) {
-	now := time.Now()
+// CheckStart проверяет, может ли ученик начать попытку в момент now: задание открыто (или принимает
+// работы после срока) и попытки не исчерпаны. Окончательно число попыток проверяет ReserveAttempt
+// при записи попытки.
+func (a *Assignment) CheckStart(studentID int32, now time.Time) error {
+	if a.OpenTime != nil && a.OpenTime.After(now) {
+		return ErrNotOpen
+	}
+	if a.CloseTime != nil && a.CloseTime.Before(now) && a.LatePenalty == nil {
+		return ErrClosed
+	}
+	if a.MaxAttempts == nil {
+		return nil
+	}
+	return a.checkAttemptsLeft(DB, *a.MaxAttempts, studentID)
+}
 
-	if a.CloseTime != nil && a.CloseTime.Before(now) {
-		return false, nil
+// ReserveAttempt блокирует задание до конца транзакции tx и проверяет, что у ученика остались попытки.
+// Попытка записывается в той же tx: иначе параллельные начала проходили проверку вместе.
+func (a *Assignment) ReserveAttempt(tx *sqlx.Tx, studentID int32) error {
+	var maxAttempts *int32
+	if err := tx.Get(&maxAttempts, "SELECT max_attempts FROM assignment WHERE id=$1 FOR UPDATE", a.ID); err != nil {
+		return err
 	}
-	if a.OpenTime != nil && a.OpenTime.After(now) {
-		return false, nil
+	if maxAttempts == nil {
+		return nil
 	}
+	return a.checkAttemptsLeft(tx, *maxAttempts, studentID)
+}
 
-	const query = `
-		INSERT INTO completed_assignment (assignment_id, student_id) VALUES ($1, $2)
-		ON CONFLICT (assignment_id, student_id) DO NOTHING
-	`
-	_, err := DB.Exec(query, a.ID, studentID)
+func (a *Assignment) checkAttemptsLeft(db sqlx.Queryer, maxAttempts, studentID int32) error {
+	var count int32
+	err := sqlx.Get(db, &count, "SELECT count(*) FROM attempt WHERE assignment_id=$1 AND account_id=$2", a.ID, studentID)
 	if err != nil {
-		return false, err
+		return err
 	}
+	if count >= maxAttempts {
+		return ErrNoAttemptsLeft
+	}
+	return nil
+}
 
-	a.Completed.Value = true
-	return true, nil
+// Deadline - до какого момента принимается попытка, начатая в startTime: по Duration и, если работы
+// после срока не принимаются, по CloseTime. nil - без ограничения.
+func (a *Assignment) Deadline(startTime time.Time) *time.Time {
+	var deadline *time.Time
+	if a.Duration != nil {
+		t := startTime.Add(time.Duration(*a.Duration) * time.Second)
+		deadline = &t
+	}
+	if a.CloseTime != nil && a.LatePenalty == nil && (deadline == nil || a.CloseTime.Before(*deadline)) {
+		deadline = a.CloseTime
+	}
+	return deadline
+}
+
+// SubmitPenalty проверяет отправку в момент now попытки, начатой в startTime, и возвращает штраф -
+// долю баллов, которая снимается за опоздание.
+func (a *Assignment) SubmitPenalty(startTime, now time.Time) (float64, error) {
+	if a.OpenTime != nil && a.OpenTime.After(now) {
+		return 0, ErrNotOpen
+	}
+	if a.Duration != nil && now.After(startTime.Add(time.Duration(*a.Duration)*time.Second+DurationGrace)) {
+		return 0, ErrTimeOver
+	}
+	if a.CloseTime == nil || !a.CloseTime.Before(now) {
+		return 0, nil
+	}
+	if a.LatePenalty == nil {
+		return 0, ErrClosed
+	}
+	return *a.LatePenalty, nil
+}
+
+// StudentScore - результат ученика по заданию: доля от максимума баллов среди отправленных попыток
+// по ScorePolicy. nil - отправленных попыток нет.
+func (a *Assignment) StudentScore(studentID int32) (*float64, error) {
+	const fraction = "CASE WHEN max_score > 0 THEN score / max_score ELSE 1 END"
+	const submitted = "FROM attempt WHERE assignment_id=$1 AND account_id=$2 AND submit_time IS NOT NULL"
+	var query string
+	switch a.ScorePolicy {
+	case ScoreLast:
+		query = "SELECT " + fraction + " " + submitted + " ORDER BY submit_time DESC LIMIT 1"
+	case ScoreAverage:
+		query = "SELECT avg(" + fraction + ") " + submitted
+	default:
+		query = "SELECT max(" + fraction + ") " + submitted
+	}
+	var score *float64
+	err := DB.Get(&score, query, a.ID, studentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return score, err
+}
+
+// Passed сообщает, засчитывается ли задание с результатом score (см. StudentScore).
+func (a *Assignment) Passed(score float64) bool {
+	return score >= a.PassThreshold-1e-9
+}
+
+// SetCompleted отмечает задание выполненным учеником или снимает отметку: при ScoreLast
+// и ScoreAverage результат может и ухудшиться.
+func (a *Assignment) SetCompleted(studentID int32, completed bool) error {
+	var err error
+	if completed {
+		_, err = DB.Exec(`INSERT INTO completed_assignment (assignment_id, student_id) VALUES ($1, $2)
+			ON CONFLICT (assignment_id, student_id) DO NOTHING`, a.ID, studentID)
+	} else {
+		_, err = DB.Exec("DELETE FROM completed_assignment WHERE assignment_id=$1 AND student_id=$2", a.ID, studentID)
+	}
+	if err != nil {
+		return err
+	}
+	a.Completed.Value = completed
+	return nil
 }
 
 // DB - это ваша глобальная или контекстная переменная для соединения с базой данных
@@ -2,55 +2,379 @@ package assignment
 
 import (
 	"api/account"
+	"api/authz"
 	"api/config"
 	"api/utils"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type GetAssignmentData struct {
-	QwizID    int    `json:"qwiz_id"`
-	ClassID   int    `json:"class_id"`
+	ID            int         `json:"id"`
+	QwizID        int         `json:"qwiz_id"`
+	ClassID       int         `json:"class_id"`
+	OpenTime      *int64      `json:"open_time"`
+	CloseTime     *int64      `json:"close_time"`
+	PassThreshold float64     `json:"pass_threshold"`
+	MaxAttempts   *int32      `json:"max_attempts"`
+	ScorePolicy   ScorePolicy `json:"score_policy"`
+	Duration      *int32      `json:"duration"`
+	LatePenalty   *float64    `json:"late_penalty"`
+	Completed     bool        `json:"completed"`
+}
+
+func NewGetAssignmentData(a *Assignment) GetAssignmentData {
+	return GetAssignmentData{
+		ID:            a.ID,
+		QwizID:        a.QwizID,
+		ClassID:       a.ClassID,
+		OpenTime:      timeToMillis(a.OpenTime),
+		CloseTime:     timeToMillis(a.CloseTime),
+		PassThreshold: a.PassThreshold,
+		MaxAttempts:   a.MaxAttempts,
+		ScorePolicy:   a.ScorePolicy,
+		Duration:      a.Duration,
+		LatePenalty:   a.LatePenalty,
+		Completed:     a.Completed.Value,
+	}
+}
+
+type PostAssignmentData struct {
+	Assignment NewAssignmentData `json:"assignment"`
+}
+
+// PatchAssignmentData - новые сроки и настройки, nil оставляет как есть. 0 снимает ограничение
+// во времени, попытках и длительности, отрицательный штраф - снова не принимать работы после срока.
+type PatchAssignmentData struct {
+	NewOpenTime      *int64   `json:"new_open_time"`
+	NewCloseTime     *int64   `json:"new_close_time"`
+	NewPassThreshold *float64 `json:"new_pass_threshold"`
+	NewMaxAttempts   *int32   `json:"new_max_attempts"`
+	NewScorePolicy   *string  `json:"new_score_policy"`
+	NewDuration      *int32   `json:"new_duration"`
+	NewLatePenalty   *float64 `json:"new_late_penalty"`
+}
+
+type GetGradebookAssignmentData struct {
+	ID        int32  `json:"id"`
+	QwizID    int32  `json:"qwiz_id"`
+	QwizName  string `json:"qwiz_name"`
 	OpenTime  *int64 `json:"open_time"`
 	CloseTime *int64 `json:"close_time"`
-	Completed bool   `json:"completed"`
 }
 
-type GetAssignmentsData struct {
-	Password string `json:"password"`
+type GetGradebookCellData struct {
+	AssignmentID int32    `json:"assignment_id"`
+	Completed    bool     `json:"completed"`
+	BestScore    *float64 `json:"best_score"`
+	Attempts     int32    `json:"attempts"`
+	SubmitTime   *int64   `json:"submit_time"`
 }
 
-func GetAccountAssignments(c *gin.Context) {
-	var data GetAssignmentsData
-	if err := c.BindJSON(&data); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad request"})
+type GetGradebookStudentData struct {
+	ID       int32                  `json:"id"`
+	Username string                 `json:"username"`
+	Cells    []GetGradebookCellData `json:"cells"`
+}
+
+type GetGradebookData struct {
+	Assignments []GetGradebookAssignmentData `json:"assignments"`
+	Students    []GetGradebookStudentData    `json:"students"`
+}
+
+func NewGetGradebookData(g *Gradebook) GetGradebookData {
+	data := GetGradebookData{
+		Assignments: make([]GetGradebookAssignmentData, 0, len(g.Assignments)),
+		Students:    make([]GetGradebookStudentData, 0, len(g.Students)),
+	}
+	for _, a := range g.Assignments {
+		data.Assignments = append(data.Assignments, GetGradebookAssignmentData{
+			ID:        a.ID,
+			QwizID:    a.QwizID,
+			QwizName:  a.QwizName,
+			OpenTime:  timeToMillis(a.OpenTime),
+			CloseTime: timeToMillis(a.CloseTime),
+		})
+	}
+	for _, s := range g.Students {
+		student := GetGradebookStudentData{ID: s.ID, Username: s.Username, Cells: make([]GetGradebookCellData, 0, len(s.Cells))}
+		for _, c := range s.Cells {
+			student.Cells = append(student.Cells, GetGradebookCellData{
+				AssignmentID: c.AssignmentID,
+				Completed:    c.Completed,
+				BestScore:    c.BestScore,
+				Attempts:     c.Attempts,
+				SubmitTime:   timeToMillis(c.SubmitTime),
+			})
+		}
+		data.Students = append(data.Students, student)
+	}
+	return data
+}
+
+func assignmentInfo(c *gin.Context) {
+	info := `
+GET /assignment/<id> - get assignment by id
+Authorization: Bearer <access_token> - required (teacher of the class or its student)
+
+GET /class/<id>/assignments - get assignments of a class
+Authorization: Bearer <access_token> - required (teacher of the class or its student)
+
+GET /class/<id>/gradebook?from=<ms>&to=<ms>&format=csv - students x assignments of a class
+Authorization: Bearer <access_token> - required (teacher of the class)
+from, to: i64 - optional (only assignments open at some point in [from, to))
+format: "json" | "csv" - optional (default "json")
+students: [{ id, username, cells: [{ assignment_id, completed, best_score, attempts, submit_time }] }]
+(cells follow assignments; best_score is the best fraction of max score, submit_time is the last submission)
+
+POST /assignment - assign a qwiz to a class
+Authorization: Bearer <access_token> - required (teacher of the class)
+assignment: {
+	qwiz_id: i32 - required (own or public qwiz)
+	class_id: i32 - required
+	open_time: i64 - optional (ms since epoch)
+	close_time: i64 - optional (ms since epoch, after open_time)
+	pass_threshold: f64 - optional (0 to 1, default 1)
+	max_attempts: i32 - optional
+	score_policy: "best" | "last" | "average" - optional (default "best")
+	duration: i32 - optional (seconds per attempt)
+	late_penalty: f64 - optional (0 to 1, accept after close_time with penalty)
+}
+
+PATCH /assignment/<id> - reschedule or change settings
+Authorization: Bearer <access_token> - required (teacher of the class)
+new_open_time: i64 - optional (0 to remove)
+new_close_time: i64 - optional (0 to remove)
+new_pass_threshold: f64 - optional
+new_max_attempts: i32 - optional (0 to remove the limit)
+new_score_policy: "best" | "last" | "average" - optional
+new_duration: i32 - optional (0 to remove the limit)
+new_late_penalty: f64 - optional (negative to reject late submissions)
+
+DELETE /assignment/<id> - cancel an assignment
+Authorization: Bearer <access_token> - required (teacher of the class)
+`
+	c.String(http.StatusOK, info)
+}
+
+func getAssignmentByID(c *gin.Context) {
+	a, ok := assignmentFromParam(c)
+	if !ok {
+		return
+	}
+	completed, err := a.CompletedBy(account.Current(c).ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
 		return
 	}
+	a.Completed.Value = completed
+	c.JSON(http.StatusOK, NewGetAssignmentData(a))
+}
 
-	id := c.Param("accountParam")
-	intID, err := strconv.ParseInt(id, 10, 32)
+func getClassAssignments(c *gin.Context) {
+	classID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
 		return
 	}
 
-	acct, err := account.GetByID(int32(intID))
+	assignments, err := GetAllByClassID(classID, account.Current(c).ID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Account not found"})
-		utils.DbErrToStatus(err, http.StatusNotFound)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
 		return
 	}
 
-	isValid, err := acct.VerifyPassword(data.Password)
+	result := make([]GetAssignmentData, 0, len(assignments))
+	for _, a := range assignments {
+		result = append(result, NewGetAssignmentData(a))
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func getClassGradebook(c *gin.Context) {
+	classID, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
-		utils.InternalErr(err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+	from, ok := millisQuery(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := millisQuery(c, "to")
+	if !ok {
+		return
+	}
+
+	gradebook, err := GetGradebook(int32(classID), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
 		return
 	}
 
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		c.JSON(http.StatusOK, NewGetGradebookData(gradebook))
+	case "csv":
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="gradebook-%d.csv"`, classID))
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		if err := gradebook.WriteCSV(c.Writer); err != nil {
+			utils.InternalErr(err)
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format"})
+	}
+}
+
+// millisQuery читает из запроса необязательное время в миллисекундах Unix. При ошибке ответ уже записан.
+func millisQuery(c *gin.Context, name string) (*time.Time, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name})
+		return nil, false
+	}
+	return millisToTime(&millis), true
+}
+
+func createAssignment(c *gin.Context) {
+	var data PostAssignmentData
+	if err := c.BindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data"})
+		return
+	}
+
+	// Выдать можно только своему классу и только свою или публичную викторину
+	classID := func(*gin.Context) (int32, error) { return int32(data.Assignment.ClassID), nil }
+	qwizID := func(*gin.Context) (int32, error) { return int32(data.Assignment.QwizID), nil }
+	if !authz.Check(c, authz.TeacherOwnsClass(classID), authz.QwizAssignable(qwizID)) {
+		return
+	}
+
+	a, err := FromAssignmentData(&data.Assignment)
+	if err != nil {
+		var customErr *Error
+		if errors.As(err, &customErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": customErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
+	}
+
+	c.Header("Location", fmt.Sprintf("%s/assignment/%d", config.BaseURL, a.ID))
+	c.JSON(http.StatusCreated, NewGetAssignmentData(a))
+}
+
+func updateAssignment(c *gin.Context) {
+	var data PatchAssignmentData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data"})
+		return
+	}
+
+	a, ok := assignmentFromParam(c)
+	if !ok {
+		return
+	}
+
+	if data.NewOpenTime != nil {
+		a.OpenTime = millisToTime(nonZero(*data.NewOpenTime))
+	}
+	if data.NewCloseTime != nil {
+		a.CloseTime = millisToTime(nonZero(*data.NewCloseTime))
+	}
+	if data.NewPassThreshold != nil {
+		a.PassThreshold = *data.NewPassThreshold
+	}
+	if data.NewMaxAttempts != nil {
+		a.MaxAttempts = nonZero(*data.NewMaxAttempts)
+	}
+	if data.NewScorePolicy != nil {
+		policy, err := ParseScorePolicy(*data.NewScorePolicy)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		a.ScorePolicy = policy
+	}
+	if data.NewDuration != nil {
+		a.Duration = nonZero(*data.NewDuration)
+	}
+	if data.NewLatePenalty != nil {
+		a.LatePenalty = data.NewLatePenalty
+		if *data.NewLatePenalty < 0 {
+			a.LatePenalty = nil
+		}
+	}
+
+	if err := a.Update(); err != nil {
+		var customErr *Error
+		if errors.As(err, &customErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": customErr.Message})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, NewGetAssignmentData(a))
+}
+
+func deleteAssignment(c *gin.Context) {
+	a, ok := assignmentFromParam(c)
+	if !ok {
+		return
+	}
+	if err := a.Delete(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// assignmentFromParam загружает задание из пути. При ошибке ответ уже записан.
+func assignmentFromParam(c *gin.Context) (*Assignment, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return nil, false
+	}
+	a, err := GetByID(id)
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Assignment not found"})
+		return nil, false
+	}
+	return a, true
+}
+
+// nonZero - nil для нулевого значения: так в PatchAssignmentData снимаются ограничения.
+func nonZero[T int32 | int64](value T) *T {
+	if value == 0 {
+		return nil
+	}
+	return &value
+}
+
+func GetAccountAssignments(c *gin.Context) {
+	id := c.Param("accountParam")
+	intID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	acct, err := account.GetByID(int32(intID))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Account not found"})
 		return
 	}
 
@@ -68,25 +392,7 @@ func GetAccountAssignments(c *gin.Context) {
 
 	var result []GetAssignmentData
 	for _, a := range assignments {
-		var openTime, closeTime *int64
-
-		if a.OpenTime != nil {
-			t := a.OpenTime.Unix() * 1000 // Convert to milliseconds
-			openTime = &t
-		}
-
-		if a.CloseTime != nil {
-			t := a.CloseTime.Unix() * 1000 // Convert to milliseconds
-			closeTime = &t
-		}
-
-		result = append(result, GetAssignmentData{
-			QwizID:    a.QwizID,
-			ClassID:   a.ClassID,
-			OpenTime:  openTime,
-			CloseTime: closeTime,
-			Completed: a.Completed.Value,
-		})
+		result = append(result, NewGetAssignmentData(a))
 	}
 
 	c.JSON(http.StatusOK, result)
@@ -94,8 +400,26 @@ func GetAccountAssignments(c *gin.Context) {
 
 // RegisterRoutes добавляет маршруты модуля assignment к роутеру Gin.
 func RegisterRoutes(r *gin.Engine) {
+	assignmentGroup := r.Group(config.BaseURL + "/assignment")
+	{
+		assignmentGroup.GET("", assignmentInfo)
+		id := authz.Param("id")
+		ownsAssignment := authz.Require(authz.TeacherOwnsAssignment(id))
+		assignmentGroup.POST("", account.RequireAuth(), authz.Require(authz.Role(account.Teacher.String())), createAssignment)
+		assignmentGroup.GET("/:id", account.RequireAuth(), authz.Require(authz.Any(authz.TeacherOwnsAssignment(id), authz.StudentInAssignmentClass(id))), getAssignmentByID)
+		assignmentGroup.PATCH("/:id", account.RequireAuth(), ownsAssignment, updateAssignment)
+		assignmentGroup.DELETE("/:id", account.RequireAuth(), ownsAssignment, deleteAssignment)
+	}
+	classGroup := r.Group(config.BaseURL + "/class")
+	{
+		classID := authz.Param("id")
+		classGroup.GET("/:id/gradebook", account.RequireAuth(), authz.Require(authz.TeacherOwnsClass(classID)), getClassGradebook)
+		classGroup.GET("/:id/assignments", account.RequireAuth(), authz.Require(authz.Any(authz.TeacherOwnsClass(classID), authz.StudentInClass(classID))), getClassAssignments)
+	}
 	accountGroup := r.Group(config.BaseURL + "/account")
 	{
-		accountGroup.GET("/:accountParam/assignments", GetAccountAssignments)
+		// Родитель с подтверждённой связью может читать данные ученика, см. пакет parent
+		owner := authz.Param("accountParam")
+		accountGroup.GET("/:accountParam/assignments", account.RequireAuth(), authz.Require(authz.Any(authz.Self(owner), authz.ParentOfStudent(owner))), GetAccountAssignments)
 	}
 }
@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"github.com/jmoiron/sqlx"
+	"log"
+	"time"
+)
+
+var DB *sqlx.DB
+
+type Event string
+
+const (
+	LoginFailed Event = "login_failed"
+	LoginLocked Event = "login_locked"
+)
+
+type Entry struct {
+	ID        int64     `db:"id"`
+	Time      time.Time `db:"time"`
+	Event     Event     `db:"event"`
+	AccountID *int32    `db:"account_id"`
+	IP        string    `db:"ip"`
+	Details   string    `db:"details"`
+}
+
+// Record пишет событие в журнал. Ошибка записи только логируется: журнал не должен
+// ломать обработку запроса.
+func Record(event Event, accountID *int32, ip, details string) {
+	_, err := DB.Exec("INSERT INTO audit_log (time, event, account_id, ip, details) VALUES ($1, $2, $3, $4, $5)",
+		time.Now().UTC(), event, accountID, ip, details)
+	if err != nil {
+		log.Printf("Failed to write audit log %s: %v", event, err)
+	}
+}
+
+// GetAllByAccountID возвращает события аккаунта, начиная с последних.
+func GetAllByAccountID(accountID int32, limit int) ([]Entry, error) {
+	var entries []Entry
+	err := DB.Select(&entries, "SELECT * FROM audit_log WHERE account_id=$1 ORDER BY id DESC LIMIT $2", accountID, limit)
+	return entries, err
+}
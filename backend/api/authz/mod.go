@@ -0,0 +1,337 @@
+package authz
+
+import (
+	"api/utils"
+	"database/sql"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+	"strconv"
+)
+
+// AdminRole - тип аккаунта, которому разрешено действовать над любым ресурсом.
+const AdminRole = "admin"
+
+const subjectContextKey = "subject"
+
+var DB *sqlx.DB
+
+var ErrBadID = errors.New("invalid id")
+
+// Subject - кто выполняет запрос. Устанавливается при аутентификации (см. account.RequireAuth),
+// поэтому authz не зависит от пакета account и может использоваться любым модулем.
+type Subject struct {
+	ID   int32
+	Role string
+}
+
+func (s Subject) IsAdmin() bool {
+	return s.Role == AdminRole
+}
+
+func SetSubject(c *gin.Context, subject Subject) {
+	c.Set(subjectContextKey, subject)
+}
+
+func CurrentSubject(c *gin.Context) (Subject, bool) {
+	value, exists := c.Get(subjectContextKey)
+	if !exists {
+		return Subject{}, false
+	}
+	subject, ok := value.(Subject)
+	return subject, ok
+}
+
+// IDSource достаёт идентификатор ресурса из запроса.
+type IDSource func(c *gin.Context) (int32, error)
+
+func Param(name string) IDSource {
+	return func(c *gin.Context) (int32, error) {
+		return parseID(c.Param(name))
+	}
+}
+
+func Query(name string) IDSource {
+	return func(c *gin.Context) (int32, error) {
+		return parseID(c.Query(name))
+	}
+}
+
+func parseID(value string) (int32, error) {
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, ErrBadID
+	}
+	return int32(id), nil
+}
+
+// Policy решает, разрешено ли субъекту действие над ресурсом из запроса.
+// sql.ErrNoRows означает, что ресурса нет.
+type Policy func(c *gin.Context, subject Subject) (bool, error)
+
+// Role разрешает действие аккаунтам перечисленных типов.
+func Role(roles ...string) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		for _, role := range roles {
+			if subject.Role == role {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// Self разрешает действие только над собственным аккаунтом.
+func Self(accountID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := accountID(c)
+		if err != nil {
+			return false, err
+		}
+		return id == subject.ID, nil
+	}
+}
+
+// TeacherOwnsClass разрешает действие учителю, который ведёт класс.
+func TeacherOwnsClass(classID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := classID(c)
+		if err != nil {
+			return false, err
+		}
+		var teacherID int32
+		if err := DB.Get(&teacherID, "SELECT teacher_id FROM class WHERE id=$1", id); err != nil {
+			return false, err
+		}
+		return teacherID == subject.ID, nil
+	}
+}
+
+// CreatorOwnsQwiz разрешает действие автору викторины.
+func CreatorOwnsQwiz(qwizID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := qwizID(c)
+		if err != nil {
+			return false, err
+		}
+		var creatorID int32
+		if err := DB.Get(&creatorID, "SELECT creator_id FROM qwiz WHERE id=$1", id); err != nil {
+			return false, err
+		}
+		return creatorID == subject.ID, nil
+	}
+}
+
+// QwizAssignable разрешает выдать викторину заданием: публичную - любому, закрытую - только автору.
+func QwizAssignable(qwizID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := qwizID(c)
+		if err != nil {
+			return false, err
+		}
+		var qwiz struct {
+			CreatorID int32 `db:"creator_id"`
+			Public    bool  `db:"public"`
+		}
+		if err := DB.Get(&qwiz, "SELECT creator_id, public FROM qwiz WHERE id=$1", id); err != nil {
+			return false, err
+		}
+		return qwiz.Public || qwiz.CreatorID == subject.ID, nil
+	}
+}
+
+// StudentInClass разрешает действие ученику класса.
+func StudentInClass(classID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := classID(c)
+		if err != nil {
+			return false, err
+		}
+		var exists bool
+		err = DB.Get(&exists, "SELECT EXISTS(SELECT 1 FROM student WHERE class_id=$1 AND student_id=$2)", id, subject.ID)
+		return exists, err
+	}
+}
+
+// StudentInAssignmentClass разрешает действие ученику класса, которому выдано задание.
+func StudentInAssignmentClass(assignmentID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := assignmentID(c)
+		if err != nil {
+			return false, err
+		}
+		var exists bool
+		err = DB.Get(&exists, `SELECT EXISTS(SELECT 1 FROM assignment a JOIN student s ON s.class_id=a.class_id
+			WHERE a.id=$1 AND s.student_id=$2)`, id, subject.ID)
+		return exists, err
+	}
+}
+
+// TeacherOwnsAssignment разрешает действие учителю класса, которому выдано задание.
+func TeacherOwnsAssignment(assignmentID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := assignmentID(c)
+		if err != nil {
+			return false, err
+		}
+		var teacherID int32
+		err = DB.Get(&teacherID, "SELECT c.teacher_id FROM assignment a JOIN class c ON c.id=a.class_id WHERE a.id=$1", id)
+		if err != nil {
+			return false, err
+		}
+		return teacherID == subject.ID, nil
+	}
+}
+
+// ParentOfStudent разрешает родителю читать данные ученика, связь с которым подтверждена.
+func ParentOfStudent(studentID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := studentID(c)
+		if err != nil {
+			return false, err
+		}
+		var exists bool
+		err = DB.Get(&exists, "SELECT EXISTS(SELECT 1 FROM parent_link WHERE parent_id=$1 AND student_id=$2 AND status='accepted')", subject.ID, id)
+		return exists, err
+	}
+}
+
+// LinkMember разрешает действие родителю или ученику из связи.
+func LinkMember(linkID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := linkID(c)
+		if err != nil {
+			return false, err
+		}
+		var link struct {
+			ParentID  int32 `db:"parent_id"`
+			StudentID int32 `db:"student_id"`
+		}
+		if err := DB.Get(&link, "SELECT parent_id, student_id FROM parent_link WHERE id=$1", id); err != nil {
+			return false, err
+		}
+		return link.ParentID == subject.ID || link.StudentID == subject.ID, nil
+	}
+}
+
+// LinkApprover разрешает подтвердить связь ученику или учителю одного из его классов.
+func LinkApprover(linkID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := linkID(c)
+		if err != nil {
+			return false, err
+		}
+		var studentID int32
+		if err := DB.Get(&studentID, "SELECT student_id FROM parent_link WHERE id=$1", id); err != nil {
+			return false, err
+		}
+		if studentID == subject.ID {
+			return true, nil
+		}
+		var exists bool
+		err = DB.Get(&exists, `SELECT EXISTS(SELECT 1 FROM student s JOIN class c ON c.id=s.class_id
+			WHERE s.student_id=$1 AND c.teacher_id=$2)`, studentID, subject.ID)
+		return exists, err
+	}
+}
+
+// LiveSessionOwner разрешает читать итоги живой сессии её ведущему или автору викторины.
+func LiveSessionOwner(sessionID IDSource) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		id, err := sessionID(c)
+		if err != nil {
+			return false, err
+		}
+		var owners struct {
+			HostID    int32 `db:"host_id"`
+			CreatorID int32 `db:"creator_id"`
+		}
+		err = DB.Get(&owners, "SELECT s.host_id, q.creator_id FROM live_session s JOIN qwiz q ON q.id=s.qwiz_id WHERE s.id=$1", id)
+		if err != nil {
+			return false, err
+		}
+		return owners.HostID == subject.ID || owners.CreatorID == subject.ID, nil
+	}
+}
+
+// Any разрешает действие, если разрешает хотя бы одна из политик.
+func Any(policies ...Policy) Policy {
+	return func(c *gin.Context, subject Subject) (bool, error) {
+		for _, policy := range policies {
+			allowed, err := policy(c, subject)
+			if err != nil {
+				return false, err
+			}
+			if allowed {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// Forbidden - единый ответ на запрещённое действие.
+func Forbidden(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+}
+
+// Check применяет политики к текущему запросу. При отказе ответ уже записан и запрос прерван.
+// Администратор проходит любые политики.
+func Check(c *gin.Context, policies ...Policy) bool {
+	subject, ok := CurrentSubject(c)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return false
+	}
+	if subject.IsAdmin() {
+		return true
+	}
+
+	for _, policy := range policies {
+		allowed, err := policy(c, subject)
+		switch {
+		case errors.Is(err, ErrBadID):
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+			return false
+		case errors.Is(err, sql.ErrNoRows):
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Not found"})
+			return false
+		case err != nil:
+			utils.InternalErr(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+			return false
+		case !allowed:
+			Forbidden(c)
+			return false
+		}
+	}
+	return true
+}
+
+// Allowed применяет политики, ничего не отвечая: для запросов, где от прав зависит только вид ответа.
+// Анонимный запрос не проходит, администратор проходит любые политики.
+func Allowed(c *gin.Context, policies ...Policy) (bool, error) {
+	subject, ok := CurrentSubject(c)
+	if !ok {
+		return false, nil
+	}
+	if subject.IsAdmin() {
+		return true, nil
+	}
+	for _, policy := range policies {
+		if allowed, err := policy(c, subject); err != nil || !allowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Require - то же, что Check, в виде middleware. Ставится после account.RequireAuth.
+func Require(policies ...Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if Check(c, policies...) {
+			c.Next()
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package broker
+
+import (
+	"sync"
+	"time"
+)
+
+// MemoryBroker - брокер для одного экземпляра API.
+type MemoryBroker struct {
+	topics topics
+
+	mu     sync.Mutex
+	claims map[string]time.Time
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{claims: make(map[string]time.Time)}
+}
+
+func (b *MemoryBroker) Publish(topic string, payload []byte) error {
+	b.topics.deliver(topic, payload)
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(topic string, handler Handler) (Subscription, error) {
+	s := newSubscription(handler, func(s *subscription) {
+		b.topics.mu.Lock()
+		defer b.topics.mu.Unlock()
+		b.topics.remove(topic, s)
+	})
+
+	b.topics.mu.Lock()
+	defer b.topics.mu.Unlock()
+	b.topics.add(topic, s)
+	return s, nil
+}
+
+func (b *MemoryBroker) Claim(key string, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if expires, ok := b.claims[key]; ok && now.Before(expires) {
+		return false, nil
+	}
+	b.claims[key] = now.Add(ttl)
+	b.cleanup(now)
+	return true, nil
+}
+
+func (b *MemoryBroker) Claimed(key string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	expires, ok := b.claims[key]
+	return ok && time.Now().Before(expires), nil
+}
+
+func (b *MemoryBroker) Release(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.claims, key)
+	return nil
+}
+
+func (b *MemoryBroker) cleanup(now time.Time) {
+	for key, expires := range b.claims {
+		if !now.Before(expires) {
+			delete(b.claims, key)
+		}
+	}
+}
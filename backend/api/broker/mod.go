@@ -0,0 +1,137 @@
+package broker
+
+import (
+	"sync"
+	"time"
+)
+
+// Broker рассылает сообщения по темам между экземплярами API и помнит, кем занят ключ.
+// MemoryBroker работает в пределах процесса, PostgresBroker - через LISTEN/NOTIFY,
+// чтобы сообщения видели все экземпляры за балансировщиком.
+type Broker interface {
+	// Publish доставляет payload всем подписчикам темы, в том числе на этом экземпляре.
+	// Темы - короткие строки, не длиннее 63 байт (ограничение имени канала Postgres), payload - текст.
+	Publish(topic string, payload []byte) error
+	// Subscribe вызывает handler для каждого сообщения темы в порядке публикации,
+	// в отдельной горутине подписки.
+	Subscribe(topic string, handler Handler) (Subscription, error)
+	// Claim занимает ключ на ttl. false - ключ уже занят и ещё не истёк.
+	Claim(key string, ttl time.Duration) (bool, error)
+	// Claimed сообщает, занят ли ключ.
+	Claimed(key string) (bool, error)
+	// Release освобождает ключ раньше срока.
+	Release(key string) error
+}
+
+type Handler func(payload []byte)
+
+type Subscription interface {
+	// Unsubscribe прекращает доставку. Сообщения, ещё не переданные handler, отбрасываются.
+	Unsubscribe()
+}
+
+// subscription - очередь сообщений одного подписчика. Publish не ждёт обработчик,
+// а медленный обработчик не задерживает других подписчиков.
+type subscription struct {
+	handler Handler
+	remove  func(*subscription)
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  [][]byte
+	closed bool
+}
+
+func newSubscription(handler Handler, remove func(*subscription)) *subscription {
+	s := &subscription{handler: handler, remove: remove}
+	s.cond = sync.NewCond(&s.mu)
+	go s.run()
+	return s
+}
+
+func (s *subscription) deliver(payload []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.queue = append(s.queue, payload)
+	s.cond.Signal()
+}
+
+func (s *subscription) run() {
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+		if s.closed {
+			s.mu.Unlock()
+			return
+		}
+		payload := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+
+		s.handler(payload)
+	}
+}
+
+func (s *subscription) Unsubscribe() {
+	if s.stop() {
+		s.remove(s)
+	}
+}
+
+// stop останавливает доставку и возвращает false, если подписка уже остановлена.
+func (s *subscription) stop() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.closed = true
+	s.queue = nil
+	s.cond.Signal()
+	return true
+}
+
+// topics - подписчики по темам, общая часть обеих реализаций.
+type topics struct {
+	mu   sync.Mutex
+	subs map[string]map[*subscription]struct{}
+}
+
+func (t *topics) add(topic string, s *subscription) (first bool) {
+	if t.subs == nil {
+		t.subs = make(map[string]map[*subscription]struct{})
+	}
+	subs, ok := t.subs[topic]
+	if !ok {
+		subs = make(map[*subscription]struct{})
+		t.subs[topic] = subs
+	}
+	subs[s] = struct{}{}
+	return !ok
+}
+
+func (t *topics) remove(topic string, s *subscription) (last bool) {
+	subs, ok := t.subs[topic]
+	if !ok {
+		return false
+	}
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(t.subs, topic)
+		return true
+	}
+	return false
+}
+
+func (t *topics) deliver(topic string, payload []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for s := range t.subs[topic] {
+		s.deliver(payload)
+	}
+}
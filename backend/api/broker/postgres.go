@@ -0,0 +1,150 @@
+package broker
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"log"
+	"strconv"
+	"time"
+)
+
+// NOTIFY принимает не больше 8000 байт. Сообщения длиннее кладутся в таблицу broker_message,
+// а в канал уходит только их id.
+const (
+	maxNotifyPayload = 7900
+	inlinePrefix     = '='
+	storedPrefix     = '#'
+	storedMessageTTL = time.Minute
+)
+
+// PostgresBroker рассылает сообщения через LISTEN/NOTIFY, ключи хранит в таблице broker_claim.
+// На каждую тему - один канал Postgres на общем соединении listener.
+type PostgresBroker struct {
+	DB       *sqlx.DB
+	listener *pq.Listener
+	topics   topics
+}
+
+// NewPostgresBroker открывает отдельное соединение для LISTEN по databaseURL.
+func NewPostgresBroker(db *sqlx.DB, databaseURL string) *PostgresBroker {
+	listener := pq.NewListener(databaseURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Broker listener: %v", err)
+		}
+	})
+	b := &PostgresBroker{DB: db, listener: listener}
+	go b.dispatch()
+	return b
+}
+
+func (b *PostgresBroker) Publish(topic string, payload []byte) error {
+	message := string(inlinePrefix) + string(payload)
+	if len(message) > maxNotifyPayload {
+		now := time.Now().UTC()
+		var id int64
+		err := b.DB.Get(&id, "INSERT INTO broker_message (payload, create_time) VALUES ($1, $2) RETURNING id", payload, now)
+		if err != nil {
+			return err
+		}
+		// Подписчики забирают сообщение сразу после NOTIFY, старые можно удалять
+		if _, err := b.DB.Exec("DELETE FROM broker_message WHERE create_time < $1", now.Add(-storedMessageTTL)); err != nil {
+			return err
+		}
+		message = string(storedPrefix) + strconv.FormatInt(id, 10)
+	}
+	_, err := b.DB.Exec("SELECT pg_notify($1, $2)", topic, message)
+	return err
+}
+
+func (b *PostgresBroker) Subscribe(topic string, handler Handler) (Subscription, error) {
+	s := newSubscription(handler, func(s *subscription) {
+		b.topics.mu.Lock()
+		defer b.topics.mu.Unlock()
+		if b.topics.remove(topic, s) {
+			if err := b.listener.Unlisten(topic); err != nil && !errors.Is(err, pq.ErrChannelNotOpen) {
+				log.Printf("Broker unlisten %s: %v", topic, err)
+			}
+		}
+	})
+
+	b.topics.mu.Lock()
+	defer b.topics.mu.Unlock()
+	if b.topics.add(topic, s) {
+		if err := b.listener.Listen(topic); err != nil && !errors.Is(err, pq.ErrChannelAlreadyOpen) {
+			b.topics.remove(topic, s)
+			s.stop()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (b *PostgresBroker) dispatch() {
+	for n := range b.listener.Notify {
+		if n == nil {
+			// Соединение переустановлено, уведомления за время разрыва потеряны
+			log.Println("Broker listener reconnected, notifications may have been lost")
+			continue
+		}
+		payload, err := b.payload(n.Extra)
+		if err != nil {
+			log.Printf("Broker message on %s: %v", n.Channel, err)
+			continue
+		}
+		b.topics.deliver(n.Channel, payload)
+	}
+}
+
+func (b *PostgresBroker) payload(message string) ([]byte, error) {
+	if message == "" {
+		return nil, errors.New("empty notification")
+	}
+	switch message[0] {
+	case inlinePrefix:
+		return []byte(message[1:]), nil
+	case storedPrefix:
+		var payload []byte
+		err := b.DB.Get(&payload, "SELECT payload FROM broker_message WHERE id=$1", message[1:])
+		return payload, err
+	default:
+		return nil, errors.New("unknown notification format")
+	}
+}
+
+func (b *PostgresBroker) Claim(key string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	var claimed string
+	err := b.DB.Get(&claimed, `
+		INSERT INTO broker_claim (key, expire_time) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET expire_time=EXCLUDED.expire_time
+		WHERE broker_claim.expire_time <= $3
+		RETURNING key
+	`, key, now.Add(ttl), now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_, err = b.DB.Exec("DELETE FROM broker_claim WHERE expire_time <= $1", now)
+	return true, err
+}
+
+func (b *PostgresBroker) Claimed(key string) (bool, error) {
+	var claimed bool
+	err := b.DB.Get(&claimed, "SELECT EXISTS(SELECT 1 FROM broker_claim WHERE key=$1 AND expire_time > $2)", key, time.Now().UTC())
+	return claimed, err
+}
+
+func (b *PostgresBroker) Release(key string) error {
+	_, err := b.DB.Exec("DELETE FROM broker_claim WHERE key=$1", key)
+	return err
+}
+
+// Close закрывает соединение LISTEN.
+func (b *PostgresBroker) Close() error {
+	return b.listener.Close()
+}
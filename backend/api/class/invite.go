@@ -0,0 +1,220 @@
+package class
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"strings"
+	"time"
+)
+
+const (
+	InviteNotUsable   Error = "Invite expired or used up"
+	AlreadyInClass    Error = "Already in class"
+	InvalidMaxUses    Error = "max_uses must be positive"
+	InvalidExpireTime Error = "expire_time must be in the future"
+)
+
+// Invite - код, по которому ученик сам вступает в класс. У класса один действующий код:
+// новый заменяет старый, и старые ссылки перестают работать.
+type Invite struct {
+	ClassID int32  `db:"class_id"`
+	Code    string `db:"code"`
+	// RequireApproval - вступивший попадает в заявки, которые принимает учитель
+	RequireApproval bool `db:"require_approval"`
+	// ExpireTime и MaxUses - до какого момента и сколько раз код принимается, nil - без ограничения
+	ExpireTime *time.Time `db:"expire_time"`
+	MaxUses    *int32     `db:"max_uses"`
+	Uses       int32      `db:"uses"`
+	CreateTime time.Time  `db:"create_time"`
+}
+
+type NewInviteData struct {
+	RequireApproval bool `json:"require_approval"`
+	// ExpireTime - в миллисекундах Unix
+	ExpireTime *int64 `json:"expire_time"`
+	MaxUses    *int32 `json:"max_uses"`
+}
+
+// JoinRequest - заявка ученика на вступление в класс, ждущая решения учителя.
+type JoinRequest struct {
+	StudentID  int32     `db:"student_id"`
+	Username   string    `db:"username"`
+	CreateTime time.Time `db:"create_time"`
+}
+
+var inviteEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateInviteCode возвращает 10 символов base32: их удобно продиктовать и ввести вручную.
+func generateInviteCode() (string, error) {
+	bytes := make([]byte, 7)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return strings.ToLower(inviteEncoding.EncodeToString(bytes))[:10], nil
+}
+
+func normalizeInviteCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// CreateInvite выпускает новый код приглашения в класс, заменяя прежний вместе со счётчиком вступлений.
+func (c *Class) CreateInvite(data NewInviteData) (*Invite, error) {
+	now := time.Now().UTC()
+	var expireTime *time.Time
+	if data.ExpireTime != nil {
+		t := time.UnixMilli(*data.ExpireTime).UTC()
+		if !t.After(now) {
+			return nil, InvalidExpireTime
+		}
+		expireTime = &t
+	}
+	if data.MaxUses != nil && *data.MaxUses <= 0 {
+		return nil, InvalidMaxUses
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	invite := &Invite{}
+	err = DB.Get(invite, `
+		INSERT INTO class_invite (class_id, code, require_approval, expire_time, max_uses, uses, create_time)
+		VALUES ($1, $2, $3, $4, $5, 0, $6)
+		ON CONFLICT (class_id) DO UPDATE SET code=EXCLUDED.code, require_approval=EXCLUDED.require_approval,
+			expire_time=EXCLUDED.expire_time, max_uses=EXCLUDED.max_uses, uses=0, create_time=EXCLUDED.create_time
+		RETURNING *
+	`, c.ID, code, data.RequireApproval, expireTime, data.MaxUses, now)
+	if err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// GetInvite возвращает действующий код класса, sql.ErrNoRows - кода нет.
+func (c *Class) GetInvite() (*Invite, error) {
+	invite := &Invite{}
+	err := DB.Get(invite, "SELECT * FROM class_invite WHERE class_id=$1", c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// RevokeInvite отзывает код класса. Заявки, поданные по нему, остаются.
+func (c *Class) RevokeInvite() error {
+	_, err := DB.Exec("DELETE FROM class_invite WHERE class_id=$1", c.ID)
+	return err
+}
+
+// Usable сообщает, принимается ли код в момент now.
+func (i *Invite) Usable(now time.Time) bool {
+	if i.ExpireTime != nil && !i.ExpireTime.After(now) {
+		return false
+	}
+	return i.MaxUses == nil || i.Uses < *i.MaxUses
+}
+
+// JoinByInvite вступает учеником studentID в класс по коду. Если класс требует одобрения,
+// подаётся заявка и pending - true. Вступление и новая заявка расходуют одно использование кода,
+// повторная заявка - нет. Неизвестный код - sql.ErrNoRows, аккаунт не ученик - NotAStudent.
+func JoinByInvite(code string, studentID int32) (classID int32, pending bool, err error) {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return 0, false, err
+	}
+	defer tx.Rollback()
+
+	invite := &Invite{}
+	err = tx.Get(invite, "SELECT * FROM class_invite WHERE code=$1 FOR UPDATE", normalizeInviteCode(code))
+	if err != nil {
+		return 0, false, err
+	}
+	if !invite.Usable(time.Now().UTC()) {
+		return 0, false, InviteNotUsable
+	}
+	// Политика маршрута пропускает администратора, поэтому роль проверяется здесь
+	if err := checkStudent(tx, studentID); err != nil {
+		return 0, false, err
+	}
+
+	var inClass bool
+	err = tx.Get(&inClass, "SELECT EXISTS(SELECT 1 FROM student WHERE class_id=$1 AND student_id=$2)", invite.ClassID, studentID)
+	if err != nil {
+		return 0, false, err
+	}
+	if inClass {
+		return 0, false, AlreadyInClass
+	}
+
+	var result sql.Result
+	if invite.RequireApproval {
+		result, err = tx.Exec(`INSERT INTO class_join_request (class_id, student_id, create_time) VALUES ($1, $2, $3)
+			ON CONFLICT (class_id, student_id) DO NOTHING`, invite.ClassID, studentID, time.Now().UTC())
+	} else {
+		result, err = tx.Exec("INSERT INTO student (class_id, student_id) VALUES ($1, $2)", invite.ClassID, studentID)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if rows, err := result.RowsAffected(); err != nil {
+		return 0, false, err
+	} else if rows > 0 {
+		if _, err := tx.Exec("UPDATE class_invite SET uses=uses+1 WHERE class_id=$1", invite.ClassID); err != nil {
+			return 0, false, err
+		}
+	}
+	return invite.ClassID, invite.RequireApproval, tx.Commit()
+}
+
+// GetJoinRequests возвращает заявки на вступление, старые первыми.
+func (c *Class) GetJoinRequests() ([]JoinRequest, error) {
+	requests := []JoinRequest{}
+	err := DB.Select(&requests, `SELECT r.student_id, a.username, r.create_time
+		FROM class_join_request r JOIN account a ON a.id=r.student_id
+		WHERE r.class_id=$1 ORDER BY r.create_time, r.student_id`, c.ID)
+	return requests, err
+}
+
+// AcceptJoinRequest принимает ученика по заявке. Нет заявки - sql.ErrNoRows, аккаунт больше
+// не ученик - NotAStudent.
+func (c *Class) AcceptJoinRequest(studentID int32) error {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := deleteJoinRequest(tx.Exec, c.ID, studentID); err != nil {
+		return err
+	}
+	if err := checkStudent(tx, studentID); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO student (class_id, student_id) VALUES ($1, $2)
+		ON CONFLICT (student_id, class_id) DO NOTHING`, c.ID, studentID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RejectJoinRequest отклоняет заявку. Нет заявки - sql.ErrNoRows.
+func (c *Class) RejectJoinRequest(studentID int32) error {
+	return deleteJoinRequest(DB.Exec, c.ID, studentID)
+}
+
+func deleteJoinRequest(exec func(string, ...interface{}) (sql.Result, error), classID, studentID int32) error {
+	result, err := exec("DELETE FROM class_join_request WHERE class_id=$1 AND student_id=$2", classID, studentID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
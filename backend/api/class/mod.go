@@ -2,6 +2,7 @@ package class
 
 import (
 	"api/account"
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
@@ -136,6 +137,23 @@ func (c *Class) GetAllStudents() ([]int32, error) {
 	return studentIDs, nil
 }
 
+// checkStudent проверяет в tx, что аккаунт studentID - ученик. Роль проверяется в коде, а не в базе,
+// чтобы ответить 400: AccountNotFound или NotAStudent.
+func checkStudent(tx *sqlx.Tx, studentID int32) error {
+	var accountType string
+	err := tx.Get(&accountType, "SELECT account_type FROM account WHERE id=$1", studentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Error(fmt.Sprintf(string(AccountNotFound), studentID))
+	}
+	if err != nil {
+		return err
+	}
+	if accountType != account.Student.String() {
+		return Error(fmt.Sprintf(string(NotAStudent), studentID))
+	}
+	return nil
+}
+
 func (c *Class) AddStudents(studentIDs *[]int32) error {
 	// Мы будем использовать транзакции, чтобы добавить несколько студентов
 	tx, err := DB.Beginx()
@@ -144,7 +162,10 @@ func (c *Class) AddStudents(studentIDs *[]int32) error {
 	}
 
 	for _, studentID := range *studentIDs {
-		_, err := tx.Exec(`INSERT INTO student (student_id, class_id) VALUES ($1, $2)`, studentID, c.ID)
+		err := checkStudent(tx, studentID)
+		if err == nil {
+			_, err = tx.Exec(`INSERT INTO student (student_id, class_id) VALUES ($1, $2)`, studentID, c.ID)
+		}
 		if err != nil {
 			rollbackErr := tx.Rollback()
 			if rollbackErr != nil {
@@ -2,12 +2,13 @@ package class
 
 import (
 	"api/account"
+	"api/authz"
 	"api/config"
 	"api/utils"
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
-	"log"
 	"net/http"
 	"strconv"
 )
@@ -40,78 +41,109 @@ func ConvertToInt32Slice(ints []int) []int32 {
 }
 
 type PutClassData struct {
-	TeacherPassword string `json:"teacher_password"`
-	StudentIDs      []int  `json:"student_ids"`
+	StudentIDs []int `json:"student_ids"`
 }
 
 type DeleteClassData struct {
-	TeacherPassword string `json:"teacher_password"`
-	StudentIDs      *[]int `json:"student_ids,omitempty"`
+	StudentIDs *[]int `json:"student_ids,omitempty"`
 }
 
-type GetClassesData struct {
-	Password string `json:"password"`
+type PostClassData struct {
+	Class NewClassData `json:"class"`
 }
 
-type PostClassData struct {
-	TeacherPassword string       `json:"teacher_password"`
-	Class           NewClassData `json:"class"`
+type GetInviteData struct {
+	Code string `json:"code"`
+	// Link - путь для вступления по коду, см. joinClass
+	Link            string `json:"link"`
+	RequireApproval bool   `json:"require_approval"`
+	ExpireTime      *int64 `json:"expire_time"`
+	MaxUses         *int32 `json:"max_uses"`
+	Uses            int32  `json:"uses"`
+	CreateTime      int64  `json:"create_time"`
+}
+
+func NewGetInviteData(invite *Invite) GetInviteData {
+	data := GetInviteData{
+		Code:            invite.Code,
+		Link:            fmt.Sprintf("%s/class/join/%s", config.BaseURL, invite.Code),
+		RequireApproval: invite.RequireApproval,
+		MaxUses:         invite.MaxUses,
+		Uses:            invite.Uses,
+		CreateTime:      invite.CreateTime.UnixMilli(),
+	}
+	if invite.ExpireTime != nil {
+		t := invite.ExpireTime.UnixMilli()
+		data.ExpireTime = &t
+	}
+	return data
+}
+
+type GetJoinRequestData struct {
+	StudentID  int32  `json:"student_id"`
+	Username   string `json:"username"`
+	CreateTime int64  `json:"create_time"`
 }
 
 func classInfo(c *gin.Context) {
 	info := `
 GET /class/<id> - get class by id
+Authorization: Bearer <access_token> - required (teacher or student of the class)
 
 POST /class - create a new class
-teacher_password: String - required
+Authorization: Bearer <access_token> - required
 class: {
-	teacher_id: i32 - required
+	teacher_id: i32 - optional (must match the caller)
 	name: String - required
 	student_ids: Vec<i32> - optional
 }
 
 PUT /class/<id> - add students to a class
-teacher_password: String - required
+Authorization: Bearer <access_token> - required
 student_ids: Vec<i32> - required
 
 DELETE /class/<id> - delete a class
-teacher_password: String - required
+Authorization: Bearer <access_token> - required
 
 DELETE /class/<id> - remove students from class
-teacher_password: String - required
+Authorization: Bearer <access_token> - required
 student_ids: Vec<i32> - required
+
+GET /class/<id>/invite - get the class invite code
+Authorization: Bearer <access_token> - required (teacher of the class)
+
+POST /class/<id>/invite - create a new invite code, replacing the old one
+Authorization: Bearer <access_token> - required (teacher of the class)
+require_approval: bool - optional (joins wait in /class/<id>/requests)
+expire_time: i64 - optional (ms since epoch)
+max_uses: i32 - optional
+
+DELETE /class/<id>/invite - revoke the invite code
+Authorization: Bearer <access_token> - required (teacher of the class)
+
+POST /class/join/<code> - join a class by invite code
+Authorization: Bearer <access_token> - required (student)
+200 - joined, 202 - waiting for approval, 404 - unknown code, 410 - expired or used up, 409 - already in class
+
+GET /class/<id>/requests - pending join requests
+Authorization: Bearer <access_token> - required (teacher of the class)
+
+POST /class/<id>/requests/<student_id>/accept - accept a join request
+Authorization: Bearer <access_token> - required (teacher of the class)
+
+DELETE /class/<id>/requests/<student_id> - reject a join request
+Authorization: Bearer <access_token> - required (teacher of the class)
 `
 	c.String(http.StatusOK, info)
 }
 
 func getClassByID(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.Atoi(idStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+	classObj, ok := classFromParam(c)
+	if !ok {
 		return
 	}
-
-	classData, err := GetByID(int32(id))
-	if err != nil {
-		status := utils.DbErrToStatus(err, http.StatusNotFound)
-		c.JSON(status, gin.H{"error": err.Error()})
-		return
-	}
-
-	newClassData := &NewClassData{
-		TeacherID:  classData.TeacherID,
-		Name:       classData.Name,
-		StudentIDs: nil,
-	}
-
-	responseData, err := FromClassData(newClassData)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
-		return
-	}
-
-	c.JSON(http.StatusOK, responseData)
+	// Чтение не должно создавать классы: FromClassData вставляет новую запись
+	c.JSON(http.StatusOK, ConvertToGetClassData(classObj))
 }
 
 func CreateClass(c *gin.Context) {
@@ -121,25 +153,19 @@ func CreateClass(c *gin.Context) {
 		return
 	}
 
-	acct, err := account.GetByID(classData.Class.TeacherID)
-	if err != nil {
-		log.Printf("Error getting account by ID: %v", classData.Class.TeacherID) // Логирование ошибки
-		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
-		return
-	}
-
-	if valid, err := acct.VerifyPassword(classData.TeacherPassword); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
-		return
-	} else if !valid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid password"})
+	// Создать класс для другого учителя может только администратор
+	acct := account.Current(c)
+	if classData.Class.TeacherID == 0 {
+		classData.Class.TeacherID = acct.ID
+	} else if classData.Class.TeacherID != acct.ID && !acct.IsAdmin() {
+		authz.Forbidden(c)
 		return
 	}
 
 	classObj, err := FromClassData(&classData.Class)
 	if err != nil {
-		var customErr *Error
-		if errors.As(err, &customErr) { // Проверяем, может ли ошибка быть приведена к типу *Error
+		var customErr Error
+		if errors.As(err, &customErr) { // Проверяем, может ли ошибка быть приведена к типу Error
 			c.JSON(http.StatusBadRequest, gin.H{"error": customErr})
 			return
 		}
@@ -166,32 +192,16 @@ func addStudents(c *gin.Context) {
 		return
 	}
 
-	acct, err := account.GetByID(classData.TeacherID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
-		return
-	}
-
 	var putClassData PutClassData
 	if err := c.BindJSON(&putClassData); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input format"})
 		return
 	}
 
-	isValid, err := acct.VerifyPassword(putClassData.TeacherPassword)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
-		return
-	}
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
 	studentIDs32 := ConvertToInt32Slice(putClassData.StudentIDs)
 
 	err = classData.AddStudents(&studentIDs32)
-	var customErr *Error
+	var customErr Error
 	if errors.As(err, &customErr) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": customErr.Error()})
 		return
@@ -217,26 +227,13 @@ func DeleteClass(c *gin.Context) {
 		return
 	}
 
-	acct, err := account.GetByID(classData.TeacherID)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
+	// Тело необязательно: без student_ids удаляется весь класс
 	var deleteClassData DeleteClassData
-	if err := c.BindJSON(&deleteClassData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
-		return
-	}
-
-	if ok, err := acct.VerifyPassword(deleteClassData.TeacherPassword); err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	} else if !ok {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid password"})
-		return
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&deleteClassData); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+			return
+		}
 	}
 
 	if studentIDs := deleteClassData.StudentIDs; studentIDs != nil {
@@ -272,26 +269,9 @@ func GetAccountClasses(c *gin.Context) {
 		return
 	}
 
-	var getClassesData GetClassesData
-	if err := c.BindJSON(&getClassesData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data"})
-		return
-	}
-
 	acct, err := account.GetByID(int32(id))
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Account not found"})
-		return
-	}
-
-	isValid, err := acct.VerifyPassword(getClassesData.Password)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify password"})
-		return
-	}
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid password"})
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Account not found"})
 		return
 	}
 
@@ -316,19 +296,144 @@ func GetAccountClasses(c *gin.Context) {
 	}
 
 	var classDatas []GetClassData
-	for _, class := range classes {
-		newClassData := ConvertToNewClassData(class)
-		data, err := FromClassData(newClassData)
-		if err != nil {
-			utils.InternalErr(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// Чтение не должно создавать классы: FromClassData вставляет новую запись
+	for i := range classes {
+		classDatas = append(classDatas, ConvertToGetClassData(&classes[i]))
+	}
+
+	c.JSON(http.StatusOK, classDatas)
+}
+
+// classFromParam загружает класс из пути. При ошибке ответ уже записан.
+func classFromParam(c *gin.Context) (*Class, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return nil, false
+	}
+	classObj, err := GetByID(int32(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Class not found"})
+		return nil, false
+	}
+	return classObj, true
+}
+
+func getInvite(c *gin.Context) {
+	classObj, ok := classFromParam(c)
+	if !ok {
+		return
+	}
+	invite, err := classObj.GetInvite()
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "No invite"})
+		return
+	}
+	c.JSON(http.StatusOK, NewGetInviteData(invite))
+}
+
+func createInvite(c *gin.Context) {
+	var data NewInviteData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data"})
+		return
+	}
+	classObj, ok := classFromParam(c)
+	if !ok {
+		return
+	}
+
+	invite, err := classObj.CreateInvite(data)
+	if err != nil {
+		var customErr Error
+		if errors.As(err, &customErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": customErr.Error()})
 			return
 		}
-		getClassData := ConvertToGetClassData(data)
-		classDatas = append(classDatas, getClassData)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
 	}
+	c.JSON(http.StatusCreated, NewGetInviteData(invite))
+}
 
-	c.JSON(http.StatusOK, classDatas)
+func revokeInvite(c *gin.Context) {
+	classObj, ok := classFromParam(c)
+	if !ok {
+		return
+	}
+	if err := classObj.RevokeInvite(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func joinClass(c *gin.Context) {
+	classID, pending, err := JoinByInvite(c.Param("code"), account.Current(c).ID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown invite code"})
+	case errors.Is(err, InviteNotUsable):
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	case errors.Is(err, AlreadyInClass):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.As(err, new(Error)):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+	case pending:
+		c.JSON(http.StatusAccepted, gin.H{"class_id": classID, "status": "pending"})
+	default:
+		c.JSON(http.StatusOK, gin.H{"class_id": classID, "status": "joined"})
+	}
+}
+
+func getJoinRequests(c *gin.Context) {
+	classObj, ok := classFromParam(c)
+	if !ok {
+		return
+	}
+	requests, err := classObj.GetJoinRequests()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": utils.InternalErr(err)})
+		return
+	}
+	result := make([]GetJoinRequestData, 0, len(requests))
+	for _, r := range requests {
+		result = append(result, GetJoinRequestData{StudentID: r.StudentID, Username: r.Username, CreateTime: r.CreateTime.UnixMilli()})
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// resolveJoinRequest принимает или отклоняет заявку ученика из пути.
+func resolveJoinRequest(accept bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		classObj, ok := classFromParam(c)
+		if !ok {
+			return
+		}
+		studentID, err := strconv.ParseInt(c.Param("studentID"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid student ID"})
+			return
+		}
+
+		if accept {
+			err = classObj.AcceptJoinRequest(int32(studentID))
+		} else {
+			err = classObj.RejectJoinRequest(int32(studentID))
+		}
+		var customErr Error
+		if errors.As(err, &customErr) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": customErr.Error()})
+			return
+		}
+		if err != nil {
+			c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Join request not found"})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
 }
 
 // RegisterRoutes добавляет маршруты модуля class к роутеру Gin.
@@ -336,13 +441,25 @@ func RegisterRoutes(r *gin.Engine) {
 	classGroup := r.Group(config.BaseURL + "/class")
 	{
 		classGroup.GET("", classInfo)
-		classGroup.POST("", CreateClass)
-		classGroup.GET("/:id", getClassByID)
-		classGroup.PUT("/:id", addStudents)
-		classGroup.DELETE("/:id", DeleteClass)
+		classID := authz.Param("id")
+		ownsClass := authz.Require(authz.TeacherOwnsClass(classID))
+		classGroup.POST("", account.RequireAuth(), authz.Require(authz.Role(account.Teacher.String())), CreateClass)
+		classGroup.GET("/:id", account.RequireAuth(), authz.Require(authz.Any(authz.TeacherOwnsClass(classID), authz.StudentInClass(classID))), getClassByID)
+		classGroup.PUT("/:id", account.RequireAuth(), ownsClass, addStudents)
+		classGroup.DELETE("/:id", account.RequireAuth(), ownsClass, DeleteClass)
+
+		classGroup.GET("/:id/invite", account.RequireAuth(), ownsClass, getInvite)
+		classGroup.POST("/:id/invite", account.RequireAuth(), ownsClass, createInvite)
+		classGroup.DELETE("/:id/invite", account.RequireAuth(), ownsClass, revokeInvite)
+		classGroup.POST("/join/:code", account.RequireAuth(), authz.Require(authz.Role(account.Student.String())), joinClass)
+		classGroup.GET("/:id/requests", account.RequireAuth(), ownsClass, getJoinRequests)
+		classGroup.POST("/:id/requests/:studentID/accept", account.RequireAuth(), ownsClass, resolveJoinRequest(true))
+		classGroup.DELETE("/:id/requests/:studentID", account.RequireAuth(), ownsClass, resolveJoinRequest(false))
 	}
 	accountGroup := r.Group(config.BaseURL + "/account")
 	{
-		accountGroup.GET("/:accountParam/classes", GetAccountClasses)
+		// Родитель с подтверждённой связью может читать данные ученика, см. пакет parent
+		owner := authz.Param("accountParam")
+		accountGroup.GET("/:accountParam/classes", account.RequireAuth(), authz.Require(authz.Any(authz.Self(owner), authz.ParentOfStudent(owner))), GetAccountClasses)
 	}
 }
@@ -1,46 +1,141 @@
 package crypto
 
 import (
+	"crypto/rand"
 	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/hex"
-	"math/rand"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"net/mail"
+	"strings"
 	"unicode"
 )
 
-const maxIterations = 127
+// Params задаёт стоимость argon2id. Значения по умолчанию соответствуют рекомендациям OWASP,
+// main переопределяет их из config.toml.
+type Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
 
-func EncodePassword(password string) string {
-	hasher := sha512.New()
-	hasher.Write([]byte(password))
-	bytes := hasher.Sum(nil)
+var Argon2Params = Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
 
-	iterations := rand.Intn(maxIterations)
-	for i := 0; i < iterations; i++ {
-		hasher.Reset()
-		hasher.Write(bytes)
-		bytes = hasher.Sum(nil)
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// legacyMaxIterations - верхняя граница числа раундов SHA-512 в старой схеме хеширования.
+const legacyMaxIterations = 127
+
+// EncodePassword хеширует пароль argon2id и возвращает строку в формате PHC:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+func EncodePassword(password string) (string, error) {
+	params := Argon2Params
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
 	}
 
-	return hex.EncodeToString(bytes)
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
 }
 
-func VerifyPassword(password, passwordHash string) bool {
+func decodeHash(passwordHash string) (Params, []byte, []byte, error) {
+	var params Params
+
+	parts := strings.Split(passwordHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+// isLegacyHash проверяет, что хеш записан старой схемой (hex SHA-512 без соли).
+func isLegacyHash(passwordHash string) bool {
+	return !strings.HasPrefix(passwordHash, "$")
+}
+
+func verifyLegacyPassword(password, passwordHash string) bool {
 	hasher := sha512.New()
 	hasher.Write([]byte(password))
 	bytes := hasher.Sum(nil)
 
-	for i := 0; i < maxIterations; i++ {
+	for i := 0; i <= legacyMaxIterations; i++ {
+		if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(bytes)), []byte(passwordHash)) == 1 {
+			return true
+		}
+
 		hasher.Reset()
 		hasher.Write(bytes)
 		bytes = hasher.Sum(nil)
-
-		if hex.EncodeToString(bytes) == passwordHash {
-			return true
-		}
 	}
 	return false
 }
 
+// VerifyPassword сверяет пароль с хешем в формате PHC или со старым хешем SHA-512.
+func VerifyPassword(password, passwordHash string) bool {
+	passwordHash = strings.TrimSpace(passwordHash)
+	if isLegacyHash(passwordHash) {
+		return verifyLegacyPassword(password, passwordHash)
+	}
+
+	params, salt, key, err := decodeHash(passwordHash)
+	if err != nil {
+		return false
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, otherKey) == 1
+}
+
+// NeedsRehash сообщает, что хеш записан старой схемой или с устаревшими параметрами.
+func NeedsRehash(passwordHash string) bool {
+	passwordHash = strings.TrimSpace(passwordHash)
+	if isLegacyHash(passwordHash) {
+		return true
+	}
+
+	params, _, _, err := decodeHash(passwordHash)
+	if err != nil {
+		return true
+	}
+	return params != Argon2Params
+}
+
 func ValidatePassword(password string) bool {
 	if len(password) < 8 {
 		return false
@@ -79,3 +174,12 @@ func ValidateUsername(username string) bool {
 
 	return true
 }
+
+// ValidateEmail принимает только голый адрес вида user@example.com, без имени и угловых скобок.
+func ValidateEmail(email string) bool {
+	if len(email) > 254 {
+		return false
+	}
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email
+}
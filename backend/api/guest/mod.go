@@ -0,0 +1,117 @@
+package guest
+
+import (
+	"api/limiter"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+var DB *sqlx.DB
+
+// TTL - сколько действует гостевой токен, переопределяется из config.toml в main.
+var TTL = 6 * time.Hour
+
+// Policy ограничивает, сколько гостей можно создать с одного IP. Каждое создание считается
+// попыткой limiter.Limiter: после FreeAttempts за Window следующие откладываются.
+var Policy = limiter.Policy{
+	FreeAttempts: 10,
+	BaseDelay:    time.Minute,
+	MaxDelay:     15 * time.Minute,
+	Window:       10 * time.Minute,
+}
+
+// Limiter считает созданных гостей по IP. main заменяет его на limiter.PostgresLimiter
+// вместе со счётчиками блокировок входа.
+var Limiter limiter.Limiter = limiter.NewMemoryLimiter(Policy)
+
+var ErrInvalidToken = errors.New("invalid guest token")
+
+// Guest - участник без аккаунта. Он существует только в пределах Scope (одна живая сессия
+// или одна публичная викторина) и до ExpireTime.
+type Guest struct {
+	ID         int32     `db:"id"`
+	TokenHash  string    `db:"token_hash"`
+	Nickname   string    `db:"nickname"`
+	Scope      string    `db:"scope"`
+	CreateTime time.Time `db:"create_time"`
+	ExpireTime time.Time `db:"expire_time"`
+}
+
+// LiveScope и QwizScope - области действия гостя: живая сессия по коду или решение викторины.
+func LiveScope(code string) string  { return "live:" + code }
+func QwizScope(qwizID int32) string { return fmt.Sprintf("qwiz:%d", qwizID) }
+
+// ParticipantID - id гостя среди участников. id аккаунтов положительные, у гостей - отрицательные,
+// поэтому они не пересекаются.
+func (g *Guest) ParticipantID() int32 {
+	return -g.ID
+}
+
+// IsGuestID сообщает, что id участника принадлежит гостю, а не аккаунту.
+func IsGuestID(participantID int32) bool {
+	return participantID < 0
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// New создаёт гостя с проверенным ником и возвращает его вместе с токеном.
+// Истёкшие гости удаляются заодно.
+func New(nickname, scope string) (*Guest, string, error) {
+	nickname, err := CheckNickname(nickname)
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now().UTC()
+	if _, err := DB.Exec("DELETE FROM guest WHERE expire_time <= $1", now); err != nil {
+		return nil, "", err
+	}
+	guest := &Guest{}
+	err = DB.Get(guest, `
+		INSERT INTO guest (token_hash, nickname, scope, create_time, expire_time) VALUES ($1, $2, $3, $4, $5)
+		RETURNING *
+	`, hashToken(token), nickname, scope, now, now.Add(TTL))
+	if err != nil {
+		return nil, "", err
+	}
+	return guest, token, nil
+}
+
+// GetByToken находит действующего гостя области scope.
+func GetByToken(token, scope string) (*Guest, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	guest := &Guest{}
+	err := DB.Get(guest, "SELECT * FROM guest WHERE token_hash=$1 AND scope=$2 AND expire_time > $3",
+		hashToken(token), scope, time.Now().UTC())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
@@ -0,0 +1,74 @@
+package guest
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+const MaxNicknameLength = 30
+
+var (
+	ErrInvalidNickname = errors.New("invalid nickname")
+	ErrBlockedNickname = errors.New("nickname is not allowed")
+)
+
+// BlockedWords - недопустимые части ника, ReservedNicknames - ники, под которыми гость
+// выдавал бы себя за ведущего. Оба списка дополняются из config.toml (guest.blocked_words).
+var (
+	BlockedWords = []string{
+		"fuck", "shit", "bitch", "nigger", "faggot", "whore",
+		"хуй", "пизд", "ебат", "ебан", "мудак", "пидор", "блядь",
+	}
+	ReservedNicknames = []string{"admin", "administrator", "moderator", "teacher", "host", "qwiz"}
+)
+
+// Цифры и символы, которыми обычно заменяют буквы, чтобы обойти фильтр.
+var leet = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '@': 'a', '$': 's', '!': 'i',
+}
+
+// normalize приводит ник к виду для сравнения со списками: нижний регистр, замены букв
+// раскрыты, всё, кроме букв, отброшено.
+func normalize(nickname string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(nickname) {
+		if replacement, ok := leet[r]; ok {
+			r = replacement
+		}
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// CheckNickname проверяет ник гостя и возвращает его без пробелов по краям.
+func CheckNickname(nickname string) (string, error) {
+	nickname = strings.TrimSpace(nickname)
+	length := len([]rune(nickname))
+	if length == 0 || length > MaxNicknameLength {
+		return "", ErrInvalidNickname
+	}
+	for _, r := range nickname {
+		if !unicode.IsPrint(r) {
+			return "", ErrInvalidNickname
+		}
+	}
+
+	normalized := normalize(nickname)
+	if normalized == "" {
+		return "", ErrInvalidNickname
+	}
+	for _, reserved := range ReservedNicknames {
+		if normalized == normalize(reserved) {
+			return "", ErrBlockedNickname
+		}
+	}
+	for _, word := range BlockedWords {
+		if strings.Contains(normalized, normalize(word)) {
+			return "", ErrBlockedNickname
+		}
+	}
+	return nickname, nil
+}
@@ -0,0 +1,71 @@
+package guest
+
+import (
+	"api/utils"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+type PostGuestData struct {
+	Nickname string `json:"nickname" binding:"required"`
+}
+
+type GetGuestData struct {
+	ID         int32  `json:"id"`
+	Nickname   string `json:"nickname"`
+	GuestToken string `json:"guest_token,omitempty"`
+	ExpireTime int64  `json:"expire_time"`
+}
+
+func FromGuest(guest *Guest) GetGuestData {
+	return GetGuestData{
+		ID:         guest.ParticipantID(),
+		Nickname:   guest.Nickname,
+		ExpireTime: guest.ExpireTime.UnixMilli(),
+	}
+}
+
+// Create - обработчик входа гостем в область scope. Область проверяет вызывающий модуль
+// (сессия существует, викторина публичная). Создание гостей ограничено по IP клиента.
+func Create(c *gin.Context, scope string) {
+	var data PostGuestData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Попыткой считается любой запрос, иначе ники можно было бы подбирать под фильтр без ограничений
+	key := "guest:" + c.ClientIP()
+	retryAfter, err := Limiter.Check(key)
+	if err == nil && retryAfter == 0 {
+		_, err = Limiter.Fail(key)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	if retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many attempts"})
+		return
+	}
+
+	guest, token, err := New(data.Nickname, scope)
+	if errors.Is(err, ErrInvalidNickname) || errors.Is(err, ErrBlockedNickname) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+
+	result := FromGuest(guest)
+	result.GuestToken = token
+	c.JSON(http.StatusCreated, result)
+}
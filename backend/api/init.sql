@@ -37,12 +37,25 @@ COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UU
 CREATE TYPE public.account_type AS ENUM (
     'student',
     'teacher',
-    'parent'
+    'parent',
+    'admin'
 );
 
 
 ALTER TYPE public.account_type OWNER TO qwiz;
 
+--
+-- Name: mail_token_purpose; Type: TYPE; Schema: public; Owner: qwiz
+--
+
+CREATE TYPE public.mail_token_purpose AS ENUM (
+    'verify_email',
+    'reset_password'
+);
+
+
+ALTER TYPE public.mail_token_purpose OWNER TO qwiz;
+
 --
 -- Name: media_type; Type: TYPE; Schema: public; Owner: qwiz
 --
@@ -58,87 +71,71 @@ CREATE TYPE public.media_type AS ENUM (
 ALTER TYPE public.media_type OWNER TO qwiz;
 
 --
--- Name: check_student_class_func(); Type: FUNCTION; Schema: public; Owner: qwiz
+-- Name: parent_link_status; Type: TYPE; Schema: public; Owner: qwiz
 --
 
-CREATE FUNCTION public.check_student_class_func() RETURNS trigger
-    LANGUAGE plpgsql
-    AS $$
-
-declare
-type account_type;
-class integer;
-begin
-
-select account_type into type from account where id=NEW."student_id";
-select class_id into class from assignment where id=NEW."assignment_id";
-
-if type!='student' then
-raise exception 'User with id % is not a student', NEW."student_id";
-elsif NEW."student_id" NOT IN (SELECT student_id FROM student WHERE class_id=class) then
-raise exception 'User with id % is not in class id %', NEW."student_id", class;
-end if;
-
-return NEW;
-
-end;
-
-$$;
+CREATE TYPE public.parent_link_status AS ENUM (
+    'pending',
+    'accepted'
+);
 
 
-ALTER FUNCTION public.check_student_class_func() OWNER TO qwiz;
+ALTER TYPE public.parent_link_status OWNER TO qwiz;
 
 --
--- Name: check_student_func(); Type: FUNCTION; Schema: public; Owner: qwiz
+-- Name: question_type; Type: TYPE; Schema: public; Owner: qwiz
 --
 
-CREATE FUNCTION public.check_student_func() RETURNS trigger
-    LANGUAGE plpgsql
-    AS $$
-
-declare
-type account_type;
-begin
+CREATE TYPE public.question_type AS ENUM (
+    'single',
+    'multiple',
+    'true_false',
+    'text',
+    'numeric',
+    'ordering',
+    'matching'
+);
 
-select account_type into type from account where id=NEW."student_id";
-if type!='student' then
-raise exception 'User with id % is not a student', NEW."student_id";
-end if;
 
-return NEW;
+ALTER TYPE public.question_type OWNER TO qwiz;
 
-end;
+--
+-- Name: reveal_policy; Type: TYPE; Schema: public; Owner: qwiz
+--
 
-$$;
+CREATE TYPE public.reveal_policy AS ENUM (
+    'never',
+    'after_submit',
+    'after_assignment_close'
+);
 
 
-ALTER FUNCTION public.check_student_func() OWNER TO qwiz;
+ALTER TYPE public.reveal_policy OWNER TO qwiz;
 
 --
--- Name: check_teacher_func(); Type: FUNCTION; Schema: public; Owner: qwiz
+-- Name: score_policy; Type: TYPE; Schema: public; Owner: qwiz
 --
 
-CREATE FUNCTION public.check_teacher_func() RETURNS trigger
-    LANGUAGE plpgsql
-    AS $$
-
-declare
-type account_type;
-begin
+CREATE TYPE public.score_policy AS ENUM (
+    'best',
+    'last',
+    'average'
+);
 
-select account_type into type from account where id=NEW."teacher_id";
-if type!='teacher' then
-raise exception 'User with id % is not a teacher', NEW."teacher_id";
-end if;
 
-return NEW;
+ALTER TYPE public.score_policy OWNER TO qwiz;
 
-end;
+--
+-- Name: token_kind; Type: TYPE; Schema: public; Owner: qwiz
+--
 
-$$;
+CREATE TYPE public.token_kind AS ENUM (
+    'access',
+    'refresh'
+);
 
 
-ALTER FUNCTION public.check_teacher_func() OWNER TO qwiz;
+ALTER TYPE public.token_kind OWNER TO qwiz;
 
 --
 -- Name: delete_embed_func(); Type: FUNCTION; Schema: public; Owner: qwiz
@@ -224,9 +221,11 @@ SET default_table_access_method = heap;
 CREATE TABLE public.account (
                                 id integer NOT NULL,
                                 username character varying(20) NOT NULL,
-                                password_hash character(128) NOT NULL,
+                                password_hash character varying(255) NOT NULL,
                                 profile_picture_uuid uuid,
-                                account_type public.account_type NOT NULL
+                                account_type public.account_type NOT NULL,
+                                email character varying(254),
+                                email_verified boolean DEFAULT false NOT NULL
 );
 
 
@@ -246,6 +245,24 @@ ALTER TABLE public.account ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
 );
 
 
+--
+-- Name: answer; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.answer (
+                               qwiz_id integer NOT NULL,
+                               question_index integer NOT NULL,
+                               "position" smallint NOT NULL,
+                               body character varying(200) NOT NULL,
+                               correct boolean DEFAULT false NOT NULL,
+                               embed_uuid uuid,
+                               match character varying(200),
+                               CONSTRAINT position_check CHECK (("position" >= 0))
+);
+
+
+ALTER TABLE public.answer OWNER TO qwiz;
+
 --
 -- Name: assignment; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -255,7 +272,16 @@ CREATE TABLE public.assignment (
                                    qwiz_id integer NOT NULL,
                                    class_id integer NOT NULL,
                                    open_time timestamp without time zone,
-                                   close_time timestamp without time zone
+                                   close_time timestamp without time zone,
+                                   pass_threshold double precision DEFAULT 1 NOT NULL,
+                                   max_attempts integer,
+                                   score_policy public.score_policy DEFAULT 'best'::public.score_policy NOT NULL,
+                                   duration integer,
+                                   late_penalty double precision,
+                                   CONSTRAINT duration_check CHECK ((duration > 0)),
+                                   CONSTRAINT late_penalty_check CHECK (((late_penalty >= (0)::double precision) AND (late_penalty <= (1)::double precision))),
+                                   CONSTRAINT max_attempts_check CHECK ((max_attempts > 0)),
+                                   CONSTRAINT pass_threshold_check CHECK (((pass_threshold >= (0)::double precision) AND (pass_threshold <= (1)::double precision)))
 );
 
 
@@ -275,6 +301,117 @@ ALTER TABLE public.assignment ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
 );
 
 
+--
+-- Name: attempt; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.attempt (
+                                id integer NOT NULL,
+                                qwiz_id integer NOT NULL,
+                                seed bigint NOT NULL,
+                                shuffle_questions boolean NOT NULL,
+                                shuffle_answers boolean NOT NULL,
+                                start_time timestamp without time zone NOT NULL,
+                                account_id integer,
+                                guest_id integer,
+                                display_name character varying(30),
+                                assignment_id integer,
+                                submit_time timestamp without time zone,
+                                question_indexes integer[],
+                                answers jsonb,
+                                scores double precision[],
+                                score double precision,
+                                max_score double precision,
+                                penalty double precision DEFAULT 0 NOT NULL,
+                                layouts jsonb
+);
+
+
+ALTER TABLE public.attempt OWNER TO qwiz;
+
+--
+-- Name: attempt_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.attempt ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.attempt_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
+--
+-- Name: audit_log; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.audit_log (
+                                  id bigint NOT NULL,
+                                  "time" timestamp without time zone NOT NULL,
+                                  event character varying(50) NOT NULL,
+                                  account_id integer,
+                                  ip character varying(45) NOT NULL,
+                                  details text DEFAULT ''::text NOT NULL
+);
+
+
+ALTER TABLE public.audit_log OWNER TO qwiz;
+
+--
+-- Name: audit_log_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.audit_log ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.audit_log_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
+--
+-- Name: broker_claim; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.broker_claim (
+                                     key character varying(100) NOT NULL,
+                                     expire_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.broker_claim OWNER TO qwiz;
+
+--
+-- Name: broker_message; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.broker_message (
+                                       id bigint NOT NULL,
+                                       payload bytea NOT NULL,
+                                       create_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.broker_message OWNER TO qwiz;
+
+--
+-- Name: broker_message_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.broker_message ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.broker_message_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
 --
 -- Name: class; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -302,6 +439,37 @@ ALTER TABLE public.class ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
 );
 
 
+--
+-- Name: class_invite; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.class_invite (
+                                   class_id integer NOT NULL,
+                                   code character varying(16) NOT NULL,
+                                   require_approval boolean DEFAULT false NOT NULL,
+                                   expire_time timestamp without time zone,
+                                   max_uses integer,
+                                   uses integer DEFAULT 0 NOT NULL,
+                                   create_time timestamp without time zone NOT NULL,
+                                   CONSTRAINT max_uses_check CHECK ((max_uses > 0))
+);
+
+
+ALTER TABLE public.class_invite OWNER TO qwiz;
+
+--
+-- Name: class_join_request; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.class_join_request (
+                                           class_id integer NOT NULL,
+                                           student_id integer NOT NULL,
+                                           create_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.class_join_request OWNER TO qwiz;
+
 --
 -- Name: completed_assignment; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -314,6 +482,167 @@ CREATE TABLE public.completed_assignment (
 
 ALTER TABLE public.completed_assignment OWNER TO qwiz;
 
+--
+-- Name: guest; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.guest (
+                              id integer NOT NULL,
+                              token_hash character(64) NOT NULL,
+                              nickname character varying(30) NOT NULL,
+                              scope character varying(50) NOT NULL,
+                              create_time timestamp without time zone NOT NULL,
+                              expire_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.guest OWNER TO qwiz;
+
+--
+-- Name: guest_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.guest ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.guest_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
+--
+-- Name: live_answer; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.live_answer (
+                                    participant_id integer NOT NULL,
+                                    question_number smallint NOT NULL,
+                                    choice smallint,
+                                    correct boolean NOT NULL,
+                                    response_time integer,
+                                    choices smallint[],
+                                    text_answer character varying(200),
+                                    numeric_answer double precision,
+                                    sequence smallint[]
+);
+
+
+ALTER TABLE public.live_answer OWNER TO qwiz;
+
+--
+-- Name: live_participant; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.live_participant (
+                                         id integer NOT NULL,
+                                         session_id integer NOT NULL,
+                                         account_id integer,
+                                         display_name character varying(30) NOT NULL,
+                                         score integer NOT NULL
+);
+
+
+ALTER TABLE public.live_participant OWNER TO qwiz;
+
+--
+-- Name: live_participant_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.live_participant ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.live_participant_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
+--
+-- Name: live_question; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.live_question (
+                                      session_id integer NOT NULL,
+                                      number smallint NOT NULL,
+                                      body character varying(500) NOT NULL,
+                                      answers character varying(200)[] NOT NULL,
+                                      correct smallint,
+                                      time_limit integer,
+                                      question_type public.question_type DEFAULT 'single'::public.question_type NOT NULL,
+                                      correct_answers smallint[],
+                                      accepted_answers character varying(200)[],
+                                      numeric_answer double precision,
+                                      tolerance double precision DEFAULT 0 NOT NULL,
+                                      matches character varying(200)[]
+);
+
+
+ALTER TABLE public.live_question OWNER TO qwiz;
+
+--
+-- Name: live_session; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.live_session (
+                                     id integer NOT NULL,
+                                     code character(6) NOT NULL,
+                                     qwiz_id integer NOT NULL,
+                                     host_id integer NOT NULL,
+                                     scoring character varying(20) NOT NULL,
+                                     streak_bonus boolean DEFAULT false NOT NULL,
+                                     create_time timestamp without time zone NOT NULL,
+                                     finish_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.live_session OWNER TO qwiz;
+
+--
+-- Name: live_session_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.live_session ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.live_session_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
+--
+-- Name: login_attempt; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.login_attempt (
+                                      key character varying(100) NOT NULL,
+                                      failures integer NOT NULL,
+                                      last_failure timestamp without time zone NOT NULL,
+                                      locked_until timestamp without time zone
+);
+
+
+ALTER TABLE public.login_attempt OWNER TO qwiz;
+
+--
+-- Name: mail_token; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.mail_token (
+                                   token_hash character(64) NOT NULL,
+                                   account_id integer NOT NULL,
+                                   purpose public.mail_token_purpose NOT NULL,
+                                   email character varying(254) NOT NULL,
+                                   expire_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.mail_token OWNER TO qwiz;
+
 --
 -- Name: media; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -327,6 +656,37 @@ CREATE TABLE public.media (
 
 ALTER TABLE public.media OWNER TO qwiz;
 
+--
+-- Name: parent_link; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.parent_link (
+                                    id integer NOT NULL,
+                                    parent_id integer NOT NULL,
+                                    student_id integer NOT NULL,
+                                    status public.parent_link_status DEFAULT 'pending'::public.parent_link_status NOT NULL,
+                                    create_time timestamp without time zone NOT NULL,
+                                    accept_time timestamp without time zone,
+                                    accepted_by integer
+);
+
+
+ALTER TABLE public.parent_link OWNER TO qwiz;
+
+--
+-- Name: parent_link_id_seq; Type: SEQUENCE; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE public.parent_link ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.parent_link_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
+
+
 --
 -- Name: question; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -335,15 +695,17 @@ CREATE TABLE public.question (
                                  qwiz_id integer NOT NULL,
                                  index integer NOT NULL,
                                  body character varying(500) NOT NULL,
-                                 answer1 character varying(200) NOT NULL,
-                                 answer2 character varying(200) NOT NULL,
-                                 answer3 character varying(200),
-                                 answer4 character varying(200),
-                                 correct smallint NOT NULL,
                                  embed_uuid uuid,
-                                 CONSTRAINT correct_check CHECK (((correct >= 1) AND (((correct <= 4) AND (answer4 IS NOT NULL)) OR ((correct <= 3) AND (answer3 IS NOT NULL)) OR (correct <= 2)))),
+                                 time_limit smallint,
+                                 question_type public.question_type DEFAULT 'single'::public.question_type NOT NULL,
+                                 accepted_answers character varying(200)[],
+                                 numeric_answer double precision,
+                                 tolerance double precision DEFAULT 0 NOT NULL,
+                                 weight double precision DEFAULT 1 NOT NULL,
                                  CONSTRAINT index_check CHECK ((index >= 0)),
-                                 CONSTRAINT question4_check CHECK (((answer4 IS NULL) OR (answer3 IS NOT NULL)))
+                                 CONSTRAINT question_type_check CHECK ((((question_type <> 'text'::public.question_type) OR (COALESCE(cardinality(accepted_answers), 0) > 0)) AND ((question_type <> 'numeric'::public.question_type) OR (numeric_answer IS NOT NULL)) AND (tolerance >= (0)::double precision))),
+                                 CONSTRAINT time_limit_check CHECK (((time_limit IS NULL) OR (time_limit > 0))),
+                                 CONSTRAINT weight_check CHECK ((weight > (0)::double precision))
 );
 
 
@@ -359,7 +721,10 @@ CREATE TABLE public.qwiz (
                              creator_id integer NOT NULL,
                              thumbnail_uuid uuid,
                              public boolean DEFAULT true NOT NULL,
-                             create_time timestamp without time zone DEFAULT (now() AT TIME ZONE 'UTC'::text) NOT NULL
+                             create_time timestamp without time zone DEFAULT (now() AT TIME ZONE 'UTC'::text) NOT NULL,
+                             shuffle_questions boolean DEFAULT false NOT NULL,
+                             shuffle_answers boolean DEFAULT false NOT NULL,
+                             reveal public.reveal_policy DEFAULT 'never'::public.reveal_policy NOT NULL
 );
 
 
@@ -379,6 +744,18 @@ ALTER TABLE public.qwiz ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (
 );
 
 
+--
+-- Name: recovery_code; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.recovery_code (
+                                      account_id integer NOT NULL,
+                                      code_hash character(64) NOT NULL
+);
+
+
+ALTER TABLE public.recovery_code OWNER TO qwiz;
+
 --
 -- Name: student; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -391,6 +768,36 @@ CREATE TABLE public.student (
 
 ALTER TABLE public.student OWNER TO qwiz;
 
+--
+-- Name: token; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.token (
+                              token_hash character(64) NOT NULL,
+                              session_uuid uuid NOT NULL,
+                              account_id integer NOT NULL,
+                              kind public.token_kind NOT NULL,
+                              expire_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.token OWNER TO qwiz;
+
+--
+-- Name: two_factor; Type: TABLE; Schema: public; Owner: qwiz
+--
+
+CREATE TABLE public.two_factor (
+                                   account_id integer NOT NULL,
+                                   secret character varying(64) NOT NULL,
+                                   enabled boolean DEFAULT false NOT NULL,
+                                   last_used_step bigint DEFAULT 0 NOT NULL,
+                                   create_time timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.two_factor OWNER TO qwiz;
+
 --
 -- Name: vote; Type: TABLE; Schema: public; Owner: qwiz
 --
@@ -419,6 +826,22 @@ ALTER TABLE ONLY public.account
     ADD CONSTRAINT account_username_key UNIQUE (username);
 
 
+--
+-- Name: account account_email_key; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.account
+    ADD CONSTRAINT account_email_key UNIQUE (email);
+
+
+--
+-- Name: answer answer_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_pkey PRIMARY KEY (qwiz_id, question_index, "position") DEFERRABLE;
+
+
 --
 -- Name: assignment assignment_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -427,6 +850,59 @@ ALTER TABLE ONLY public.assignment
     ADD CONSTRAINT assignment_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: attempt attempt_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.attempt
+    ADD CONSTRAINT attempt_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: attempt_account_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX attempt_account_id_idx ON public.attempt USING btree (account_id);
+
+
+--
+-- Name: attempt_qwiz_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX attempt_qwiz_id_idx ON public.attempt USING btree (qwiz_id);
+
+
+--
+-- Name: audit_log audit_log_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.audit_log
+    ADD CONSTRAINT audit_log_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: audit_log_account_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX audit_log_account_id_idx ON public.audit_log USING btree (account_id);
+
+
+--
+-- Name: broker_claim broker_claim_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.broker_claim
+    ADD CONSTRAINT broker_claim_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: broker_message broker_message_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.broker_message
+    ADD CONSTRAINT broker_message_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: class class_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -435,6 +911,129 @@ ALTER TABLE ONLY public.class
     ADD CONSTRAINT class_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: class_invite class_invite_code_key; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_invite
+    ADD CONSTRAINT class_invite_code_key UNIQUE (code);
+
+
+--
+-- Name: class_invite class_invite_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_invite
+    ADD CONSTRAINT class_invite_pkey PRIMARY KEY (class_id);
+
+
+--
+-- Name: class_join_request class_join_request_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_join_request
+    ADD CONSTRAINT class_join_request_pkey PRIMARY KEY (class_id, student_id);
+
+
+--
+-- Name: guest guest_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.guest
+    ADD CONSTRAINT guest_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: guest guest_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.guest
+    ADD CONSTRAINT guest_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: guest_expire_time_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX guest_expire_time_idx ON public.guest USING btree (expire_time);
+
+
+--
+-- Name: live_answer live_answer_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_answer
+    ADD CONSTRAINT live_answer_pkey PRIMARY KEY (participant_id, question_number);
+
+
+--
+-- Name: live_participant live_participant_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_participant
+    ADD CONSTRAINT live_participant_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: live_participant_session_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX live_participant_session_id_idx ON public.live_participant USING btree (session_id);
+
+
+--
+-- Name: live_question live_question_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_question
+    ADD CONSTRAINT live_question_pkey PRIMARY KEY (session_id, number);
+
+
+--
+-- Name: live_session live_session_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_session
+    ADD CONSTRAINT live_session_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: live_session_host_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX live_session_host_id_idx ON public.live_session USING btree (host_id);
+
+
+--
+-- Name: live_session_qwiz_id_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX live_session_qwiz_id_idx ON public.live_session USING btree (qwiz_id);
+
+
+--
+-- Name: login_attempt login_attempt_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.login_attempt
+    ADD CONSTRAINT login_attempt_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: login_attempt_last_failure_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX login_attempt_last_failure_idx ON public.login_attempt USING btree (last_failure);
+
+
+--
+-- Name: mail_token mail_token_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.mail_token
+    ADD CONSTRAINT mail_token_pkey PRIMARY KEY (token_hash);
+
+
 --
 -- Name: media media_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -443,6 +1042,22 @@ ALTER TABLE ONLY public.media
     ADD CONSTRAINT media_pkey PRIMARY KEY (uuid);
 
 
+--
+-- Name: parent_link parent_link_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.parent_link
+    ADD CONSTRAINT parent_link_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: parent_link parent_link_parent_id_student_id_key; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.parent_link
+    ADD CONSTRAINT parent_link_parent_id_student_id_key UNIQUE (parent_id, student_id);
+
+
 --
 -- Name: question question_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -459,6 +1074,14 @@ ALTER TABLE ONLY public.qwiz
     ADD CONSTRAINT qwiz_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: recovery_code recovery_code_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.recovery_code
+    ADD CONSTRAINT recovery_code_pkey PRIMARY KEY (account_id, code_hash);
+
+
 --
 -- Name: student student_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -468,32 +1091,41 @@ ALTER TABLE ONLY public.student
 
 
 --
--- Name: vote vote_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
+-- Name: token token_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
 
-ALTER TABLE ONLY public.vote
-    ADD CONSTRAINT vote_pkey PRIMARY KEY (voter_id, qwiz_id);
+ALTER TABLE ONLY public.token
+    ADD CONSTRAINT token_pkey PRIMARY KEY (token_hash);
+
+
+--
+-- Name: token_session_uuid_idx; Type: INDEX; Schema: public; Owner: qwiz
+--
+
+CREATE INDEX token_session_uuid_idx ON public.token USING btree (session_uuid);
 
 
 --
--- Name: completed_assignment check_student; Type: TRIGGER; Schema: public; Owner: qwiz
+-- Name: two_factor two_factor_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
 
-CREATE TRIGGER check_student BEFORE INSERT OR UPDATE ON public.completed_assignment FOR EACH ROW EXECUTE FUNCTION public.check_student_class_func();
+ALTER TABLE ONLY public.two_factor
+    ADD CONSTRAINT two_factor_pkey PRIMARY KEY (account_id);
 
 
 --
--- Name: student check_student; Type: TRIGGER; Schema: public; Owner: qwiz
+-- Name: vote vote_pkey; Type: CONSTRAINT; Schema: public; Owner: qwiz
 --
 
-CREATE TRIGGER check_student BEFORE INSERT OR UPDATE ON public.student FOR EACH ROW EXECUTE FUNCTION public.check_student_func();
+ALTER TABLE ONLY public.vote
+    ADD CONSTRAINT vote_pkey PRIMARY KEY (voter_id, qwiz_id);
 
 
 --
--- Name: class check_teacher; Type: TRIGGER; Schema: public; Owner: qwiz
+-- Name: answer delete_embed; Type: TRIGGER; Schema: public; Owner: qwiz
 --
 
-CREATE TRIGGER check_teacher BEFORE INSERT OR UPDATE ON public.class FOR EACH ROW EXECUTE FUNCTION public.check_teacher_func();
+CREATE TRIGGER delete_embed AFTER DELETE ON public.answer FOR EACH ROW EXECUTE FUNCTION public.delete_embed_func();
 
 
 --
@@ -532,6 +1164,22 @@ ALTER TABLE ONLY public.account
     ADD CONSTRAINT account_profile_picture_uuid_fkey FOREIGN KEY (profile_picture_uuid) REFERENCES public.media(uuid) ON DELETE SET NULL;
 
 
+--
+-- Name: answer answer_embed_uuid_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_embed_uuid_fkey FOREIGN KEY (embed_uuid) REFERENCES public.media(uuid) ON DELETE SET NULL;
+
+
+--
+-- Name: answer answer_qwiz_id_question_index_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_qwiz_id_question_index_fkey FOREIGN KEY (qwiz_id, question_index) REFERENCES public.question(qwiz_id, index) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
 --
 -- Name: assignment assignment_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -548,6 +1196,38 @@ ALTER TABLE ONLY public.assignment
     ADD CONSTRAINT assignment_qwiz_id_fkey FOREIGN KEY (qwiz_id) REFERENCES public.qwiz(id) ON DELETE CASCADE;
 
 
+--
+-- Name: attempt attempt_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.attempt
+    ADD CONSTRAINT attempt_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE SET NULL;
+
+
+--
+-- Name: attempt attempt_assignment_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.attempt
+    ADD CONSTRAINT attempt_assignment_id_fkey FOREIGN KEY (assignment_id) REFERENCES public.assignment(id) ON DELETE SET NULL;
+
+
+--
+-- Name: attempt attempt_guest_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.attempt
+    ADD CONSTRAINT attempt_guest_id_fkey FOREIGN KEY (guest_id) REFERENCES public.guest(id) ON DELETE SET NULL;
+
+
+--
+-- Name: attempt attempt_qwiz_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.attempt
+    ADD CONSTRAINT attempt_qwiz_id_fkey FOREIGN KEY (qwiz_id) REFERENCES public.qwiz(id) ON DELETE CASCADE;
+
+
 --
 -- Name: class class_teacher_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -556,6 +1236,30 @@ ALTER TABLE ONLY public.class
     ADD CONSTRAINT class_teacher_id_fkey FOREIGN KEY (teacher_id) REFERENCES public.account(id) ON DELETE CASCADE;
 
 
+--
+-- Name: class_invite class_invite_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_invite
+    ADD CONSTRAINT class_invite_class_id_fkey FOREIGN KEY (class_id) REFERENCES public.class(id) ON DELETE CASCADE;
+
+
+--
+-- Name: class_join_request class_join_request_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_join_request
+    ADD CONSTRAINT class_join_request_class_id_fkey FOREIGN KEY (class_id) REFERENCES public.class(id) ON DELETE CASCADE;
+
+
+--
+-- Name: class_join_request class_join_request_student_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.class_join_request
+    ADD CONSTRAINT class_join_request_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
 --
 -- Name: completed_assignment completed_assignment_assignment_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -572,6 +1276,86 @@ ALTER TABLE ONLY public.completed_assignment
     ADD CONSTRAINT completed_assignment_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE;
 
 
+--
+-- Name: live_answer live_answer_participant_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_answer
+    ADD CONSTRAINT live_answer_participant_id_fkey FOREIGN KEY (participant_id) REFERENCES public.live_participant(id) ON DELETE CASCADE;
+
+
+--
+-- Name: live_participant live_participant_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_participant
+    ADD CONSTRAINT live_participant_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE SET NULL;
+
+
+--
+-- Name: live_participant live_participant_session_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_participant
+    ADD CONSTRAINT live_participant_session_id_fkey FOREIGN KEY (session_id) REFERENCES public.live_session(id) ON DELETE CASCADE;
+
+
+--
+-- Name: live_question live_question_session_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_question
+    ADD CONSTRAINT live_question_session_id_fkey FOREIGN KEY (session_id) REFERENCES public.live_session(id) ON DELETE CASCADE;
+
+
+--
+-- Name: live_session live_session_host_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_session
+    ADD CONSTRAINT live_session_host_id_fkey FOREIGN KEY (host_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
+--
+-- Name: live_session live_session_qwiz_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.live_session
+    ADD CONSTRAINT live_session_qwiz_id_fkey FOREIGN KEY (qwiz_id) REFERENCES public.qwiz(id) ON DELETE CASCADE;
+
+
+--
+-- Name: mail_token mail_token_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.mail_token
+    ADD CONSTRAINT mail_token_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
+--
+-- Name: parent_link parent_link_accepted_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.parent_link
+    ADD CONSTRAINT parent_link_accepted_by_fkey FOREIGN KEY (accepted_by) REFERENCES public.account(id) ON DELETE SET NULL;
+
+
+--
+-- Name: parent_link parent_link_parent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.parent_link
+    ADD CONSTRAINT parent_link_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
+--
+-- Name: parent_link parent_link_student_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.parent_link
+    ADD CONSTRAINT parent_link_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
 --
 -- Name: question question_embed_uuid_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -604,6 +1388,14 @@ ALTER TABLE ONLY public.qwiz
     ADD CONSTRAINT qwiz_thumbnail_uuid_fkey FOREIGN KEY (thumbnail_uuid) REFERENCES public.media(uuid) ON DELETE SET NULL;
 
 
+--
+-- Name: recovery_code recovery_code_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.recovery_code
+    ADD CONSTRAINT recovery_code_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
 --
 -- Name: student student_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -620,6 +1412,22 @@ ALTER TABLE ONLY public.student
     ADD CONSTRAINT student_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE;
 
 
+--
+-- Name: token token_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.token
+    ADD CONSTRAINT token_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
+--
+-- Name: two_factor two_factor_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
+--
+
+ALTER TABLE ONLY public.two_factor
+    ADD CONSTRAINT two_factor_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE;
+
+
 --
 -- Name: vote vote_qwiz_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: qwiz
 --
@@ -0,0 +1,77 @@
+package limiter
+
+import (
+	"sync"
+	"time"
+)
+
+type entry struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+type MemoryLimiter struct {
+	Policy  Policy
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+func NewMemoryLimiter(policy Policy) *MemoryLimiter {
+	return &MemoryLimiter{Policy: policy, entries: make(map[string]*entry)}
+}
+
+func (l *MemoryLimiter) Check(key string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, ok := l.entries[key]
+	if !ok {
+		return 0, nil
+	}
+	return remaining(e.lockedUntil, time.Now()), nil
+}
+
+func (l *MemoryLimiter) Fail(key string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	e, ok := l.entries[key]
+	if !ok || now.Sub(e.lastFailure) > l.Policy.Window {
+		e = &entry{}
+		l.entries[key] = e
+	}
+	e.failures++
+	e.lastFailure = now
+
+	delay := l.Policy.Delay(e.failures)
+	if delay > 0 {
+		e.lockedUntil = now.Add(delay)
+	}
+	l.cleanup(now)
+	return delay, nil
+}
+
+func (l *MemoryLimiter) Reset(key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.entries, key)
+	return nil
+}
+
+// cleanup удаляет забытые ключи, чтобы перебор с разных IP не раздувал карту.
+func (l *MemoryLimiter) cleanup(now time.Time) {
+	for key, e := range l.entries {
+		if now.Sub(e.lastFailure) > l.Policy.Window && !now.Before(e.lockedUntil) {
+			delete(l.entries, key)
+		}
+	}
+}
+
+func remaining(lockedUntil, now time.Time) time.Duration {
+	if now.Before(lockedUntil) {
+		return lockedUntil.Sub(now)
+	}
+	return 0
+}
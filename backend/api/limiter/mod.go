@@ -0,0 +1,51 @@
+package limiter
+
+import (
+	"time"
+)
+
+// Policy задаёт экспоненциальную задержку: первые FreeAttempts неудач бесплатны,
+// каждая следующая блокирует ключ на BaseDelay, 2*BaseDelay, 4*BaseDelay... но не дольше MaxDelay.
+// Счётчик сбрасывается, если с последней неудачи прошло больше Window.
+type Policy struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Window       time.Duration
+}
+
+var DefaultPolicy = Policy{
+	FreeAttempts: 5,
+	BaseDelay:    30 * time.Second,
+	MaxDelay:     15 * time.Minute,
+	Window:       15 * time.Minute,
+}
+
+// Delay возвращает длительность блокировки после failures неудач подряд.
+func (p Policy) Delay(failures int) time.Duration {
+	if failures <= p.FreeAttempts {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := p.FreeAttempts + 1; i < failures; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// Limiter считает неудачные попытки по ключу (аккаунт, IP). Состояние хранится в памяти
+// процесса (MemoryLimiter) или в Postgres (PostgresLimiter), чтобы его разделяли все экземпляры API.
+type Limiter interface {
+	// Check возвращает оставшееся время блокировки ключа или 0.
+	Check(key string) (time.Duration, error)
+	// Fail учитывает неудачу и возвращает время блокировки, которое она повлекла, или 0.
+	Fail(key string) (time.Duration, error)
+	// Reset сбрасывает счётчик после успешной попытки.
+	Reset(key string) error
+}
@@ -0,0 +1,74 @@
+package limiter
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// PostgresLimiter хранит счётчики в таблице login_attempt, общей для всех экземпляров API.
+type PostgresLimiter struct {
+	Policy Policy
+	DB     *sqlx.DB
+}
+
+func NewPostgresLimiter(db *sqlx.DB, policy Policy) *PostgresLimiter {
+	return &PostgresLimiter{Policy: policy, DB: db}
+}
+
+func (l *PostgresLimiter) Check(key string) (time.Duration, error) {
+	var lockedUntil sql.NullTime
+	err := l.DB.Get(&lockedUntil, "SELECT locked_until FROM login_attempt WHERE key=$1", key)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !lockedUntil.Valid) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return remaining(lockedUntil.Time, time.Now().UTC()), nil
+}
+
+func (l *PostgresLimiter) Fail(key string) (time.Duration, error) {
+	now := time.Now().UTC()
+
+	tx, err := l.DB.Beginx()
+	if err != nil {
+		return 0, err
+	}
+
+	// Счётчик начинается заново, если с прошлой неудачи прошло больше Window
+	var failures int
+	err = tx.Get(&failures, `
+		INSERT INTO login_attempt (key, failures, last_failure) VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempt.last_failure < $3 THEN 1 ELSE login_attempt.failures + 1 END,
+			last_failure = EXCLUDED.last_failure
+		RETURNING failures
+	`, key, now, now.Add(-l.Policy.Window))
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	// Забытые ключи удаляются, чтобы перебор с разных IP не раздувал таблицу
+	_, err = tx.Exec("DELETE FROM login_attempt WHERE last_failure < $1 AND (locked_until IS NULL OR locked_until < $2)", now.Add(-l.Policy.Window), now)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	delay := l.Policy.Delay(failures)
+	if delay > 0 {
+		if _, err := tx.Exec("UPDATE login_attempt SET locked_until=$1 WHERE key=$2", now.Add(delay), key); err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+	return delay, tx.Commit()
+}
+
+func (l *PostgresLimiter) Reset(key string) error {
+	_, err := l.DB.Exec("DELETE FROM login_attempt WHERE key=$1", key)
+	return err
+}
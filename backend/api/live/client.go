@@ -0,0 +1,200 @@
+package live
+
+import (
+	"api/question"
+	"github.com/gorilla/websocket"
+	"sync"
+	"time"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10
+	maxMessageSize = 4096
+	sendBuffer     = 32
+)
+
+// Event - сообщение сервера клиенту. Заполняются только поля, относящиеся к Type.
+type Event struct {
+	Type         string            `json:"type"`
+	Code         string            `json:"code,omitempty"`
+	Participant  *ParticipantData  `json:"participant,omitempty"`
+	Participants []ParticipantData `json:"participants,omitempty"`
+	Question     *QuestionData     `json:"question,omitempty"`
+	Scores       []ScoreData       `json:"scores,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	// Поля снимка состояния для переподключившегося участника
+	State       string `json:"state,omitempty"`
+	ResumeToken string `json:"resume_token,omitempty"`
+	Answered    *bool  `json:"answered,omitempty"`
+	Score       *uint  `json:"score,omitempty"`
+	// Correct - номер верного ответа (с 1) в событии reveal, остальные поля - верный ответ других типов вопросов
+	Correct         *uint8         `json:"correct,omitempty"`
+	CorrectAnswers  []uint8        `json:"correct_answers,omitempty"`
+	AcceptedAnswers []string       `json:"accepted_answers,omitempty"`
+	NumericAnswer   *float64       `json:"numeric_answer,omitempty"`
+	CorrectSequence []uint8        `json:"correct_sequence,omitempty"`
+	Distribution    []int          `json:"distribution,omitempty"`
+	Dashboard       *DashboardData `json:"dashboard,omitempty"`
+}
+
+// Command - сообщение клиента серверу.
+type Command struct {
+	Type          string `json:"type"`
+	AccessToken   string `json:"access_token,omitempty"`
+	GuestToken    string `json:"guest_token,omitempty"`
+	DisplayName   string `json:"display_name,omitempty"`
+	ResumeToken   string `json:"resume_token,omitempty"`
+	Answer        uint8  `json:"answer,omitempty"`
+	ParticipantID int32  `json:"participant_id,omitempty"`
+	// Ответы на вопросы multiple, text, numeric, ordering и matching
+	Answers  []uint8  `json:"answers,omitempty"`
+	Text     *string  `json:"text,omitempty"`
+	Number   *float64 `json:"number,omitempty"`
+	Sequence []uint8  `json:"sequence,omitempty"`
+}
+
+// response переводит ответ из команды в Response: номера вариантов с 1 становятся индексами.
+// Подходит ли ответ типу вопроса, проверяет сессия.
+func (c *Command) response() (*Response, error) {
+	switch {
+	case c.Text != nil:
+		return &Response{Text: c.Text}, nil
+	case c.Number != nil:
+		return &Response{Number: c.Number}, nil
+	case c.Sequence != nil:
+		response := &Response{Sequence: make([]uint8, 0, len(c.Sequence))}
+		for _, n := range c.Sequence {
+			if n == 0 {
+				return nil, ErrInvalidAnswer
+			}
+			response.Sequence = append(response.Sequence, n-1)
+		}
+		return response, nil
+	}
+	answers := c.Answers
+	if answers == nil {
+		answers = []uint8{c.Answer}
+	}
+	response := &Response{Choices: make([]uint8, 0, len(answers))}
+	for _, a := range answers {
+		if a == 0 {
+			return nil, ErrInvalidAnswer
+		}
+		response.Choices = append(response.Choices, a-1)
+	}
+	return response, nil
+}
+
+type ParticipantData struct {
+	ID                int32  `json:"id"`
+	DisplayName       string `json:"display_name"`
+	ProfilePictureURI string `json:"profile_picture_uri,omitempty"`
+}
+
+type QuestionData struct {
+	Number   int           `json:"number"`
+	Total    int           `json:"total"`
+	Type     question.Type `json:"type"`
+	Body     string        `json:"body"`
+	EmbedURI *string       `json:"embed_uri,omitempty"`
+	Answers  []string      `json:"answers"`
+	// Matches - пары вопроса matching, перемешанные отдельно от answers
+	Matches []string `json:"matches,omitempty"`
+	// AnswerEmbedURIs - медиа вариантов в порядке answers, если хотя бы у одного оно есть
+	AnswerEmbedURIs []*string `json:"answer_embed_uris,omitempty"`
+	// TimeLimit в секундах и Deadline заданы, если время на ответ ограничено. RemainingMs - сколько
+	// осталось на момент отправки: часы клиента могут расходиться с серверными.
+	TimeLimit   int        `json:"time_limit,omitempty"`
+	Deadline    *time.Time `json:"deadline,omitempty"`
+	RemainingMs int64      `json:"remaining_ms,omitempty"`
+	Paused      bool       `json:"paused,omitempty"`
+}
+
+// DashboardData - сводка по текущему вопросу для ведущего. Distribution - сколько участников
+// выбрали каждый вариант в порядке answers, Total - сколько участников должны ответить.
+type DashboardData struct {
+	Number       int               `json:"number"`
+	Answered     int               `json:"answered"`
+	Total        int               `json:"total"`
+	Distribution []int             `json:"distribution"`
+	Unanswered   []ParticipantData `json:"unanswered"`
+	Revealed     bool              `json:"revealed"`
+	Paused       bool              `json:"paused"`
+}
+
+type ScoreData struct {
+	ParticipantData
+	Score uint `json:"score"`
+}
+
+func participantData(p QwizParticipant) ParticipantData {
+	return ParticipantData{ID: p.ID, DisplayName: p.DisplayName, ProfilePictureURI: p.ProfilePictureURI}
+}
+
+// client - одно WebSocket-соединение. Писать в conn может только writePump,
+// остальные кладут события в send. Медленный клиент, переполнивший буфер, отключается.
+type client struct {
+	conn   *websocket.Conn
+	send   chan Event
+	mu     sync.Mutex
+	closed bool
+}
+
+func newClient(conn *websocket.Conn) *client {
+	return &client{conn: conn, send: make(chan Event, sendBuffer)}
+}
+
+func (c *client) push(event Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	select {
+	case c.send <- event:
+	default:
+		c.closeLocked()
+	}
+}
+
+func (c *client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closeLocked()
+}
+
+func (c *client) closeLocked() {
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
+
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case event, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
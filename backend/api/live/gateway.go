@@ -0,0 +1,88 @@
+package live
+
+import (
+	"api/broker"
+	"encoding/json"
+	"log"
+	"sync"
+)
+
+// gateway - клиенты одной сессии, подключённые к этому экземпляру API. Он подписан на события
+// сессии и раздаёт их своим соединениям; владелец сессии может работать на другом экземпляре.
+type gateway struct {
+	sub     broker.Subscription
+	clients map[string]*client
+}
+
+var (
+	gatewaysMu sync.Mutex
+	gateways   = make(map[string]*gateway)
+)
+
+// connect регистрирует соединение conn сессии code. Подписка создаётся до первой команды,
+// поэтому ответ на неё не потеряется.
+func connect(code, conn string, c *client) error {
+	gatewaysMu.Lock()
+	defer gatewaysMu.Unlock()
+
+	gw, ok := gateways[code]
+	if !ok {
+		gw = &gateway{clients: make(map[string]*client)}
+		sub, err := Broker.Subscribe(eventTopic(code), gw.deliver)
+		if err != nil {
+			return err
+		}
+		gw.sub = sub
+		gateways[code] = gw
+	}
+	gw.clients[conn] = c
+	return nil
+}
+
+// disconnect убирает соединение; с последним соединением экземпляр отписывается от сессии.
+func disconnect(code, conn string) {
+	gatewaysMu.Lock()
+	defer gatewaysMu.Unlock()
+
+	gw, ok := gateways[code]
+	if !ok {
+		return
+	}
+	delete(gw.clients, conn)
+	if len(gw.clients) == 0 {
+		gw.sub.Unsubscribe()
+		delete(gateways, code)
+	}
+}
+
+func (gw *gateway) deliver(payload []byte) {
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		log.Printf("Live gateway: invalid event: %v", err)
+		return
+	}
+
+	gatewaysMu.Lock()
+	var targets []*client
+	if len(env.To) == 0 {
+		for _, c := range gw.clients {
+			targets = append(targets, c)
+		}
+	} else {
+		for _, conn := range env.To {
+			if c, ok := gw.clients[conn]; ok {
+				targets = append(targets, c)
+			}
+		}
+	}
+	gatewaysMu.Unlock()
+
+	for _, c := range targets {
+		if env.Event != nil {
+			c.push(*env.Event)
+		}
+		if env.Close {
+			c.close()
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package live
+
+import (
+	"api/guest"
+	"api/question"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// DB хранит историю завершённых сессий; сами сессии живут в памяти экземпляра-владельца.
+var DB *sqlx.DB
+
+// playedQuestion - вопрос в том виде, в каком его задали. Вопросы викторины можно менять
+// и после сессии, поэтому в историю попадает копия. Варианты - в исходном порядке вопроса.
+type playedQuestion struct {
+	question.Question
+	TimeLimit time.Duration
+}
+
+func newPlayedQuestion(state *RunningLiveQwiz) playedQuestion {
+	return playedQuestion{Question: *state.Question, TimeLimit: state.TimeLimit}
+}
+
+// SessionRecord - завершённая сессия. QwizName и ParticipantCount заполняются только в списках.
+type SessionRecord struct {
+	ID               int32     `db:"id"`
+	Code             string    `db:"code"`
+	QwizID           int32     `db:"qwiz_id"`
+	QwizName         string    `db:"qwiz_name"`
+	HostID           int32     `db:"host_id"`
+	Scoring          Scoring   `db:"scoring"`
+	StreakBonus      bool      `db:"streak_bonus"`
+	CreateTime       time.Time `db:"create_time"`
+	FinishTime       time.Time `db:"finish_time"`
+	ParticipantCount int32     `db:"participant_count"`
+}
+
+// QuestionRecord - заданный вопрос. Верный ответ хранится в полях его типа, как в question.Question:
+// Correct (с 1) у single и true_false, CorrectAnswers у multiple, AcceptedAnswers у text,
+// NumericAnswer и Tolerance у numeric. У ordering верный порядок - порядок Answers, у matching
+// Matches - пары вариантов.
+type QuestionRecord struct {
+	SessionID int32          `db:"session_id"`
+	Number    int16          `db:"number"`
+	Type      question.Type  `db:"question_type"`
+	Body      string         `db:"body"`
+	Answers   pq.StringArray `db:"answers"`
+	Correct   *int16         `db:"correct"`
+	// TimeLimit в миллисекундах, NULL - без ограничения
+	TimeLimit       *int32         `db:"time_limit"`
+	CorrectAnswers  pq.Int32Array  `db:"correct_answers"`
+	AcceptedAnswers pq.StringArray `db:"accepted_answers"`
+	NumericAnswer   *float64       `db:"numeric_answer"`
+	Tolerance       float64        `db:"tolerance"`
+	Matches         pq.StringArray `db:"matches"`
+}
+
+// ParticipantRecord - участник завершённой сессии. AccountID пуст у гостей и если аккаунт удалён.
+type ParticipantRecord struct {
+	ID          int32  `db:"id"`
+	SessionID   int32  `db:"session_id"`
+	AccountID   *int32 `db:"account_id"`
+	DisplayName string `db:"display_name"`
+	Score       int32  `db:"score"`
+}
+
+// AnswerRecord - ответ участника на вопрос QuestionNumber. Choice - номер варианта (с 1) в исходном
+// порядке вопроса single и true_false, Choices - выбранные варианты multiple, TextAnswer и NumericAnswer -
+// ответы text и numeric, Sequence - ответ ordering и matching как question.Response.Sequence.
+// Если участник не ответил, пусты все они и ResponseTime (в миллисекундах).
+type AnswerRecord struct {
+	ParticipantID  int32         `db:"participant_id"`
+	QuestionNumber int16         `db:"question_number"`
+	Choice         *int16        `db:"choice"`
+	Correct        bool          `db:"correct"`
+	ResponseTime   *int32        `db:"response_time"`
+	Choices        pq.Int32Array `db:"choices"`
+	TextAnswer     *string       `db:"text_answer"`
+	NumericAnswer  *float64      `db:"numeric_answer"`
+	Sequence       pq.Int32Array `db:"sequence"`
+}
+
+// saveHistory записывает итоги сессии одной транзакцией.
+func (s *Session) saveHistory(state *FinishingLiveQwiz) error {
+	scoring := s.qwiz.Options.Scoring
+	if scoring == "" {
+		scoring = ScoringClassic
+	}
+
+	tx, err := DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var sessionID int32
+	err = tx.Get(&sessionID, `
+		INSERT INTO live_session (code, qwiz_id, host_id, scoring, streak_bonus, create_time, finish_time)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`, s.Code, s.qwiz.QwizID, s.HostID, scoring, s.qwiz.Options.StreakBonus, s.createTime, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	for number, q := range s.played {
+		var timeLimit *int32
+		if q.TimeLimit > 0 {
+			ms := int32(q.TimeLimit.Milliseconds())
+			timeLimit = &ms
+		}
+		var correct *int16
+		var correctAnswers pq.Int32Array
+		var matches pq.StringArray
+		if q.Kind() == question.Matching {
+			matches = q.Matches()
+		}
+		for _, c := range q.CorrectChoices() {
+			if q.Kind() == question.Multiple {
+				correctAnswers = append(correctAnswers, int32(c))
+			} else {
+				n := int16(c)
+				correct = &n
+			}
+		}
+		_, err := tx.Exec(`
+			INSERT INTO live_question (session_id, number, question_type, body, answers, correct, time_limit,
+				correct_answers, accepted_answers, numeric_answer, tolerance, matches)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`, sessionID, number, q.Kind(), q.Body, pq.StringArray(q.AnswerBodies()), correct, timeLimit,
+			correctAnswers, q.AcceptedAnswers, q.NumericAnswer, q.Tolerance, matches)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range s.qwiz.Participants {
+		score, ok := state.Scores[p.ID]
+		if !ok {
+			continue
+		}
+		var accountID *int32
+		if !guest.IsGuestID(p.ID) {
+			accountID = &p.ID
+		}
+		var participantID int32
+		err := tx.Get(&participantID, `
+			INSERT INTO live_participant (session_id, account_id, display_name, score) VALUES ($1, $2, $3, $4) RETURNING id
+		`, sessionID, accountID, p.DisplayName, score)
+		if err != nil {
+			return err
+		}
+
+		answers := state.AcceptedAnswers[p.ID]
+		for number, q := range s.played {
+			if number >= len(answers) {
+				break
+			}
+			var choice *int16
+			var choices, sequence pq.Int32Array
+			var text *string
+			var numeric *float64
+			var responseTime *int32
+			if r := state.Responses[p.ID][number]; r != nil {
+				if q.Kind() == question.Multiple {
+					for _, c := range r.Choices {
+						choices = append(choices, int32(c))
+					}
+				} else if len(r.Choices) == 1 {
+					n := int16(r.Choices[0])
+					choice = &n
+				}
+				for _, n := range r.Sequence {
+					sequence = append(sequence, int32(n))
+				}
+				text, numeric = r.Text, r.Number
+				ms := int32(state.ResponseTimes[p.ID][number].Milliseconds())
+				responseTime = &ms
+			}
+			_, err := tx.Exec(`
+				INSERT INTO live_answer (participant_id, question_number, choice, correct, response_time, choices, text_answer,
+					numeric_answer, sequence)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			`, participantID, number, choice, answers[number], responseTime, choices, text, numeric, sequence)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+const sessionRecordQuery = `
+	SELECT s.id, s.code, s.qwiz_id, q.name AS qwiz_name, s.host_id, s.scoring, s.streak_bonus, s.create_time, s.finish_time,
+		(SELECT count(*) FROM live_participant p WHERE p.session_id=s.id) AS participant_count
+	FROM live_session s JOIN qwiz q ON q.id=s.qwiz_id
+`
+
+func GetSessionRecord(id int32) (*SessionRecord, error) {
+	var record SessionRecord
+	err := DB.Get(&record, sessionRecordQuery+"WHERE s.id=$1", id)
+	return &record, err
+}
+
+// GetSessionRecordsByQwizID возвращает сессии викторины, новые первыми.
+func GetSessionRecordsByQwizID(qwizID int32) ([]SessionRecord, error) {
+	var records []SessionRecord
+	err := DB.Select(&records, sessionRecordQuery+"WHERE s.qwiz_id=$1 ORDER BY s.finish_time DESC", qwizID)
+	return records, err
+}
+
+// GetSessionRecordsByHostID возвращает сессии, которые провёл ведущий, новые первыми.
+func GetSessionRecordsByHostID(hostID int32) ([]SessionRecord, error) {
+	var records []SessionRecord
+	err := DB.Select(&records, sessionRecordQuery+"WHERE s.host_id=$1 ORDER BY s.finish_time DESC", hostID)
+	return records, err
+}
+
+func (r *SessionRecord) Questions() ([]QuestionRecord, error) {
+	var questions []QuestionRecord
+	err := DB.Select(&questions, "SELECT * FROM live_question WHERE session_id=$1 ORDER BY number", r.ID)
+	return questions, err
+}
+
+// Participants возвращает участников по убыванию очков.
+func (r *SessionRecord) Participants() ([]ParticipantRecord, error) {
+	var participants []ParticipantRecord
+	err := DB.Select(&participants, "SELECT * FROM live_participant WHERE session_id=$1 ORDER BY score DESC, display_name", r.ID)
+	return participants, err
+}
+
+func (r *SessionRecord) Answers() ([]AnswerRecord, error) {
+	var answers []AnswerRecord
+	err := DB.Select(&answers, `
+		SELECT a.* FROM live_answer a JOIN live_participant p ON p.id=a.participant_id
+		WHERE p.session_id=$1 ORDER BY a.participant_id, a.question_number
+	`, r.ID)
+	return answers, err
+}
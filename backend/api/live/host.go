@@ -0,0 +1,190 @@
+package live
+
+import (
+	"api/question"
+	"errors"
+	"time"
+)
+
+var ErrKicked = errors.New("removed by the host")
+
+// Команды ведущего: показать верный ответ, удалить участника, пауза. Все вызываются под s.mu.
+
+func (s *Session) running() (*RunningLiveQwiz, error) {
+	running, ok := s.qwiz.State.(*RunningLiveQwiz)
+	if !ok {
+		return nil, ErrNotRunning
+	}
+	return running, nil
+}
+
+// reveal закрывает приём ответов и показывает всем верный ответ и распределение.
+// Таймер останавливается: дальше вопрос переключает ведущий.
+func (s *Session) reveal(conn string) error {
+	if conn != s.host {
+		return ErrForbidden
+	}
+	running, err := s.running()
+	if err != nil {
+		return err
+	}
+	if running.Revealed {
+		return nil
+	}
+
+	s.stopTimer()
+	running.Revealed = true
+	event := Event{Type: "reveal"}
+	revealData(running, &event)
+	s.broadcast(event)
+	s.pushDashboard()
+	return nil
+}
+
+// revealData добавляет в событие верный ответ текущего вопроса в виде его типа и распределение ответов.
+func revealData(running *RunningLiveQwiz, event *Event) {
+	q := running.Question
+	if q.Kind() == question.Single || q.Kind() == question.TrueFalse {
+		correct := running.CorrectAnswer + 1
+		event.Correct = &correct
+	}
+	if q.Kind().IsChoice() {
+		event.CorrectAnswers = make([]uint8, len(running.CorrectAnswers))
+		for i, c := range running.CorrectAnswers {
+			event.CorrectAnswers[i] = c + 1
+		}
+	}
+	for _, c := range running.CorrectSequence {
+		event.CorrectSequence = append(event.CorrectSequence, c+1)
+	}
+	event.AcceptedAnswers = q.AcceptedAnswers
+	event.NumericAnswer = q.NumericAnswer
+	event.Distribution = running.Distribution()
+}
+
+// kick удаляет участника вместе с ответами. Вернуться ни по resume, ни через join он не сможет.
+func (s *Session) kick(conn string, participantID int32) error {
+	if conn != s.host {
+		return ErrForbidden
+	}
+	if _, ok := s.qwiz.State.(*FinishingLiveQwiz); ok {
+		return ErrNotRunning
+	}
+	if _, ok := s.qwiz.Participant(participantID); !ok {
+		return ErrNotParticipant
+	}
+
+	s.kicked[participantID] = true
+	for i, p := range s.qwiz.Participants {
+		if p.ID == participantID {
+			s.qwiz.Participants = append(s.qwiz.Participants[:i], s.qwiz.Participants[i+1:]...)
+			break
+		}
+	}
+	if running, ok := s.qwiz.State.(*RunningLiveQwiz); ok {
+		running.Remove(participantID)
+	}
+	for token, p := range s.resumeTokens {
+		if p.ID == participantID {
+			delete(s.resumeTokens, token)
+		}
+	}
+	if other, ok := s.clients[participantID]; ok {
+		s.send(other, Event{Type: "kicked"})
+		s.disconnect(other)
+		delete(s.clients, participantID)
+	}
+
+	s.broadcast(Event{Type: "participants", Participants: s.participantsData()})
+	s.pushDashboard()
+	return nil
+}
+
+func (s *Session) pause(conn string) error {
+	if conn != s.host {
+		return ErrForbidden
+	}
+	running, err := s.running()
+	if err != nil {
+		return err
+	}
+	if running.Paused() {
+		return nil
+	}
+
+	s.stopTimer()
+	running.Pause(time.Now())
+	s.pushState("")
+	s.pushDashboard()
+	return nil
+}
+
+func (s *Session) unpause(conn string) error {
+	if conn != s.host {
+		return ErrForbidden
+	}
+	running, err := s.running()
+	if err != nil {
+		return err
+	}
+	if !running.Paused() {
+		return nil
+	}
+
+	running.Unpause(time.Now())
+	if !running.Revealed {
+		s.startTimer(running)
+	}
+	s.pushState("")
+	s.pushDashboard()
+	return nil
+}
+
+func (s *Session) startTimer(state *RunningLiveQwiz) {
+	deadline, ok := state.Deadline()
+	if !ok {
+		return
+	}
+	s.stopTimer()
+	gen := s.timerGen
+	s.timer = time.AfterFunc(time.Until(deadline), func() { s.timeUp(gen) })
+}
+
+func (s *Session) stopTimer() {
+	s.timerGen++
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+// pushDashboard отправляет ведущему сводку по текущему вопросу.
+func (s *Session) pushDashboard() {
+	if s.host == "" {
+		return
+	}
+	running, ok := s.qwiz.State.(*RunningLiveQwiz)
+	if !ok {
+		return
+	}
+
+	data := &DashboardData{
+		Number:       running.QuestionNumber,
+		Total:        len(running.AcceptedAnswers),
+		Distribution: running.Distribution(),
+		Unanswered:   []ParticipantData{},
+		Revealed:     running.Revealed,
+		Paused:       running.Paused(),
+	}
+	for _, p := range s.qwiz.Participants {
+		if _, ok := running.AcceptedAnswers[p.ID]; !ok {
+			continue
+		}
+		if running.Answered(p.ID) {
+			data.Answered++
+		} else {
+			data.Unanswered = append(data.Unanswered, participantData(p))
+		}
+	}
+	s.send(s.host, Event{Type: "dashboard", Dashboard: data})
+}
@@ -2,14 +2,43 @@ package live
 
 import (
 	"api/question"
+	"errors"
 	"math/rand"
+	"sort"
+	"time"
 )
 
+var (
+	ErrNotRunning      = errors.New("no question is running")
+	ErrNotParticipant  = errors.New("not a participant")
+	ErrAlreadyAnswered = errors.New("already answered")
+	ErrInvalidAnswer   = errors.New("invalid answer")
+	ErrTimeUp          = errors.New("time is up")
+	ErrPaused          = errors.New("qwiz is paused")
+	ErrAnswersClosed   = errors.New("answers are closed")
+)
+
+// Response - ответ участника на текущий вопрос. Choices - индексы в CurrentAnswers (у single и true_false
+// ровно один), Text и Number - ответы на вопросы text и numeric. Sequence у ordering - индексы
+// в CurrentAnswers в выбранном порядке, у matching - для каждого из CurrentAnswers индекс в CurrentMatches.
+type Response struct {
+	Choices  []uint8  `json:"choices,omitempty"`
+	Text     *string  `json:"text,omitempty"`
+	Number   *float64 `json:"number,omitempty"`
+	Sequence []uint8  `json:"sequence,omitempty"`
+}
+
 type QwizOptions struct {
 	ShuffleQuestions bool
 	ShuffleAnswers   bool
+	Scoring          Scoring
+	// StreakBonus умножает очки за верные ответы подряд, см. StreakStep
+	StreakBonus bool
+	// TimeLimit - ограничение для вопросов без своего, 0 - без ограничения
+	TimeLimit time.Duration
 }
 
+// QwizParticipant - участник сессии. ID - id аккаунта, у гостя - отрицательный guest.Guest.ParticipantID.
 type QwizParticipant struct {
 	ID                int32
 	DisplayName       string
@@ -18,142 +47,340 @@ type QwizParticipant struct {
 
 type StartingLiveQwiz struct{}
 
+// RunningLiveQwiz - идёт вопрос QuestionNumber. AcceptedAnswers, Responses, ResponseTimes и TimeLimits
+// хранят историю по номерам вопросов: верен ли ответ, что ответил участник (варианты - в исходной
+// нумерации вопроса, nil - не ответил), за сколько дан и сколько времени отводилось.
 type RunningLiveQwiz struct {
-	QuestionNumber  int
-	CurrentAnswers  []string
+	Question       *question.Question
+	QuestionNumber int
+	// CurrentAnswers пуст у вопросов text и numeric
+	CurrentAnswers []string
+	// AnswerOrder[i] - индекс варианта CurrentAnswers[i] в исходном вопросе
+	AnswerOrder []uint8
+	// CurrentMatches - пары вопроса matching, всегда перемешаны; MatchOrder[i] - индекс варианта,
+	// чья пара CurrentMatches[i]
+	CurrentMatches []string
+	MatchOrder     []uint8
+	// CorrectAnswer - индекс верного варианта single и true_false, CorrectAnswers - всех верных вариантов.
+	// CorrectSequence - верный ответ ordering и matching в виде Response.Sequence
 	CorrectAnswer   uint8
+	CorrectAnswers  []uint8
+	CorrectSequence []uint8
 	AcceptedAnswers map[int32][]bool
+	Responses       map[int32][]*question.Response
+	ResponseTimes   map[int32][]time.Duration
+	StartTime       time.Time
+	TimeLimit       time.Duration
+	TimeLimits      []time.Duration
+	// Revealed - ведущий показал верный ответ, приём ответов закрыт
+	Revealed bool
+	// PausedAt - когда ведущий поставил паузу, нулевое время - не на паузе
+	PausedAt time.Time
 }
 
-func NewRunningLiveQwiz(question question.Question, shuffleAnswers bool, participantIDs []int32) *RunningLiveQwiz {
-	var answers []string
-	answers = append(answers, question.Answer1, question.Answer2)
-	if question.Answer3 != nil {
-		answers = append(answers, *question.Answer3)
-	}
-	if question.Answer4 != nil {
-		answers = append(answers, *question.Answer4)
-	}
+func NewRunningLiveQwiz(q question.Question, options QwizOptions, participantIDs []int32) *RunningLiveQwiz {
+	answers := q.AnswerBodies()
 
 	indices := make([]uint8, len(answers))
 	for i := range indices {
 		indices[i] = uint8(i)
 	}
 
-	if shuffleAnswers {
-		rand.Shuffle(len(indices), func(i, j int) {
-			indices[i], indices[j] = indices[j], indices[i]
-		})
+	// У true_false порядок "верно/неверно" не перемешивается, а у ordering перемешивается всегда:
+	// иначе варианты сразу показаны в верном порядке
+	if (options.ShuffleAnswers && q.Kind() != question.TrueFalse) || q.Kind() == question.Ordering {
+		shuffle(indices)
 	}
 
 	var correctAnswer uint8
-	for idx, val := range indices {
-		if int16(val) == question.Correct-1 {
-			correctAnswer = uint8(idx)
-			break
+	correctAnswers := []uint8{}
+	for _, c := range q.CorrectChoices() {
+		for idx, val := range indices {
+			if val == c-1 {
+				correctAnswers = append(correctAnswers, uint8(idx))
+				break
+			}
 		}
 	}
+	sort.Slice(correctAnswers, func(i, j int) bool { return correctAnswers[i] < correctAnswers[j] })
+	if len(correctAnswers) == 1 {
+		correctAnswer = correctAnswers[0]
+	}
 
 	currentAnswers := make([]string, len(indices))
+	position := make([]uint8, len(indices))
 	for i, idx := range indices {
 		currentAnswers[i] = answers[idx]
+		position[idx] = uint8(i)
+	}
+
+	var currentMatches []string
+	var matchOrder, correctSequence []uint8
+	switch q.Kind() {
+	case question.Ordering:
+		correctSequence = position
+	case question.Matching:
+		matches := q.Matches()
+		matchOrder = make([]uint8, len(matches))
+		for i := range matchOrder {
+			matchOrder[i] = uint8(i)
+		}
+		shuffle(matchOrder)
+		currentMatches = make([]string, len(matchOrder))
+		matchPosition := make([]uint8, len(matchOrder))
+		for i, idx := range matchOrder {
+			currentMatches[i] = matches[idx]
+			matchPosition[idx] = uint8(i)
+		}
+		correctSequence = make([]uint8, len(indices))
+		for i, idx := range indices {
+			correctSequence[i] = matchPosition[idx]
+		}
 	}
 
 	acceptedAnswers := make(map[int32][]bool)
+	responses := make(map[int32][]*question.Response)
+	responseTimes := make(map[int32][]time.Duration)
 	for _, id := range participantIDs {
 		acceptedAnswers[id] = []bool{}
+		responses[id] = []*question.Response{}
+		responseTimes[id] = []time.Duration{}
 	}
 
+	timeLimit := options.timeLimit(&q)
 	return &RunningLiveQwiz{
+		Question:        &q,
 		QuestionNumber:  0,
 		CurrentAnswers:  currentAnswers,
+		AnswerOrder:     indices,
+		CurrentMatches:  currentMatches,
+		MatchOrder:      matchOrder,
 		CorrectAnswer:   correctAnswer,
+		CorrectAnswers:  correctAnswers,
+		CorrectSequence: correctSequence,
 		AcceptedAnswers: acceptedAnswers,
+		Responses:       responses,
+		ResponseTimes:   responseTimes,
+		StartTime:       time.Now(),
+		TimeLimit:       timeLimit,
+		TimeLimits:      []time.Duration{timeLimit},
 	}
 }
 
-func (s *RunningLiveQwiz) Next(nextQuestion *question.Question, shuffleAnswers bool, participantIDs []int32) QwizState {
-	if nextQuestion != nil {
-		nq := *nextQuestion
-		questionNumber := s.QuestionNumber + 1
-		nextAnswers := []string{nq.Answer1, nq.Answer2}
-		if nq.Answer3 != nil {
-			nextAnswers = append(nextAnswers, *nq.Answer3)
-		}
-		if nq.Answer4 != nil {
-			nextAnswers = append(nextAnswers, *nq.Answer4)
-		}
+func shuffle(indices []uint8) {
+	rand.Shuffle(len(indices), func(i, j int) {
+		indices[i], indices[j] = indices[j], indices[i]
+	})
+}
 
-		indices := make([]uint8, len(nextAnswers))
-		for i := range indices {
-			indices[i] = uint8(i)
-		}
+// Answer записывает выбор одного варианта. answer - индекс в CurrentAnswers,
+// изменить уже принятый ответ нельзя.
+func (s *RunningLiveQwiz) Answer(participantID int32, answer uint8) error {
+	return s.AnswerAt(participantID, answer, time.Now())
+}
 
-		if shuffleAnswers {
-			rand.Shuffle(len(indices), func(i, j int) {
-				indices[i], indices[j] = indices[j], indices[i]
-			})
-		}
+// AnswerAt - Answer с явным временем ответа: от него считается бонус за скорость.
+func (s *RunningLiveQwiz) AnswerAt(participantID int32, answer uint8, at time.Time) error {
+	return s.RespondAt(participantID, Response{Choices: []uint8{answer}}, at)
+}
 
-		var correctAnswer uint8
-		for idx, val := range indices {
-			if int16(val) == nq.Correct-1 {
-				correctAnswer = uint8(idx)
-				break
-			}
-		}
+// Respond записывает ответ участника любого типа вопроса.
+func (s *RunningLiveQwiz) Respond(participantID int32, response Response) error {
+	return s.RespondAt(participantID, response, time.Now())
+}
 
-		currentAnswers := make([]string, len(indices))
-		for i, idx := range indices {
-			currentAnswers[i] = nextAnswers[idx]
+// RespondAt - Respond с явным временем ответа. Частичный зачёт ordering и matching в живой
+// сессии не учитывается: очки дают только за полностью верный ответ.
+func (s *RunningLiveQwiz) RespondAt(participantID int32, response Response, at time.Time) error {
+	answers, ok := s.AcceptedAnswers[participantID]
+	if !ok {
+		return ErrNotParticipant
+	}
+	if len(answers) > s.QuestionNumber {
+		return ErrAlreadyAnswered
+	}
+	if s.Revealed {
+		return ErrAnswersClosed
+	}
+	if s.Paused() {
+		return ErrPaused
+	}
+	responseTime := at.Sub(s.StartTime)
+	if s.TimeLimit > 0 && responseTime > s.TimeLimit {
+		return ErrTimeUp
+	}
+	r := question.Response{Text: response.Text, Number: response.Number}
+	for _, c := range response.Choices {
+		if int(c) >= len(s.CurrentAnswers) {
+			return ErrInvalidAnswer
 		}
+		r.Choices = append(r.Choices, s.AnswerOrder[c]+1)
+	}
+	if err := s.mapSequence(&r, response.Sequence); err != nil {
+		return err
+	}
+	if err := s.Question.Validate(r); err != nil {
+		return ErrInvalidAnswer
+	}
+	s.AcceptedAnswers[participantID] = append(answers, s.Question.Grade(r))
+	s.Responses[participantID] = append(s.Responses[participantID], &r)
+	s.ResponseTimes[participantID] = append(s.ResponseTimes[participantID], responseTime)
+	return nil
+}
 
-		acceptedAnswers := make(map[int32][]bool)
-		for id, answers := range s.AcceptedAnswers {
-			newAnswers := append([]bool(nil), answers...)
-			for len(newAnswers) < questionNumber {
-				newAnswers = append(newAnswers, false)
+// mapSequence переводит Sequence из индексов CurrentAnswers и CurrentMatches в исходную нумерацию вопроса.
+func (s *RunningLiveQwiz) mapSequence(r *question.Response, sequence []uint8) error {
+	if sequence == nil {
+		return nil
+	}
+	if len(sequence) != len(s.CurrentAnswers) {
+		return ErrInvalidAnswer
+	}
+	switch s.Question.Kind() {
+	case question.Ordering:
+		for _, idx := range sequence {
+			if int(idx) >= len(s.AnswerOrder) {
+				return ErrInvalidAnswer
+			}
+			r.Sequence = append(r.Sequence, s.AnswerOrder[idx]+1)
+		}
+	case question.Matching:
+		r.Sequence = make([]uint8, len(sequence))
+		for i, idx := range sequence {
+			if int(idx) >= len(s.MatchOrder) {
+				return ErrInvalidAnswer
 			}
-			acceptedAnswers[id] = newAnswers
+			r.Sequence[s.AnswerOrder[i]] = s.MatchOrder[idx] + 1
 		}
+	default:
+		return ErrInvalidAnswer
+	}
+	return nil
+}
 
-		return &RunningLiveQwiz{
-			QuestionNumber:  questionNumber,
-			CurrentAnswers:  currentAnswers,
-			CorrectAnswer:   correctAnswer,
-			AcceptedAnswers: acceptedAnswers,
+// Answered сообщает, ответил ли участник на текущий вопрос.
+func (s *RunningLiveQwiz) Answered(participantID int32) bool {
+	return len(s.AcceptedAnswers[participantID]) > s.QuestionNumber
+}
+
+// Distribution - сколько участников выбрали каждый из CurrentAnswers. У multiple участник
+// учитывается в каждом выбранном варианте, у text и numeric распределение пустое, у ordering
+// и matching - из нулей.
+func (s *RunningLiveQwiz) Distribution() []int {
+	distribution := make([]int, len(s.CurrentAnswers))
+	position := make(map[uint8]int)
+	for i, idx := range s.AnswerOrder {
+		position[idx+1] = i
+	}
+	for _, responses := range s.Responses {
+		if len(responses) <= s.QuestionNumber || responses[s.QuestionNumber] == nil {
+			continue
+		}
+		for _, c := range responses[s.QuestionNumber].Choices {
+			distribution[position[c]]++
 		}
 	}
+	return distribution
+}
 
-	finishing := &FinishingLiveQwiz{}
-	return finishing.fromRunning(s)
+func (s *RunningLiveQwiz) Paused() bool {
+	return !s.PausedAt.IsZero()
 }
 
-type FinishingLiveQwiz struct {
-	Participants []int32
-	Scores       map[int32]uint
+// Pause останавливает отсчёт времени на вопрос.
+func (s *RunningLiveQwiz) Pause(at time.Time) {
+	if !s.Paused() {
+		s.PausedAt = at
+	}
 }
 
-func (s *FinishingLiveQwiz) fromRunning(r *RunningLiveQwiz) QwizState {
-	scores := make(map[int32]int)
+// Unpause продолжает отсчёт: время паузы не входит ни во время ответа, ни в ограничение.
+func (s *RunningLiveQwiz) Unpause(at time.Time) {
+	if s.Paused() {
+		s.StartTime = s.StartTime.Add(at.Sub(s.PausedAt))
+		s.PausedAt = time.Time{}
+	}
+}
 
-	for id, answers := range r.AcceptedAnswers {
-		score := 0
-		for _, answer := range answers {
-			if answer {
-				score++
-			}
+// Remove вычёркивает участника со всеми его ответами.
+func (s *RunningLiveQwiz) Remove(participantID int32) {
+	delete(s.AcceptedAnswers, participantID)
+	delete(s.Responses, participantID)
+	delete(s.ResponseTimes, participantID)
+}
+
+// Score - очки участника за уже данные ответы.
+func (s *RunningLiveQwiz) Score(participantID int32, options QwizOptions) uint {
+	return options.Score(s.AcceptedAnswers[participantID], s.ResponseTimes[participantID], s.TimeLimits)
+}
+
+// Deadline - когда истекает время на текущий вопрос; ok=false, если ограничения нет.
+func (s *RunningLiveQwiz) Deadline() (deadline time.Time, ok bool) {
+	if s.TimeLimit <= 0 {
+		return time.Time{}, false
+	}
+	return s.StartTime.Add(s.TimeLimit), true
+}
+
+func (s *RunningLiveQwiz) Next(nextQuestion *question.Question, options QwizOptions, participantIDs []int32) QwizState {
+	// Кто не ответил на текущий вопрос, получает неверный ответ
+	questionNumber := s.QuestionNumber + 1
+	acceptedAnswers := make(map[int32][]bool)
+	responses := make(map[int32][]*question.Response)
+	responseTimes := make(map[int32][]time.Duration)
+	for id, answers := range s.AcceptedAnswers {
+		newAnswers := append([]bool(nil), answers...)
+		newResponses := append([]*question.Response(nil), s.Responses[id]...)
+		newTimes := append([]time.Duration(nil), s.ResponseTimes[id]...)
+		for len(newAnswers) < questionNumber {
+			newAnswers = append(newAnswers, false)
+			newResponses = append(newResponses, nil)
+			newTimes = append(newTimes, s.TimeLimit)
 		}
-		scores[id] = score
+		acceptedAnswers[id] = newAnswers
+		responses[id] = newResponses
+		responseTimes[id] = newTimes
 	}
 
-	// Convert scores to map[int32]uint
-	uintScores := make(map[int32]uint)
-	for id, score := range scores {
-		uintScores[id] = uint(score)
+	if nextQuestion == nil {
+		finishing := &FinishingLiveQwiz{}
+		return finishing.fromRunning(&RunningLiveQwiz{
+			AcceptedAnswers: acceptedAnswers,
+			Responses:       responses,
+			ResponseTimes:   responseTimes,
+			TimeLimits:      s.TimeLimits,
+		}, options)
 	}
 
-	s.Scores = uintScores // Assuming FinishingLiveQwiz has a Scores field.
+	next := NewRunningLiveQwiz(*nextQuestion, options, nil)
+	next.QuestionNumber = questionNumber
+	next.AcceptedAnswers = acceptedAnswers
+	next.Responses = responses
+	next.ResponseTimes = responseTimes
+	next.TimeLimits = append(append([]time.Duration(nil), s.TimeLimits...), next.TimeLimit)
+	return next
+}
+
+// FinishingLiveQwiz - итоги. AcceptedAnswers, Responses и ResponseTimes - полная история ответов
+// по номерам вопросов, как в RunningLiveQwiz.
+type FinishingLiveQwiz struct {
+	Participants    []int32
+	Scores          map[int32]uint
+	AcceptedAnswers map[int32][]bool
+	Responses       map[int32][]*question.Response
+	ResponseTimes   map[int32][]time.Duration
+}
+
+func (s *FinishingLiveQwiz) fromRunning(r *RunningLiveQwiz, options QwizOptions) QwizState {
+	s.Scores = make(map[int32]uint)
+	s.Participants = make([]int32, 0, len(r.AcceptedAnswers))
+	s.AcceptedAnswers = r.AcceptedAnswers
+	s.Responses = r.Responses
+	s.ResponseTimes = r.ResponseTimes
+	for id, answers := range r.AcceptedAnswers {
+		s.Scores[id] = options.Score(answers, r.ResponseTimes[id], r.TimeLimits)
+		s.Participants = append(s.Participants, id)
+	}
 	return s
 }
 
@@ -172,6 +399,13 @@ type Qwiz struct {
 }
 
 func newLiveQwiz(qwizID int32, options QwizOptions, questions []question.Question, participants []QwizParticipant) *Qwiz {
+	if options.ShuffleQuestions {
+		questions = append([]question.Question(nil), questions...)
+		rand.Shuffle(len(questions), func(i, j int) {
+			questions[i], questions[j] = questions[j], questions[i]
+		})
+	}
+
 	questionChan := make(chan question.Question, len(questions))
 	for _, q := range questions {
 		questionChan <- q
@@ -189,20 +423,20 @@ func newLiveQwiz(qwizID int32, options QwizOptions, questions []question.Questio
 
 type QwizState interface {
 	IsLiveQwizState() bool
-	Next(question *question.Question, shuffleAnswers bool, participantIDs []int32) QwizState
+	Next(question *question.Question, options QwizOptions, participantIDs []int32) QwizState
 }
 
-func (s *StartingLiveQwiz) Next(question *question.Question, shuffleAnswers bool, participantIDs []int32) QwizState {
+func (s *StartingLiveQwiz) Next(question *question.Question, options QwizOptions, participantIDs []int32) QwizState {
 	if question != nil {
 		// Если у нас есть следующий вопрос, переходим в состояние RunningLiveQwiz
-		return NewRunningLiveQwiz(*question, shuffleAnswers, participantIDs)
+		return NewRunningLiveQwiz(*question, options, participantIDs)
 	}
 
 	// Если вопросов больше нет, переходим в состояние FinishingLiveQwiz
 	return NewFinishingLiveQwiz(participantIDs)
 }
 
-func (s *FinishingLiveQwiz) Next(question *question.Question, shuffleAnswers bool, participantIDs []int32) QwizState {
+func (s *FinishingLiveQwiz) Next(question *question.Question, options QwizOptions, participantIDs []int32) QwizState {
 	return s
 }
 
@@ -225,7 +459,25 @@ func NewFinishingLiveQwiz(participantIDs []int32) *FinishingLiveQwiz {
 }
 
 func (l *Qwiz) Progress(question *question.Question) {
-	l.State = l.State.Next(question, l.Options.ShuffleAnswers, getParticipantIDs(l.Participants))
+	l.State = l.State.Next(question, l.Options, getParticipantIDs(l.Participants))
+}
+
+// Advance берёт следующий вопрос из очереди; когда вопросы кончились, викторина завершается.
+func (l *Qwiz) Advance() {
+	if q, ok := <-l.Questions; ok {
+		l.Progress(&q)
+	} else {
+		l.Progress(nil)
+	}
+}
+
+func (l *Qwiz) Participant(id int32) (*QwizParticipant, bool) {
+	for i := range l.Participants {
+		if l.Participants[i].ID == id {
+			return &l.Participants[i], true
+		}
+	}
+	return nil, false
 }
 
 func getParticipantIDs(participants []QwizParticipant) []int32 {
@@ -0,0 +1,508 @@
+package live
+
+import (
+	"api/account"
+	"api/authz"
+	"api/config"
+	"api/guest"
+	"api/media"
+	"api/question"
+	"api/qwiz"
+	"api/utils"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func liveInfo(c *gin.Context) {
+	c.String(http.StatusOK, `
+POST /live - create a live session, returns a join code
+Authorization: Bearer <access_token> - required
+qwiz_id: Integer - required, own or public qwiz
+shuffle_questions: Boolean - optional
+shuffle_answers: Boolean - optional
+scoring: "classic" (default, 1 point per correct answer) / "speed" (up to 1000 points, faster is more) - optional
+streak_bonus: Boolean - optional, multiply points for correct answers in a row (+10% each, up to 50%)
+time_limit: Integer - optional, seconds per question for questions without their own time limit
+
+POST /live/<code>/guest - join a live session without an account, returns a guest token for "join"
+nickname: String - required, up to 30 characters, offensive nicknames are rejected
+Returns: { id: Integer (negative, never equal to an account id), nickname, guest_token, expire_time }
+Гость существует только в этой сессии. Число гостей с одного IP ограничено (429 с Retry-After).
+
+GET /live/<code>/ws - WebSocket of a live session
+Первое сообщение клиента - "host" (ведущий) или "join" (участник), затем ведущий шлёт "next" и остальные свои команды,
+участники - "answer". Ответ зависит от типа вопроса (question.type): номер варианта из присланного answers,
+начиная с 1, у single и true_false, массив номеров у multiple, строка text у text, число number у numeric.
+Если время на вопрос ограничено, по его истечении сессия сама переходит к следующему вопросу.
+Отключившийся участник возвращается командой "resume" с resume_token из события joined (или снова "join"
+тем же аккаунтом) и получает snapshot - его ответы и очки сохраняются.
+Клиенты одной сессии могут быть подключены к разным экземплярам API (live.broker = "postgres").
+
+Client -> server:
+{ "type": "host", "access_token": String }
+{ "type": "join", "access_token": String, "display_name": String }
+{ "type": "join", "guest_token": String } - a guest joins under their nickname, it must be unique in the session
+{ "type": "resume", "resume_token": String }
+{ "type": "next" } - start the qwiz, then go to the next question, after the last one finish it
+{ "type": "reveal" } - close answers to the current question and show the correct one
+{ "type": "kick", "participant_id": Integer } - remove a participant, they can not rejoin
+{ "type": "pause" } / { "type": "unpause" } - stop and continue the countdown
+{ "type": "answer", "answer": Integer } - single, true_false
+{ "type": "answer", "answers": [Integer] } - multiple
+{ "type": "answer", "text": String } - text
+{ "type": "answer", "number": Float } - numeric
+{ "type": "answer", "sequence": [Integer] } - ordering: numbers in answers in the chosen order,
+  matching: for each of answers the number in matches
+
+Server -> client:
+{ "type": "hosting", "code": String, "participants": [Participant] }
+{ "type": "joined", "code": String, "participant": Participant, "resume_token": String }
+{ "type": "snapshot", "code": String, "participant": Participant, "participants": [Participant], "resume_token": String,
+  "state": "waiting"/"question"/"finished", "question": Question, "answered": Boolean, "score": Integer, "scores": [Score] }
+{ "type": "participants", "participants": [Participant] }
+{ "type": "question", "question": { number, total, type, body, embed_uri, answers: [String], answer_embed_uris: [String],
+  matches: [String], time_limit, deadline, remaining_ms, paused } }
+  - sent again on pause and unpause
+{ "type": "answer_accepted" }
+{ "type": "reveal", "correct": Integer, "correct_answers": [Integer], "accepted_answers": [String], "numeric_answer": Float,
+  "correct_sequence": [Integer], "distribution": [Integer] } - correct for single and true_false, correct_answers (numbers
+  in answers) for choice questions, accepted_answers for text, numeric_answer for numeric, correct_sequence (as in the
+  answer sequence) for ordering and matching; only fully correct ordering and matching answers get points
+{ "type": "kicked" }
+{ "type": "dashboard", "dashboard": { number, answered, total, distribution: [Integer], unanswered: [Participant], revealed, paused } } - host only
+{ "type": "finished", "scores": [{ id, display_name, profile_picture_uri, score }] }
+{ "type": "error", "error": String }
+
+Итоги завершённой сессии сохраняются. Номера вопросов начинаются с 0, как в событиях сессии,
+choice - номер варианта с 1 в исходном порядке вопроса (без перемешивания), у multiple - choices, время - в миллисекундах.
+У ordering и matching sequence - как Response.Sequence вопроса, matches - пары вариантов matching.
+
+GET /live/history/qwiz/<qwiz_id> - finished sessions of a qwiz, newest first (qwiz creator)
+Authorization: Bearer <access_token> - required
+
+GET /live/history/host/<account_id> - finished sessions of a host, newest first (the host)
+Authorization: Bearer <access_token> - required
+
+GET /live/history/<id> - session report (the host or the qwiz creator)
+Authorization: Bearer <access_token> - required
+Returns: { session: Session, questions: [{ number, type, body, answers: [String], matches: [String], correct, correct_answers, accepted_answers,
+  numeric_answer, tolerance, time_limit, correct_count }],
+  participants: [{ id, account_id (null for guests), display_name, score,
+  answers: [{ question_number, choice, choices, text, number, sequence, correct, response_time }] }] }
+`)
+}
+
+type PostLiveData struct {
+	QwizID           int32  `json:"qwiz_id" binding:"required"`
+	ShuffleQuestions bool   `json:"shuffle_questions"`
+	ShuffleAnswers   bool   `json:"shuffle_answers"`
+	Scoring          string `json:"scoring"`
+	StreakBonus      bool   `json:"streak_bonus"`
+	TimeLimit        uint16 `json:"time_limit"`
+}
+
+func createSession(c *gin.Context) {
+	var data PostLiveData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scoring, err := ParseScoring(data.Scoring)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	q, err := qwiz.GetByID(data.QwizID)
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Qwiz not found"})
+		return
+	}
+	host := account.Current(c)
+	if !q.Public && q.CreatorID != host.ID && !host.IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	}
+
+	session, err := NewSession(host.ID, q.ID, QwizOptions{
+		ShuffleQuestions: data.ShuffleQuestions,
+		ShuffleAnswers:   data.ShuffleAnswers,
+		Scoring:          scoring,
+		StreakBonus:      data.StreakBonus,
+		TimeLimit:        time.Duration(data.TimeLimit) * time.Second,
+	})
+	if errors.Is(err, ErrNoQuestions) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"code":     session.Code,
+		"location": fmt.Sprintf("%s/live/%s/ws", config.BaseURL, session.Code),
+	})
+}
+
+// Клиенты авторизуются токеном в первом сообщении, а не cookie, поэтому проверять Origin не нужно.
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+func authenticate(token string) (*account.Account, error) {
+	acct, _, err := account.GetByToken(token, account.AccessToken)
+	if err != nil && !errors.Is(err, account.ErrInvalidToken) {
+		utils.InternalErr(err)
+	}
+	return acct, err
+}
+
+func newParticipant(acct *account.Account, displayName string) QwizParticipant {
+	participant := QwizParticipant{ID: acct.ID, DisplayName: displayName}
+	if acct.ProfilePictureUUID != nil {
+		if picture, err := media.GetByUUID(acct.ProfilePictureUUID); err == nil {
+			participant.ProfilePictureURI = picture.URI
+		}
+	}
+	return participant
+}
+
+// joinAsGuest выдаёт гостевой токен для входа в сессию без аккаунта.
+func joinAsGuest(c *gin.Context) {
+	code := c.Param("code")
+	exists, err := sessionExists(code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Session not found"})
+		return
+	}
+	guest.Create(c, guest.LiveScope(code))
+}
+
+func serveSession(c *gin.Context) {
+	code := c.Param("code")
+	exists, err := sessionExists(code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Session not found"})
+		return
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade уже ответил клиенту
+		return
+	}
+	cl := newClient(conn)
+	go cl.writePump()
+
+	connID := uuid.NewString()
+	if err := connect(code, connID, cl); err != nil {
+		utils.InternalErr(err)
+		cl.close()
+		return
+	}
+	defer func() {
+		if err := sendRequest(code, request{Conn: connID, Type: "leave"}); err != nil {
+			utils.InternalErr(err)
+		}
+		disconnect(code, connID)
+		cl.close()
+	}()
+
+	conn.SetReadLimit(maxMessageSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	fail := func(err error) {
+		cl.push(Event{Type: "error", Error: err.Error()})
+	}
+
+	// Права и состояние проверяет владелец сессии, здесь только аутентификация и разбор команд
+	for {
+		var cmd Command
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+
+		req := request{Conn: connID, Type: cmd.Type}
+		switch cmd.Type {
+		case "join":
+			if cmd.GuestToken == "" {
+				acct, err := authenticate(cmd.AccessToken)
+				if err != nil {
+					fail(errors.New("unauthorized"))
+					continue
+				}
+				req.AccountID = acct.ID
+				participant := newParticipant(acct, cmd.DisplayName)
+				req.Participant = &participant
+				break
+			}
+			g, err := guest.GetByToken(cmd.GuestToken, guest.LiveScope(code))
+			if err != nil {
+				if !errors.Is(err, guest.ErrInvalidToken) {
+					utils.InternalErr(err)
+				}
+				fail(errors.New("unauthorized"))
+				continue
+			}
+			req.Participant = &QwizParticipant{ID: g.ParticipantID(), DisplayName: g.Nickname}
+		case "host":
+			acct, err := authenticate(cmd.AccessToken)
+			if err != nil {
+				fail(errors.New("unauthorized"))
+				continue
+			}
+			req.AccountID, req.Admin = acct.ID, acct.IsAdmin()
+		case "resume":
+			req.ResumeToken = cmd.ResumeToken
+		case "next", "reveal", "pause", "unpause":
+		case "kick":
+			req.ParticipantID = cmd.ParticipantID
+		case "answer":
+			response, err := cmd.response()
+			if err != nil {
+				fail(err)
+				continue
+			}
+			req.Response = response
+		default:
+			fail(ErrUnknownCommand)
+			continue
+		}
+
+		if err := sendRequest(code, req); err != nil {
+			utils.InternalErr(err)
+			fail(errors.New("session unavailable"))
+		}
+	}
+}
+
+type GetSessionRecordData struct {
+	ID               int32   `json:"id"`
+	Code             string  `json:"code"`
+	QwizID           int32   `json:"qwiz_id"`
+	QwizName         string  `json:"qwiz_name"`
+	HostID           int32   `json:"host_id"`
+	Scoring          Scoring `json:"scoring"`
+	StreakBonus      bool    `json:"streak_bonus"`
+	CreateTime       int64   `json:"create_time"`
+	FinishTime       int64   `json:"finish_time"`
+	ParticipantCount int32   `json:"participant_count"`
+}
+
+type ReportQuestionData struct {
+	Number          int16         `json:"number"`
+	Type            question.Type `json:"type"`
+	Body            string        `json:"body"`
+	Answers         []string      `json:"answers"`
+	Matches         []string      `json:"matches,omitempty"`
+	Correct         *int16        `json:"correct"`
+	CorrectAnswers  []int32       `json:"correct_answers,omitempty"`
+	AcceptedAnswers []string      `json:"accepted_answers,omitempty"`
+	NumericAnswer   *float64      `json:"numeric_answer,omitempty"`
+	Tolerance       float64       `json:"tolerance,omitempty"`
+	TimeLimit       *int32        `json:"time_limit"`
+	CorrectCount    int           `json:"correct_count"`
+}
+
+type ReportAnswerData struct {
+	QuestionNumber int16    `json:"question_number"`
+	Choice         *int16   `json:"choice"`
+	Choices        []int32  `json:"choices,omitempty"`
+	Text           *string  `json:"text,omitempty"`
+	Number         *float64 `json:"number,omitempty"`
+	Sequence       []int32  `json:"sequence,omitempty"`
+	Correct        bool     `json:"correct"`
+	ResponseTime   *int32   `json:"response_time"`
+}
+
+type ReportParticipantData struct {
+	ID          int32              `json:"id"`
+	AccountID   *int32             `json:"account_id"`
+	DisplayName string             `json:"display_name"`
+	Score       int32              `json:"score"`
+	Answers     []ReportAnswerData `json:"answers"`
+}
+
+type GetReportData struct {
+	Session      GetSessionRecordData    `json:"session"`
+	Questions    []ReportQuestionData    `json:"questions"`
+	Participants []ReportParticipantData `json:"participants"`
+}
+
+func fromSessionRecord(record *SessionRecord) GetSessionRecordData {
+	return GetSessionRecordData{
+		ID:               record.ID,
+		Code:             record.Code,
+		QwizID:           record.QwizID,
+		QwizName:         record.QwizName,
+		HostID:           record.HostID,
+		Scoring:          record.Scoring,
+		StreakBonus:      record.StreakBonus,
+		CreateTime:       record.CreateTime.UnixMilli(),
+		FinishTime:       record.FinishTime.UnixMilli(),
+		ParticipantCount: record.ParticipantCount,
+	}
+}
+
+func idParam(c *gin.Context) (int32, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return 0, false
+	}
+	return int32(id), true
+}
+
+func respondSessionRecords(c *gin.Context, records []SessionRecord, err error) {
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	result := make([]GetSessionRecordData, 0, len(records))
+	for i := range records {
+		result = append(result, fromSessionRecord(&records[i]))
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func getQwizHistory(c *gin.Context) {
+	id, ok := idParam(c)
+	if !ok {
+		return
+	}
+	records, err := GetSessionRecordsByQwizID(id)
+	respondSessionRecords(c, records, err)
+}
+
+func getHostHistory(c *gin.Context) {
+	id, ok := idParam(c)
+	if !ok {
+		return
+	}
+	records, err := GetSessionRecordsByHostID(id)
+	respondSessionRecords(c, records, err)
+}
+
+func getReport(c *gin.Context) {
+	id, ok := idParam(c)
+	if !ok {
+		return
+	}
+	record, err := GetSessionRecord(id)
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Session not found"})
+		return
+	}
+	questions, err := record.Questions()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	participants, err := record.Participants()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	answers, err := record.Answers()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+
+	report := GetReportData{
+		Session:      fromSessionRecord(record),
+		Questions:    make([]ReportQuestionData, 0, len(questions)),
+		Participants: make([]ReportParticipantData, 0, len(participants)),
+	}
+	byParticipant := make(map[int32][]ReportAnswerData)
+	correctCounts := make(map[int16]int)
+	for _, a := range answers {
+		byParticipant[a.ParticipantID] = append(byParticipant[a.ParticipantID], ReportAnswerData{
+			QuestionNumber: a.QuestionNumber,
+			Choice:         a.Choice,
+			Choices:        a.Choices,
+			Text:           a.TextAnswer,
+			Number:         a.NumericAnswer,
+			Sequence:       a.Sequence,
+			Correct:        a.Correct,
+			ResponseTime:   a.ResponseTime,
+		})
+		if a.Correct {
+			correctCounts[a.QuestionNumber]++
+		}
+	}
+	for _, q := range questions {
+		report.Questions = append(report.Questions, ReportQuestionData{
+			Number:          q.Number,
+			Type:            q.Type,
+			Body:            q.Body,
+			Answers:         q.Answers,
+			Matches:         q.Matches,
+			Correct:         q.Correct,
+			CorrectAnswers:  q.CorrectAnswers,
+			AcceptedAnswers: q.AcceptedAnswers,
+			NumericAnswer:   q.NumericAnswer,
+			Tolerance:       q.Tolerance,
+			TimeLimit:       q.TimeLimit,
+			CorrectCount:    correctCounts[q.Number],
+		})
+	}
+	for _, p := range participants {
+		participantAnswers := byParticipant[p.ID]
+		if participantAnswers == nil {
+			participantAnswers = []ReportAnswerData{}
+		}
+		report.Participants = append(report.Participants, ReportParticipantData{
+			ID:          p.ID,
+			AccountID:   p.AccountID,
+			DisplayName: p.DisplayName,
+			Score:       p.Score,
+			Answers:     participantAnswers,
+		})
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// RegisterRoutes добавляет маршруты модуля live к роутеру Gin.
+func RegisterRoutes(r *gin.Engine) {
+	liveGroup := r.Group(config.BaseURL + "/live")
+	{
+		liveGroup.GET("", liveInfo)
+		liveGroup.POST("", account.RequireAuth(), createSession)
+		liveGroup.GET("/:code/ws", serveSession)
+		liveGroup.POST("/:code/guest", joinAsGuest)
+
+		id := authz.Param("id")
+		liveGroup.GET("/history/qwiz/:id", account.RequireAuth(), authz.Require(authz.CreatorOwnsQwiz(id)), getQwizHistory)
+		liveGroup.GET("/history/host/:id", account.RequireAuth(), authz.Require(authz.Self(id)), getHostHistory)
+		liveGroup.GET("/history/:id", account.RequireAuth(), authz.Require(authz.LiveSessionOwner(id)), getReport)
+	}
+}
@@ -0,0 +1,97 @@
+package live
+
+import (
+	"api/question"
+	"errors"
+	"math"
+	"time"
+)
+
+// Scoring - формула подсчёта очков за вопрос.
+type Scoring string
+
+const (
+	// ScoringClassic - одно очко за верный ответ, время не учитывается.
+	ScoringClassic Scoring = "classic"
+	// ScoringSpeed - как в Kahoot: за верный ответ до SpeedMaxPoints очков,
+	// к концу отведённого времени теряется половина.
+	ScoringSpeed Scoring = "speed"
+)
+
+var ErrInvalidScoring = errors.New("invalid scoring")
+
+// SpeedMaxPoints - очки за мгновенный верный ответ при ScoringSpeed. SpeedReference - время,
+// относительно которого считается скорость, если у вопроса нет ограничения по времени.
+var (
+	SpeedMaxPoints = 1000.0
+	SpeedReference = 20 * time.Second
+)
+
+// StreakStep - на сколько растёт множитель за каждый следующий верный ответ подряд,
+// MaxStreakMultiplier - его потолок.
+var (
+	StreakStep          = 0.1
+	MaxStreakMultiplier = 1.5
+)
+
+// ParseScoring принимает название формулы, пустая строка - ScoringClassic.
+func ParseScoring(name string) (Scoring, error) {
+	switch Scoring(name) {
+	case "", ScoringClassic:
+		return ScoringClassic, nil
+	case ScoringSpeed:
+		return ScoringSpeed, nil
+	default:
+		return "", ErrInvalidScoring
+	}
+}
+
+// points - очки за один верный ответ без учёта серии.
+func (s Scoring) points(responseTime, timeLimit time.Duration) float64 {
+	switch s {
+	case ScoringSpeed:
+		if timeLimit <= 0 {
+			timeLimit = SpeedReference
+		}
+		fraction := math.Min(float64(responseTime)/float64(timeLimit), 1)
+		return SpeedMaxPoints * (1 - fraction/2)
+	default:
+		return 1
+	}
+}
+
+// Score считает итог участника. correct, responseTimes и timeLimits - по номеру вопроса.
+// Сумма округляется один раз в конце, чтобы множитель серии не терялся на ScoringClassic.
+func (o QwizOptions) Score(correct []bool, responseTimes, timeLimits []time.Duration) uint {
+	var total float64
+	streak := 0
+	for i, ok := range correct {
+		if !ok {
+			streak = 0
+			continue
+		}
+		streak++
+
+		var responseTime, timeLimit time.Duration
+		if i < len(responseTimes) {
+			responseTime = responseTimes[i]
+		}
+		if i < len(timeLimits) {
+			timeLimit = timeLimits[i]
+		}
+		points := o.Scoring.points(responseTime, timeLimit)
+		if o.StreakBonus {
+			points *= math.Min(1+StreakStep*float64(streak-1), MaxStreakMultiplier)
+		}
+		total += points
+	}
+	return uint(math.Round(total))
+}
+
+// timeLimit - ограничение на вопрос: своё у вопроса или общее из настроек викторины, 0 - без ограничения.
+func (o QwizOptions) timeLimit(q *question.Question) time.Duration {
+	if q != nil && q.TimeLimit != nil {
+		return time.Duration(*q.TimeLimit) * time.Second
+	}
+	return o.TimeLimit
+}
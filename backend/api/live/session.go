@@ -0,0 +1,610 @@
+package live
+
+import (
+	"api/broker"
+	"api/guest"
+	"api/media"
+	"api/question"
+	"api/utils"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SessionTTL - сколько живёт сессия с момента создания, FinishedTTL - сколько после завершения
+// ещё можно переподключиться и получить таблицу результатов.
+var (
+	SessionTTL  = 6 * time.Hour
+	FinishedTTL = 10 * time.Minute
+)
+
+// Broker связывает экземпляры API: состояние сессии живёт на экземпляре, где её создали,
+// остальные пересылают ему команды своих клиентов и раздают клиентам его события.
+// main заменяет его на broker.PostgresBroker, если экземпляров несколько.
+var Broker broker.Broker = broker.NewMemoryBroker()
+
+const maxDisplayNameLength = 30
+
+var (
+	ErrSessionNotFound  = errors.New("session not found")
+	ErrAlreadyStarted   = errors.New("session already started")
+	ErrAlreadyJoined    = errors.New("already joined")
+	ErrAlreadyConnected = errors.New("already connected")
+	ErrForbidden        = errors.New("forbidden")
+	ErrInvalidName      = errors.New("invalid display name")
+	ErrNameTaken        = errors.New("display name is taken")
+	ErrNoQuestions      = errors.New("qwiz has no questions")
+	ErrInvalidResume    = errors.New("invalid resume token")
+	ErrUnknownCommand   = errors.New("unknown command")
+)
+
+// Session - одна проводимая викторина: состояние Qwiz и соединения, которые к ней подключены.
+// Соединения обозначаются id и могут быть открыты на любом экземпляре API.
+// Все изменения состояния идут под mu.
+type Session struct {
+	Code   string
+	HostID int32
+
+	mu      sync.Mutex
+	qwiz    *Qwiz
+	host    string
+	clients map[int32]string
+	// resumeTokens - по токену из события joined участник переподключается без повторного входа
+	resumeTokens map[string]QwizParticipant
+	// kicked - удалённые ведущим участники
+	kicked map[int32]bool
+	// played - заданные вопросы по порядку, для истории
+	played     []playedQuestion
+	createTime time.Time
+	// timer переключает вопрос, когда истекает время на ответ. timerGen меняется при каждом
+	// запуске и остановке: Stop не отменяет уже запущенный обработчик, и тот сверяет поколение.
+	timer    *time.Timer
+	timerGen uint64
+	sub      broker.Subscription
+	closed   bool
+}
+
+// Темы брокера: команды идут экземпляру-владельцу сессии, события - всем экземплярам с её клиентами.
+func commandTopic(code string) string { return "live." + code + ".cmd" }
+func eventTopic(code string) string   { return "live." + code + ".evt" }
+func claimKey(code string) string     { return "live." + code }
+
+// request - команда соединения. Токены проверяет экземпляр, принявший соединение,
+// владельцу приходят уже аккаунт и данные участника.
+type request struct {
+	Conn          string           `json:"conn"`
+	Type          string           `json:"type"`
+	AccountID     int32            `json:"account_id,omitempty"`
+	Admin         bool             `json:"admin,omitempty"`
+	Participant   *QwizParticipant `json:"participant,omitempty"`
+	ResumeToken   string           `json:"resume_token,omitempty"`
+	Response      *Response        `json:"response,omitempty"`
+	ParticipantID int32            `json:"participant_id,omitempty"`
+}
+
+// envelope - событие для соединений To, пустой To - для всех соединений сессии.
+// Close закрывает эти соединения после события.
+type envelope struct {
+	To    []string `json:"to,omitempty"`
+	Event *Event   `json:"event,omitempty"`
+	Close bool     `json:"close,omitempty"`
+}
+
+// NewSession загружает вопросы викторины, занимает новый код для входа и начинает принимать команды.
+func NewSession(hostID, qwizID int32, options QwizOptions) (*Session, error) {
+	questions, err := question.GetAllQuestionsByQwizID(qwizID)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, ErrNoQuestions
+	}
+
+	session := &Session{
+		HostID:       hostID,
+		qwiz:         newLiveQwiz(qwizID, options, questions, nil),
+		clients:      make(map[int32]string),
+		resumeTokens: make(map[string]QwizParticipant),
+		kicked:       make(map[int32]bool),
+		createTime:   time.Now().UTC(),
+	}
+
+	for {
+		code, err := generateCode()
+		if err != nil {
+			return nil, err
+		}
+		claimed, err := Broker.Claim(claimKey(code), SessionTTL)
+		if err != nil {
+			return nil, err
+		}
+		if claimed {
+			session.Code = code
+			break
+		}
+	}
+
+	session.sub, err = Broker.Subscribe(commandTopic(session.Code), session.handle)
+	if err != nil {
+		_ = Broker.Release(claimKey(session.Code))
+		return nil, err
+	}
+	time.AfterFunc(SessionTTL, session.close)
+
+	return session, nil
+}
+
+// sessionExists проверяет код на всех экземплярах.
+func sessionExists(code string) (bool, error) {
+	return Broker.Claimed(claimKey(code))
+}
+
+// sendRequest пересылает команду владельцу сессии, где бы он ни был.
+func sendRequest(code string, req request) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return Broker.Publish(commandTopic(code), payload)
+}
+
+// close отключает всех клиентов и освобождает код.
+func (s *Session) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.stopTimer()
+	s.publish(envelope{Close: true})
+	s.sub.Unsubscribe()
+	if err := Broker.Release(claimKey(s.Code)); err != nil {
+		utils.InternalErr(err)
+	}
+}
+
+// generateResumeToken возвращает случайный токен для переподключения.
+func generateResumeToken() (string, error) {
+	bytes := make([]byte, 24)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// generateCode возвращает шестизначный код для входа.
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func (s *Session) handle(payload []byte) {
+	var req request
+	if err := json.Unmarshal(payload, &req); err != nil {
+		log.Printf("Live session %s: invalid request: %v", s.Code, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	var err error
+	switch req.Type {
+	case "host":
+		err = s.attachHost(req.Conn, req.AccountID, req.Admin)
+	case "join":
+		if req.Participant == nil {
+			err = ErrInvalidName
+			break
+		}
+		err = s.join(req.Conn, *req.Participant)
+	case "resume":
+		err = s.resume(req.Conn, req.ResumeToken)
+	case "next":
+		err = s.advance(req.Conn)
+	case "answer":
+		if req.Response == nil {
+			err = ErrInvalidAnswer
+			break
+		}
+		err = s.answer(req.Conn, *req.Response)
+	case "reveal":
+		err = s.reveal(req.Conn)
+	case "kick":
+		err = s.kick(req.Conn, req.ParticipantID)
+	case "pause":
+		err = s.pause(req.Conn)
+	case "unpause":
+		err = s.unpause(req.Conn)
+	case "leave":
+		s.leave(req.Conn)
+	default:
+		err = ErrUnknownCommand
+	}
+	if err != nil {
+		s.send(req.Conn, Event{Type: "error", Error: err.Error()})
+	}
+}
+
+func (s *Session) publish(env envelope) {
+	payload, err := json.Marshal(env)
+	if err != nil {
+		utils.InternalErr(err)
+		return
+	}
+	if err := Broker.Publish(eventTopic(s.Code), payload); err != nil {
+		utils.InternalErr(err)
+	}
+}
+
+func (s *Session) send(conn string, event Event) {
+	s.publish(envelope{To: []string{conn}, Event: &event})
+}
+
+func (s *Session) broadcast(event Event) {
+	s.publish(envelope{Event: &event})
+}
+
+func (s *Session) disconnect(conn string) {
+	s.publish(envelope{To: []string{conn}, Close: true})
+}
+
+func (s *Session) connected(conn string) bool {
+	if conn == s.host {
+		return true
+	}
+	_, ok := s.participantOf(conn)
+	return ok
+}
+
+func (s *Session) participantOf(conn string) (int32, bool) {
+	for id, other := range s.clients {
+		if other == conn {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Session) attachHost(conn string, accountID int32, admin bool) error {
+	if s.connected(conn) {
+		return ErrAlreadyConnected
+	}
+	if accountID != s.HostID && !admin {
+		return ErrForbidden
+	}
+
+	if s.host != "" {
+		s.disconnect(s.host)
+	}
+	s.host = conn
+	s.send(conn, Event{Type: "hosting", Code: s.Code, Participants: s.participantsData()})
+	s.pushState(conn)
+	s.pushDashboard()
+	return nil
+}
+
+// join добавляет участника. Новые участники входят только до первого вопроса,
+// уже участвующий, но отключившийся аккаунт переподключается так же, как по resume.
+func (s *Session) join(conn string, participant QwizParticipant) error {
+	if s.connected(conn) {
+		return ErrAlreadyConnected
+	}
+	if participant.DisplayName == "" || len([]rune(participant.DisplayName)) > maxDisplayNameLength {
+		return ErrInvalidName
+	}
+	if s.kicked[participant.ID] {
+		return ErrKicked
+	}
+
+	if _, ok := s.qwiz.Participant(participant.ID); ok {
+		if _, connected := s.clients[participant.ID]; connected {
+			return ErrAlreadyJoined
+		}
+		s.reattach(conn, participant.ID)
+		return nil
+	}
+	if _, ok := s.qwiz.State.(*StartingLiveQwiz); !ok {
+		return ErrAlreadyStarted
+	}
+	// У гостей нет аккаунта, отличить их друг от друга можно только по нику
+	if guest.IsGuestID(participant.ID) && s.nameTaken(participant.DisplayName) {
+		return ErrNameTaken
+	}
+
+	token, ok := s.resumeToken(participant.ID)
+	if !ok {
+		var err error
+		if token, err = generateResumeToken(); err != nil {
+			return err
+		}
+		s.resumeTokens[token] = participant
+	}
+
+	s.qwiz.Participants = append(s.qwiz.Participants, participant)
+	s.clients[participant.ID] = conn
+
+	data := participantData(participant)
+	s.send(conn, Event{Type: "joined", Code: s.Code, Participant: &data, ResumeToken: token})
+	s.broadcast(Event{Type: "participants", Participants: s.participantsData()})
+	return nil
+}
+
+// resume переподключает участника по токену. Это тот же участник: его ответы и очки сохраняются,
+// прежнее соединение, если оно ещё открыто, закрывается. В ответ приходит снимок состояния.
+func (s *Session) resume(conn string, token string) error {
+	if s.connected(conn) {
+		return ErrAlreadyConnected
+	}
+	participant, ok := s.resumeTokens[token]
+	if !ok {
+		return ErrInvalidResume
+	}
+	if s.kicked[participant.ID] {
+		return ErrKicked
+	}
+	if _, joined := s.qwiz.Participant(participant.ID); !joined {
+		// Отключившийся до старта выбывает из списка, вернуться в него можно, пока викторина не началась
+		if _, ok := s.qwiz.State.(*StartingLiveQwiz); !ok {
+			return ErrAlreadyStarted
+		}
+		s.qwiz.Participants = append(s.qwiz.Participants, participant)
+		s.broadcast(Event{Type: "participants", Participants: s.participantsData()})
+	}
+	s.reattach(conn, participant.ID)
+	return nil
+}
+
+func (s *Session) nameTaken(displayName string) bool {
+	for _, p := range s.qwiz.Participants {
+		if strings.EqualFold(p.DisplayName, displayName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Session) reattach(conn string, participantID int32) {
+	if old, ok := s.clients[participantID]; ok && old != conn {
+		s.disconnect(old)
+	}
+	s.clients[participantID] = conn
+	s.send(conn, s.snapshot(participantID))
+}
+
+func (s *Session) resumeToken(participantID int32) (string, bool) {
+	for token, p := range s.resumeTokens {
+		if p.ID == participantID {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// snapshot - всё, что нужно клиенту участника, чтобы продолжить с того же места.
+func (s *Session) snapshot(participantID int32) Event {
+	participant, _ := s.qwiz.Participant(participantID)
+	data := participantData(*participant)
+	token, _ := s.resumeToken(participantID)
+	event := Event{
+		Type:         "snapshot",
+		Code:         s.Code,
+		Participant:  &data,
+		Participants: s.participantsData(),
+		ResumeToken:  token,
+	}
+
+	switch state := s.qwiz.State.(type) {
+	case *StartingLiveQwiz:
+		event.State = "waiting"
+	case *RunningLiveQwiz:
+		answered := state.Answered(participantID)
+		score := state.Score(participantID, s.qwiz.Options)
+		event.State = "question"
+		event.Question = s.questionData(state)
+		event.Answered = &answered
+		event.Score = &score
+		if state.Revealed {
+			revealData(state, &event)
+		}
+	case *FinishingLiveQwiz:
+		score := state.Scores[participantID]
+		event.State = "finished"
+		event.Scores = s.scoresData(state)
+		event.Score = &score
+	}
+	return event
+}
+
+func (s *Session) leave(conn string) {
+	if s.host == conn {
+		s.host = ""
+		return
+	}
+	id, ok := s.participantOf(conn)
+	if !ok {
+		return
+	}
+	delete(s.clients, id)
+	// До старта ушедший участник просто выбывает, после старта его ответы остаются в подсчёте
+	if _, ok := s.qwiz.State.(*StartingLiveQwiz); ok {
+		for i, p := range s.qwiz.Participants {
+			if p.ID == id {
+				s.qwiz.Participants = append(s.qwiz.Participants[:i], s.qwiz.Participants[i+1:]...)
+				break
+			}
+		}
+		s.broadcast(Event{Type: "participants", Participants: s.participantsData()})
+	}
+}
+
+// advance переходит к следующему вопросу или к итогам. Доступно только ведущему.
+func (s *Session) advance(conn string) error {
+	if conn != s.host {
+		return ErrForbidden
+	}
+	if _, ok := s.qwiz.State.(*FinishingLiveQwiz); ok {
+		return ErrNotRunning
+	}
+	s.next()
+	return nil
+}
+
+func (s *Session) next() {
+	s.stopTimer()
+	s.qwiz.Advance()
+
+	switch state := s.qwiz.State.(type) {
+	case *RunningLiveQwiz:
+		s.played = append(s.played, newPlayedQuestion(state))
+		s.pushState("")
+		s.startTimer(state)
+		s.pushDashboard()
+	case *FinishingLiveQwiz:
+		// История пишется до события finished: получив его, ведущий может сразу запросить отчёт
+		if err := s.saveHistory(state); err != nil {
+			utils.InternalErr(err)
+		}
+		s.pushState("")
+		time.AfterFunc(FinishedTTL, s.close)
+	}
+}
+
+// timeUp переключает вопрос, если таймер gen ещё действует: ведущий не переключил вопрос раньше,
+// не поставил паузу и не открыл ответы.
+func (s *Session) timeUp(gen uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed || gen != s.timerGen {
+		return
+	}
+	running, ok := s.qwiz.State.(*RunningLiveQwiz)
+	if !ok || running.Paused() {
+		return
+	}
+	if deadline, ok := running.Deadline(); !ok || time.Now().Before(deadline) {
+		return
+	}
+	s.next()
+}
+
+func (s *Session) answer(conn string, response Response) error {
+	participantID, ok := s.participantOf(conn)
+	if !ok {
+		return ErrForbidden
+	}
+	running, ok := s.qwiz.State.(*RunningLiveQwiz)
+	if !ok {
+		return ErrNotRunning
+	}
+	if err := running.Respond(participantID, response); err != nil {
+		return err
+	}
+	s.send(conn, Event{Type: "answer_accepted"})
+	s.pushDashboard()
+	return nil
+}
+
+// pushState отправляет текущее состояние одному соединению или, если conn пуст, всем.
+func (s *Session) pushState(conn string) {
+	var event Event
+	switch state := s.qwiz.State.(type) {
+	case *StartingLiveQwiz:
+		return
+	case *RunningLiveQwiz:
+		event = Event{Type: "question", Question: s.questionData(state)}
+	case *FinishingLiveQwiz:
+		event = Event{Type: "finished", Scores: s.scoresData(state)}
+	}
+
+	if conn == "" {
+		s.broadcast(event)
+	} else {
+		s.send(conn, event)
+	}
+}
+
+func (s *Session) questionData(state *RunningLiveQwiz) *QuestionData {
+	data := &QuestionData{
+		Number:  state.QuestionNumber,
+		Total:   cap(s.qwiz.Questions),
+		Answers: state.CurrentAnswers,
+		Matches: state.CurrentMatches,
+	}
+	if deadline, ok := state.Deadline(); ok {
+		data.TimeLimit = int(state.TimeLimit / time.Second)
+		// На паузе срок сдвигается, поэтому отдаётся только замерший остаток
+		now := time.Now()
+		if state.Paused() {
+			now = state.PausedAt
+		} else {
+			data.Deadline = &deadline
+		}
+		if remaining := deadline.Sub(now); remaining > 0 {
+			data.RemainingMs = remaining.Milliseconds()
+		}
+	}
+	data.Paused = state.Paused()
+	if state.Question != nil {
+		data.Type = state.Question.Kind()
+		data.Body = state.Question.Body
+		if state.Question.EmbedUUID != nil {
+			if embed, err := media.GetByUUID(state.Question.EmbedUUID); err == nil {
+				data.EmbedURI = &embed.URI
+			}
+		}
+		for i, idx := range state.AnswerOrder {
+			embedUUID := state.Question.Answers[idx].EmbedUUID
+			if embedUUID == nil {
+				continue
+			}
+			if data.AnswerEmbedURIs == nil {
+				data.AnswerEmbedURIs = make([]*string, len(state.AnswerOrder))
+			}
+			if embed, err := media.GetByUUID(embedUUID); err == nil {
+				data.AnswerEmbedURIs[i] = &embed.URI
+			}
+		}
+	}
+	return data
+}
+
+func (s *Session) participantsData() []ParticipantData {
+	result := make([]ParticipantData, 0, len(s.qwiz.Participants))
+	for _, p := range s.qwiz.Participants {
+		result = append(result, participantData(p))
+	}
+	return result
+}
+
+// scoresData - таблица результатов по убыванию очков.
+func (s *Session) scoresData(state *FinishingLiveQwiz) []ScoreData {
+	scores := make([]ScoreData, 0, len(s.qwiz.Participants))
+	for _, p := range s.qwiz.Participants {
+		scores = append(scores, ScoreData{ParticipantData: participantData(p), Score: state.Scores[p.ID]})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].DisplayName < scores[j].DisplayName
+	})
+	return scores
+}
@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Message - письмо пользователю. Тело - обычный текст.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer отправляет письма. Реализация выбирается в main по config.toml:
+// SMTPMailer для продакшена, FileMailer для локальной разработки.
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// Default используется всеми модулями. По умолчанию письма печатаются в stdout.
+var Default Mailer = &FileMailer{}
+
+// LinkBaseURL - адрес фронтенда, на который ведут ссылки из писем.
+var LinkBaseURL = "http://localhost:8080"
+
+func Send(msg Message) error {
+	return Default.Send(msg)
+}
+
+// Link собирает ссылку на страницу фронтенда с токеном из письма.
+func Link(path, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", strings.TrimSuffix(LinkBaseURL, "/"), strings.TrimPrefix(path, "/"), token)
+}
@@ -3,9 +3,18 @@ package main
 import (
 	"api/account"
 	"api/assignment"
+	"api/audit"
+	"api/authz"
+	"api/broker"
 	"api/class"
 	"api/config"
+	"api/crypto"
+	"api/guest"
+	"api/limiter"
+	"api/live"
+	"api/mailer"
 	"api/media"
+	"api/parent"
 	"api/question"
 	"api/qwiz"
 	"api/utils"
@@ -45,6 +54,87 @@ func main() {
 	// Преобразование 10 в байты (10 MiB)
 	byteLimit := int64(limitValue) << 20
 
+	// Время жизни токенов доступа и обновления
+	if viper.IsSet("default.auth.access_ttl") {
+		account.AccessTokenTTL = viper.GetDuration("default.auth.access_ttl")
+	}
+	if viper.IsSet("default.auth.refresh_ttl") {
+		account.RefreshTokenTTL = viper.GetDuration("default.auth.refresh_ttl")
+	}
+
+	// Стоимость хеширования паролей argon2id
+	if viper.IsSet("default.auth.argon2.memory") {
+		crypto.Argon2Params.Memory = viper.GetUint32("default.auth.argon2.memory")
+	}
+	if viper.IsSet("default.auth.argon2.iterations") {
+		crypto.Argon2Params.Iterations = viper.GetUint32("default.auth.argon2.iterations")
+	}
+	if viper.IsSet("default.auth.argon2.parallelism") {
+		crypto.Argon2Params.Parallelism = uint8(viper.GetUint("default.auth.argon2.parallelism"))
+	}
+
+	// Блокировка перебора паролей
+	lockoutPolicy := limiter.DefaultPolicy
+	if viper.IsSet("default.auth.lockout.free_attempts") {
+		lockoutPolicy.FreeAttempts = viper.GetInt("default.auth.lockout.free_attempts")
+	}
+	if viper.IsSet("default.auth.lockout.base_delay") {
+		lockoutPolicy.BaseDelay = viper.GetDuration("default.auth.lockout.base_delay")
+	}
+	if viper.IsSet("default.auth.lockout.max_delay") {
+		lockoutPolicy.MaxDelay = viper.GetDuration("default.auth.lockout.max_delay")
+	}
+	if viper.IsSet("default.auth.lockout.window") {
+		lockoutPolicy.Window = viper.GetDuration("default.auth.lockout.window")
+	}
+	ipLockoutPolicy := lockoutPolicy
+	ipLockoutPolicy.FreeAttempts = account.IPPolicy.FreeAttempts
+	if viper.IsSet("default.auth.lockout.ip_free_attempts") {
+		ipLockoutPolicy.FreeAttempts = viper.GetInt("default.auth.lockout.ip_free_attempts")
+	}
+	lockoutStore := viper.GetString("default.auth.lockout.store")
+
+	// Гости без аккаунта: срок жизни, ограничение по IP и дополнительные запрещённые слова в никах
+	guestPolicy := guest.Policy
+	if viper.IsSet("default.guest.ttl") {
+		guest.TTL = viper.GetDuration("default.guest.ttl")
+	}
+	if viper.IsSet("default.guest.free_joins") {
+		guestPolicy.FreeAttempts = viper.GetInt("default.guest.free_joins")
+	}
+	if viper.IsSet("default.guest.window") {
+		guestPolicy.Window = viper.GetDuration("default.guest.window")
+	}
+	guest.BlockedWords = append(guest.BlockedWords, viper.GetStringSlice("default.guest.blocked_words")...)
+	liveBroker := viper.GetString("default.live.broker")
+
+	// Время жизни ссылок из писем
+	if viper.IsSet("default.auth.verify_email_ttl") {
+		account.VerifyEmailTokenTTL = viper.GetDuration("default.auth.verify_email_ttl")
+	}
+	if viper.IsSet("default.auth.reset_password_ttl") {
+		account.ResetPasswordTokenTTL = viper.GetDuration("default.auth.reset_password_ttl")
+	}
+
+	// Отправка писем: "smtp" или "file" (пустой mail.path - печать в stdout)
+	if viper.IsSet("default.mail.link_url") {
+		mailer.LinkBaseURL = viper.GetString("default.mail.link_url")
+	}
+	switch driver := viper.GetString("default.mail.driver"); driver {
+	case "smtp":
+		mailer.Default = &mailer.SMTPMailer{
+			Host:     viper.GetString("default.mail.smtp.host"),
+			Port:     viper.GetInt("default.mail.smtp.port"),
+			Username: viper.GetString("default.mail.smtp.username"),
+			Password: viper.GetString("default.mail.smtp.password"),
+			From:     viper.GetString("default.mail.from"),
+		}
+	case "", "file":
+		mailer.Default = &mailer.FileMailer{Path: viper.GetString("default.mail.path")}
+	default:
+		log.Fatalf("Unknown mail driver %q", driver)
+	}
+
 	// Загрузка переменных окружения
 	// (аналог dotenv() в Rust)
 	// (предполагается, что вы используете пакет github.com/joho/godotenv)
@@ -70,12 +160,47 @@ func main() {
 
 	account.DB = database
 	assignment.DB = database
+	audit.DB = database
+	authz.DB = database
 	class.DB = database
+	guest.DB = database
+	live.DB = database
 	media.DB = database
+	parent.DB = database
 	question.DB = database
 	vote.DB = database
 	qwiz.DB = database
 
+	// Счётчики в Postgres общие для всех экземпляров API, в памяти - только для одного
+	switch lockoutStore {
+	case "postgres":
+		account.AccountLimiter = limiter.NewPostgresLimiter(database, lockoutPolicy)
+		account.IPLimiter = limiter.NewPostgresLimiter(database, ipLockoutPolicy)
+		guest.Limiter = limiter.NewPostgresLimiter(database, guestPolicy)
+	case "", "memory":
+		account.AccountLimiter = limiter.NewMemoryLimiter(lockoutPolicy)
+		account.IPLimiter = limiter.NewMemoryLimiter(ipLockoutPolicy)
+		guest.Limiter = limiter.NewMemoryLimiter(guestPolicy)
+	default:
+		log.Fatalf("Unknown lockout store %q", lockoutStore)
+	}
+
+	// Живые викторины на нескольких экземплярах API обмениваются событиями через Postgres
+	switch liveBroker {
+	case "postgres":
+		pgBroker := broker.NewPostgresBroker(database, databaseURL)
+		defer func() {
+			if err := pgBroker.Close(); err != nil {
+				log.Printf("Failed to close live broker: %v", err)
+			}
+		}()
+		live.Broker = pgBroker
+	case "", "memory":
+		live.Broker = broker.NewMemoryBroker()
+	default:
+		log.Fatalf("Unknown live broker %q", liveBroker)
+	}
+
 	gin.SetMode(gin.ReleaseMode)
 
 	// Создаем экземпляр gin без предустановленных миддлваров
@@ -100,6 +225,8 @@ func main() {
 	class.RegisterRoutes(r)               // маршруты для классов
 	vote.RegisterRoutes(r)                // маршруты для голосования
 	media.RegisterRoutes(r)               // маршруты для медиа
+	live.RegisterRoutes(r)                // маршруты для живых викторин
+	parent.RegisterRoutes(r)              // маршруты для связей родителей с учениками
 	account.RegisterRoutes(r)             // маршруты для аккаунтов и заданий
 	question.RegisterRoutes(r)            // маршруты для вопросов
 	qwiz.RegisterRoutes(r)                // маршруты для викторины
@@ -0,0 +1,18 @@
+-- Токены доступа вместо передачи пароля в каждом запросе
+
+CREATE TYPE public.token_kind AS ENUM (
+    'access',
+    'refresh'
+);
+
+CREATE TABLE public.token (
+    token_hash character(64) NOT NULL,
+    session_uuid uuid NOT NULL,
+    account_id integer NOT NULL,
+    kind public.token_kind NOT NULL,
+    expire_time timestamp without time zone NOT NULL,
+    CONSTRAINT token_pkey PRIMARY KEY (token_hash),
+    CONSTRAINT token_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX token_session_uuid_idx ON public.token USING btree (session_uuid);
@@ -0,0 +1,4 @@
+-- character(128) дополняет хеши argon2id в формате PHC пробелами и ограничивает рост параметров.
+-- Старые хеши SHA-512 остаются валидными и переписываются при следующем входе.
+
+ALTER TABLE public.account ALTER COLUMN password_hash TYPE character varying(255) USING rtrim(password_hash);
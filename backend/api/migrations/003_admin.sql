@@ -0,0 +1,3 @@
+-- Администратор может действовать над любым ресурсом, проверки прав - в пакете authz.
+
+ALTER TYPE public.account_type ADD VALUE IF NOT EXISTS 'admin';
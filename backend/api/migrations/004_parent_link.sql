@@ -0,0 +1,21 @@
+-- Связь родителя с учеником: приглашение родителя подтверждает ученик или его учитель
+
+CREATE TYPE public.parent_link_status AS ENUM (
+    'pending',
+    'accepted'
+);
+
+CREATE TABLE public.parent_link (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    parent_id integer NOT NULL,
+    student_id integer NOT NULL,
+    status public.parent_link_status DEFAULT 'pending'::public.parent_link_status NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    accept_time timestamp without time zone,
+    accepted_by integer,
+    CONSTRAINT parent_link_pkey PRIMARY KEY (id),
+    CONSTRAINT parent_link_parent_id_student_id_key UNIQUE (parent_id, student_id),
+    CONSTRAINT parent_link_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.account(id) ON DELETE CASCADE,
+    CONSTRAINT parent_link_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE,
+    CONSTRAINT parent_link_accepted_by_fkey FOREIGN KEY (accepted_by) REFERENCES public.account(id) ON DELETE SET NULL
+);
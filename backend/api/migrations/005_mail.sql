@@ -0,0 +1,20 @@
+-- Электронная почта аккаунта и одноразовые токены из писем (подтверждение адреса, сброс пароля)
+
+ALTER TABLE public.account ADD COLUMN email character varying(254);
+ALTER TABLE public.account ADD COLUMN email_verified boolean DEFAULT false NOT NULL;
+ALTER TABLE public.account ADD CONSTRAINT account_email_key UNIQUE (email);
+
+CREATE TYPE public.mail_token_purpose AS ENUM (
+    'verify_email',
+    'reset_password'
+);
+
+CREATE TABLE public.mail_token (
+    token_hash character(64) NOT NULL,
+    account_id integer NOT NULL,
+    purpose public.mail_token_purpose NOT NULL,
+    email character varying(254) NOT NULL,
+    expire_time timestamp without time zone NOT NULL,
+    CONSTRAINT mail_token_pkey PRIMARY KEY (token_hash),
+    CONSTRAINT mail_token_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
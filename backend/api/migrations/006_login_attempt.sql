@@ -0,0 +1,24 @@
+-- Счётчики неудачных попыток входа, общие для всех экземпляров API, и журнал аудита.
+-- account_id в журнале без внешнего ключа: записи переживают удаление аккаунта.
+
+CREATE TABLE public.login_attempt (
+    key character varying(100) NOT NULL,
+    failures integer NOT NULL,
+    last_failure timestamp without time zone NOT NULL,
+    locked_until timestamp without time zone,
+    CONSTRAINT login_attempt_pkey PRIMARY KEY (key)
+);
+
+CREATE INDEX login_attempt_last_failure_idx ON public.login_attempt USING btree (last_failure);
+
+CREATE TABLE public.audit_log (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    "time" timestamp without time zone NOT NULL,
+    event character varying(50) NOT NULL,
+    account_id integer,
+    ip character varying(45) NOT NULL,
+    details text DEFAULT ''::text NOT NULL,
+    CONSTRAINT audit_log_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX audit_log_account_id_idx ON public.audit_log USING btree (account_id);
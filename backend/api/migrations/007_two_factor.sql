@@ -0,0 +1,18 @@
+-- Двухфакторная аутентификация TOTP (RFC 6238) и одноразовые коды восстановления
+
+CREATE TABLE public.two_factor (
+    account_id integer NOT NULL,
+    secret character varying(64) NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    last_used_step bigint DEFAULT 0 NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    CONSTRAINT two_factor_pkey PRIMARY KEY (account_id),
+    CONSTRAINT two_factor_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
+
+CREATE TABLE public.recovery_code (
+    account_id integer NOT NULL,
+    code_hash character(64) NOT NULL,
+    CONSTRAINT recovery_code_pkey PRIMARY KEY (account_id, code_hash),
+    CONSTRAINT recovery_code_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
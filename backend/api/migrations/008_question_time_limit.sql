@@ -0,0 +1,4 @@
+-- Ограничение по времени на вопрос в секундах, NULL - без ограничения
+
+ALTER TABLE public.question ADD COLUMN time_limit smallint;
+ALTER TABLE public.question ADD CONSTRAINT time_limit_check CHECK (((time_limit IS NULL) OR (time_limit > 0)));
@@ -0,0 +1,14 @@
+-- Брокер живых викторин на Postgres: занятые ключи и сообщения длиннее лимита NOTIFY
+
+CREATE TABLE public.broker_claim (
+    key character varying(100) NOT NULL,
+    expire_time timestamp without time zone NOT NULL,
+    CONSTRAINT broker_claim_pkey PRIMARY KEY (key)
+);
+
+CREATE TABLE public.broker_message (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    payload bytea NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    CONSTRAINT broker_message_pkey PRIMARY KEY (id)
+);
@@ -0,0 +1,52 @@
+-- История живых викторин: сессии, заданные вопросы, участники и их ответы
+
+CREATE TABLE public.live_session (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    code character(6) NOT NULL,
+    qwiz_id integer NOT NULL,
+    host_id integer NOT NULL,
+    scoring character varying(20) NOT NULL,
+    streak_bonus boolean DEFAULT false NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    finish_time timestamp without time zone NOT NULL,
+    CONSTRAINT live_session_pkey PRIMARY KEY (id),
+    CONSTRAINT live_session_qwiz_id_fkey FOREIGN KEY (qwiz_id) REFERENCES public.qwiz(id) ON DELETE CASCADE,
+    CONSTRAINT live_session_host_id_fkey FOREIGN KEY (host_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX live_session_qwiz_id_idx ON public.live_session USING btree (qwiz_id);
+CREATE INDEX live_session_host_id_idx ON public.live_session USING btree (host_id);
+
+CREATE TABLE public.live_question (
+    session_id integer NOT NULL,
+    number smallint NOT NULL,
+    body character varying(500) NOT NULL,
+    answers character varying(200)[] NOT NULL,
+    correct smallint NOT NULL,
+    time_limit integer,
+    CONSTRAINT live_question_pkey PRIMARY KEY (session_id, number),
+    CONSTRAINT live_question_session_id_fkey FOREIGN KEY (session_id) REFERENCES public.live_session(id) ON DELETE CASCADE
+);
+
+CREATE TABLE public.live_participant (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    session_id integer NOT NULL,
+    account_id integer,
+    display_name character varying(30) NOT NULL,
+    score integer NOT NULL,
+    CONSTRAINT live_participant_pkey PRIMARY KEY (id),
+    CONSTRAINT live_participant_session_id_fkey FOREIGN KEY (session_id) REFERENCES public.live_session(id) ON DELETE CASCADE,
+    CONSTRAINT live_participant_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX live_participant_session_id_idx ON public.live_participant USING btree (session_id);
+
+CREATE TABLE public.live_answer (
+    participant_id integer NOT NULL,
+    question_number smallint NOT NULL,
+    choice smallint,
+    correct boolean NOT NULL,
+    response_time integer,
+    CONSTRAINT live_answer_pkey PRIMARY KEY (participant_id, question_number),
+    CONSTRAINT live_answer_participant_id_fkey FOREIGN KEY (participant_id) REFERENCES public.live_participant(id) ON DELETE CASCADE
+);
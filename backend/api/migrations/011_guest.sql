@@ -0,0 +1,15 @@
+-- Гости: участники без аккаунта в одной живой сессии или одной публичной викторине.
+-- В списках участников id гостя отрицательный, поэтому с id аккаунтов не пересекается.
+
+CREATE TABLE public.guest (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    token_hash character(64) NOT NULL,
+    nickname character varying(30) NOT NULL,
+    scope character varying(50) NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    expire_time timestamp without time zone NOT NULL,
+    CONSTRAINT guest_pkey PRIMARY KEY (id),
+    CONSTRAINT guest_token_hash_key UNIQUE (token_hash)
+);
+
+CREATE INDEX guest_expire_time_idx ON public.guest USING btree (expire_time);
@@ -0,0 +1,36 @@
+-- Типы вопросов: single (как раньше), multiple, true_false, text и numeric.
+-- Верный ответ multiple - correct_answers, text - accepted_answers, numeric - numeric_answer с tolerance;
+-- у них correct не используется.
+
+CREATE TYPE public.question_type AS ENUM (
+    'single',
+    'multiple',
+    'true_false',
+    'text',
+    'numeric'
+);
+
+ALTER TYPE public.question_type OWNER TO qwiz;
+
+ALTER TABLE public.question ADD COLUMN question_type public.question_type DEFAULT 'single'::public.question_type NOT NULL;
+ALTER TABLE public.question ADD COLUMN correct_answers smallint[];
+ALTER TABLE public.question ADD COLUMN accepted_answers character varying(200)[];
+ALTER TABLE public.question ADD COLUMN numeric_answer double precision;
+ALTER TABLE public.question ADD COLUMN tolerance double precision DEFAULT 0 NOT NULL;
+
+ALTER TABLE public.question DROP CONSTRAINT correct_check;
+ALTER TABLE public.question ADD CONSTRAINT correct_check CHECK (((question_type <> ALL (ARRAY['single'::public.question_type, 'true_false'::public.question_type])) OR ((correct >= 1) AND (((correct <= 4) AND (answer4 IS NOT NULL)) OR ((correct <= 3) AND (answer3 IS NOT NULL)) OR (correct <= 2)))));
+ALTER TABLE public.question ADD CONSTRAINT question_type_check CHECK ((((question_type <> 'true_false'::public.question_type) OR ((correct <= 2) AND (answer3 IS NULL))) AND ((question_type <> 'multiple'::public.question_type) OR (COALESCE(cardinality(correct_answers), 0) > 0)) AND ((question_type <> 'text'::public.question_type) OR (COALESCE(cardinality(accepted_answers), 0) > 0)) AND ((question_type <> 'numeric'::public.question_type) OR (numeric_answer IS NOT NULL)) AND (tolerance >= (0)::double precision)));
+
+-- История живых сессий: вопрос хранит верный ответ своего типа, ответ участника - в поле своего типа
+
+ALTER TABLE public.live_question ADD COLUMN question_type public.question_type DEFAULT 'single'::public.question_type NOT NULL;
+ALTER TABLE public.live_question ALTER COLUMN correct DROP NOT NULL;
+ALTER TABLE public.live_question ADD COLUMN correct_answers smallint[];
+ALTER TABLE public.live_question ADD COLUMN accepted_answers character varying(200)[];
+ALTER TABLE public.live_question ADD COLUMN numeric_answer double precision;
+ALTER TABLE public.live_question ADD COLUMN tolerance double precision DEFAULT 0 NOT NULL;
+
+ALTER TABLE public.live_answer ADD COLUMN choices smallint[];
+ALTER TABLE public.live_answer ADD COLUMN text_answer character varying(200);
+ALTER TABLE public.live_answer ADD COLUMN numeric_answer double precision;
@@ -0,0 +1,44 @@
+-- Варианты ответа переезжают из answer1..answer4 в отдельную таблицу answer: их может быть больше четырёх,
+-- у каждого свой признак верности и своё вложение. correct и correct_answers заменяет answer.correct.
+
+CREATE TABLE public.answer (
+                               qwiz_id integer NOT NULL,
+                               question_index integer NOT NULL,
+                               "position" smallint NOT NULL,
+                               body character varying(200) NOT NULL,
+                               correct boolean DEFAULT false NOT NULL,
+                               embed_uuid uuid,
+                               CONSTRAINT position_check CHECK (("position" >= 0))
+);
+
+ALTER TABLE public.answer OWNER TO qwiz;
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_pkey PRIMARY KEY (qwiz_id, question_index, "position");
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_embed_uuid_fkey FOREIGN KEY (embed_uuid) REFERENCES public.media(uuid) ON DELETE SET NULL;
+
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_qwiz_id_question_index_fkey FOREIGN KEY (qwiz_id, question_index) REFERENCES public.question(qwiz_id, index) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TRIGGER delete_embed AFTER DELETE ON public.answer FOR EACH ROW EXECUTE FUNCTION public.delete_embed_func();
+
+INSERT INTO public.answer (qwiz_id, question_index, "position", body, correct)
+SELECT q.qwiz_id, q.index, a.n - 1, a.body,
+       CASE WHEN q.question_type = 'multiple'::public.question_type THEN a.n = ANY (q.correct_answers)
+            ELSE a.n = q.correct END
+FROM public.question q
+         CROSS JOIN LATERAL (VALUES (1, q.answer1), (2, q.answer2), (3, q.answer3), (4, q.answer4)) AS a(n, body)
+WHERE q.question_type IN ('single', 'multiple', 'true_false') AND a.body IS NOT NULL;
+
+ALTER TABLE public.question DROP CONSTRAINT correct_check;
+ALTER TABLE public.question DROP CONSTRAINT question4_check;
+ALTER TABLE public.question DROP CONSTRAINT question_type_check;
+ALTER TABLE public.question DROP COLUMN answer1;
+ALTER TABLE public.question DROP COLUMN answer2;
+ALTER TABLE public.question DROP COLUMN answer3;
+ALTER TABLE public.question DROP COLUMN answer4;
+ALTER TABLE public.question DROP COLUMN correct;
+ALTER TABLE public.question DROP COLUMN correct_answers;
+ALTER TABLE public.question ADD CONSTRAINT question_type_check CHECK ((((question_type <> 'text'::public.question_type) OR (COALESCE(cardinality(accepted_answers), 0) > 0)) AND ((question_type <> 'numeric'::public.question_type) OR (numeric_answer IS NOT NULL)) AND (tolerance >= (0)::double precision)));
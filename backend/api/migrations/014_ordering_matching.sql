@@ -0,0 +1,13 @@
+-- Вопросы ordering (расставить варианты по порядку) и matching (сопоставить вариантам пары).
+-- Верный порядок ordering - порядок вариантов, пара варианта matching - answer.match.
+
+ALTER TYPE public.question_type ADD VALUE 'ordering';
+ALTER TYPE public.question_type ADD VALUE 'matching';
+
+ALTER TABLE public.answer ADD COLUMN match character varying(200);
+
+-- История живых сессий: пары вопроса matching и ответы ordering и matching
+
+ALTER TABLE public.live_question ADD COLUMN matches character varying(200)[];
+
+ALTER TABLE public.live_answer ADD COLUMN sequence smallint[];
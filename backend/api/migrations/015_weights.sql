@@ -0,0 +1,8 @@
+-- Вес вопроса в баллах и порог сдачи задания: доля от максимума баллов, с которой задание выполнено.
+-- По умолчанию порог 1, как раньше, когда задание засчитывалось только за все верные ответы.
+
+ALTER TABLE public.question ADD COLUMN weight double precision DEFAULT 1 NOT NULL;
+ALTER TABLE public.question ADD CONSTRAINT weight_check CHECK ((weight > (0)::double precision));
+
+ALTER TABLE public.assignment ADD COLUMN pass_threshold double precision DEFAULT 1 NOT NULL;
+ALTER TABLE public.assignment ADD CONSTRAINT pass_threshold_check CHECK (((pass_threshold >= (0)::double precision) AND (pass_threshold <= (1)::double precision)));
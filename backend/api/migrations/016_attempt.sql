@@ -0,0 +1,16 @@
+-- Перемешивание вопросов и вариантов при решении викторины. Каждая попытка хранит seed,
+-- по которому восстанавливается порядок, показанный участнику, и настройки на момент начала.
+
+ALTER TABLE public.qwiz ADD COLUMN shuffle_questions boolean DEFAULT false NOT NULL;
+ALTER TABLE public.qwiz ADD COLUMN shuffle_answers boolean DEFAULT false NOT NULL;
+
+CREATE TABLE public.attempt (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    qwiz_id integer NOT NULL,
+    seed bigint NOT NULL,
+    shuffle_questions boolean NOT NULL,
+    shuffle_answers boolean NOT NULL,
+    start_time timestamp without time zone NOT NULL,
+    CONSTRAINT attempt_pkey PRIMARY KEY (id),
+    CONSTRAINT attempt_qwiz_id_fkey FOREIGN KEY (qwiz_id) REFERENCES public.qwiz(id) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+-- Когда после решения викторины показываются верные ответы: никогда, сразу или после срока задания.
+
+CREATE TYPE public.reveal_policy AS ENUM (
+    'never',
+    'after_submit',
+    'after_assignment_close'
+);
+
+ALTER TABLE public.qwiz ADD COLUMN reveal public.reveal_policy DEFAULT 'never'::public.reveal_policy NOT NULL;
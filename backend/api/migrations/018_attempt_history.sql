@@ -0,0 +1,23 @@
+-- История решений: кто решал попытку, в рамках какого задания, когда отправил, ответы и баллы.
+-- display_name запоминается при отправке, как в live_participant: гости удаляются по истечении.
+
+ALTER TABLE public.attempt ADD COLUMN account_id integer;
+ALTER TABLE public.attempt ADD COLUMN guest_id integer;
+ALTER TABLE public.attempt ADD COLUMN display_name character varying(30);
+ALTER TABLE public.attempt ADD COLUMN assignment_id integer;
+ALTER TABLE public.attempt ADD COLUMN submit_time timestamp without time zone;
+ALTER TABLE public.attempt ADD COLUMN question_indexes integer[];
+ALTER TABLE public.attempt ADD COLUMN answers jsonb;
+ALTER TABLE public.attempt ADD COLUMN scores double precision[];
+ALTER TABLE public.attempt ADD COLUMN score double precision;
+ALTER TABLE public.attempt ADD COLUMN max_score double precision;
+
+ALTER TABLE public.attempt
+    ADD CONSTRAINT attempt_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id) ON DELETE SET NULL;
+ALTER TABLE public.attempt
+    ADD CONSTRAINT attempt_guest_id_fkey FOREIGN KEY (guest_id) REFERENCES public.guest(id) ON DELETE SET NULL;
+ALTER TABLE public.attempt
+    ADD CONSTRAINT attempt_assignment_id_fkey FOREIGN KEY (assignment_id) REFERENCES public.assignment(id) ON DELETE SET NULL;
+
+CREATE INDEX attempt_account_id_idx ON public.attempt USING btree (account_id);
+CREATE INDEX attempt_qwiz_id_idx ON public.attempt USING btree (qwiz_id);
@@ -0,0 +1,20 @@
+-- Настройки задания: число попыток, какой результат засчитывается, время на попытку (в секундах
+-- от начала) и штраф за отправку после срока (NULL - после срока не принимается).
+
+CREATE TYPE public.score_policy AS ENUM (
+    'best',
+    'last',
+    'average'
+);
+
+ALTER TABLE public.assignment ADD COLUMN max_attempts integer;
+ALTER TABLE public.assignment ADD COLUMN score_policy public.score_policy DEFAULT 'best'::public.score_policy NOT NULL;
+ALTER TABLE public.assignment ADD COLUMN duration integer;
+ALTER TABLE public.assignment ADD COLUMN late_penalty double precision;
+ALTER TABLE public.assignment ADD CONSTRAINT max_attempts_check CHECK ((max_attempts > 0));
+ALTER TABLE public.assignment ADD CONSTRAINT duration_check CHECK ((duration > 0));
+ALTER TABLE public.assignment ADD CONSTRAINT late_penalty_check CHECK (((late_penalty >= (0)::double precision) AND (late_penalty <= (1)::double precision)));
+
+-- Доля баллов, снятая с попытки за опоздание; score попытки хранится уже с её учётом
+
+ALTER TABLE public.attempt ADD COLUMN penalty double precision DEFAULT 0 NOT NULL;
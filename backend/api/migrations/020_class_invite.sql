@@ -0,0 +1,25 @@
+-- Приглашения в класс: у класса один действующий код, новый заменяет старый. Срок и число
+-- вступлений необязательны. Если нужно одобрение учителя, вступивший попадает в class_join_request.
+
+CREATE TABLE public.class_invite (
+    class_id integer NOT NULL,
+    code character varying(16) NOT NULL,
+    require_approval boolean DEFAULT false NOT NULL,
+    expire_time timestamp without time zone,
+    max_uses integer,
+    uses integer DEFAULT 0 NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    CONSTRAINT max_uses_check CHECK ((max_uses > 0)),
+    CONSTRAINT class_invite_pkey PRIMARY KEY (class_id),
+    CONSTRAINT class_invite_code_key UNIQUE (code),
+    CONSTRAINT class_invite_class_id_fkey FOREIGN KEY (class_id) REFERENCES public.class(id) ON DELETE CASCADE
+);
+
+CREATE TABLE public.class_join_request (
+    class_id integer NOT NULL,
+    student_id integer NOT NULL,
+    create_time timestamp without time zone NOT NULL,
+    CONSTRAINT class_join_request_pkey PRIMARY KEY (class_id, student_id),
+    CONSTRAINT class_join_request_class_id_fkey FOREIGN KEY (class_id) REFERENCES public.class(id) ON DELETE CASCADE,
+    CONSTRAINT class_join_request_student_id_fkey FOREIGN KEY (student_id) REFERENCES public.account(id) ON DELETE CASCADE
+);
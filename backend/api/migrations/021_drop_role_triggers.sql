@@ -0,0 +1,10 @@
+-- Роли учителя и ученика проверяются в обработчиках (см. пакет class и authz): триггеры
+-- отвечали исключением, которое доходило до клиента как 500 вместо 400/403.
+
+DROP TRIGGER check_student ON public.completed_assignment;
+DROP TRIGGER check_student ON public.student;
+DROP TRIGGER check_teacher ON public.class;
+
+DROP FUNCTION public.check_student_class_func();
+DROP FUNCTION public.check_student_func();
+DROP FUNCTION public.check_teacher_func();
@@ -0,0 +1,5 @@
+-- Неподтверждённый адрес больше не пишется в account.email (он уникален, и чужой адрес можно было
+-- занять, не владея им): до подтверждения он хранится только в mail_token. Уже записанные
+-- неподтверждённые адреса убираются, ссылки из отправленных писем продолжают работать.
+
+UPDATE public.account SET email=NULL WHERE NOT email_verified;
@@ -0,0 +1,6 @@
+-- Сдвиг вариантов после удаления (position=position-1) проверялся на уникальность построчно
+-- и в зависимости от порядка строк падал с 23505. Отложенный ключ проверяется в конце оператора.
+
+ALTER TABLE ONLY public.answer DROP CONSTRAINT answer_pkey;
+ALTER TABLE ONLY public.answer
+    ADD CONSTRAINT answer_pkey PRIMARY KEY (qwiz_id, question_index, "position") DEFERRABLE;
@@ -0,0 +1,5 @@
+-- Раскладка попытки (порядок вопросов и вариантов) запоминается при начале, а не восстанавливается
+-- при отправке по текущим вопросам: иначе правка викторины во время попытки сдвигала номера ответов.
+-- У начатых раньше попыток layouts пуст, они по-прежнему раскладываются по seed.
+
+ALTER TABLE public.attempt ADD COLUMN layouts jsonb;
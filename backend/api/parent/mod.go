@@ -0,0 +1,114 @@
+package parent
+
+import (
+	"api/account"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+var DB *sqlx.DB
+
+type LinkStatus string
+
+const (
+	Pending  LinkStatus = "pending"
+	Accepted LinkStatus = "accepted"
+)
+
+var (
+	ErrNotAParent    = errors.New("account is not a parent")
+	ErrNotAStudent   = errors.New("account is not a student")
+	ErrAlreadyLinked = errors.New("link already exists")
+)
+
+// Link - связь родителя с учеником. Родитель отправляет приглашение, принимает его ученик
+// или учитель ученика. Только принятая связь даёт родителю доступ на чтение.
+type Link struct {
+	ID         int32      `db:"id"`
+	ParentID   int32      `db:"parent_id"`
+	StudentID  int32      `db:"student_id"`
+	Status     LinkStatus `db:"status"`
+	CreateTime time.Time  `db:"create_time"`
+	AcceptTime *time.Time `db:"accept_time"`
+	AcceptedBy *int32     `db:"accepted_by"`
+}
+
+// Invite создаёт неподтверждённую связь родителя с учеником.
+func Invite(parentID, studentID int32) (*Link, error) {
+	parentAcct, err := account.GetByID(parentID)
+	if err != nil {
+		return nil, err
+	}
+	if parentAcct.AccountType != account.Parent.String() {
+		return nil, ErrNotAParent
+	}
+	studentAcct, err := account.GetByID(studentID)
+	if err != nil {
+		return nil, err
+	}
+	if studentAcct.AccountType != account.Student.String() {
+		return nil, ErrNotAStudent
+	}
+
+	link := &Link{}
+	err = DB.Get(link, `
+		INSERT INTO parent_link (parent_id, student_id, status, create_time) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (parent_id, student_id) DO NOTHING
+		RETURNING *
+	`, parentID, studentID, Pending, time.Now().UTC())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAlreadyLinked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func GetByID(id int32) (*Link, error) {
+	link := &Link{}
+	err := DB.Get(link, "SELECT * FROM parent_link WHERE id=$1", id)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// GetAllByAccountID возвращает связи, в которых аккаунт - родитель, ученик
+// или учитель ученика (такие связи он может подтвердить).
+func GetAllByAccountID(accountID int32) ([]Link, error) {
+	const query = `
+		SELECT * FROM parent_link
+		WHERE parent_id=$1 OR student_id=$1 OR student_id IN (
+			SELECT s.student_id FROM student s JOIN class c ON c.id=s.class_id WHERE c.teacher_id=$1
+		)
+		ORDER BY id
+	`
+	var links []Link
+	err := DB.Select(&links, query, accountID)
+	return links, err
+}
+
+// Accept подтверждает связь. Повторное подтверждение ничего не меняет.
+func (l *Link) Accept(approverID int32) error {
+	if l.Status == Accepted {
+		return nil
+	}
+	now := time.Now().UTC()
+	_, err := DB.Exec("UPDATE parent_link SET status=$1, accept_time=$2, accepted_by=$3 WHERE id=$4", Accepted, now, approverID, l.ID)
+	if err != nil {
+		return err
+	}
+	l.Status = Accepted
+	l.AcceptTime = &now
+	l.AcceptedBy = &approverID
+	return nil
+}
+
+// Delete отклоняет приглашение или разрывает подтверждённую связь.
+func (l *Link) Delete() error {
+	_, err := DB.Exec("DELETE FROM parent_link WHERE id=$1", l.ID)
+	return err
+}
@@ -0,0 +1,170 @@
+package parent
+
+import (
+	"api/account"
+	"api/authz"
+	"api/config"
+	"api/utils"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func parentInfo(c *gin.Context) {
+	c.String(http.StatusOK, `
+enum LinkStatus ( "pending", "accepted" )
+
+Родитель с подтверждённой связью может читать классы, задания и результаты ученика:
+GET /account/<student_id>/classes, GET /account/<student_id>/assignments, GET /account/<student_id>/attempts.
+Изменять их родитель не может.
+
+POST /parent/link - invite a student (parent accounts only)
+Authorization: Bearer <access_token> - required
+student_id: Integer - required
+
+GET /parent/link - get links of the caller as a parent, a student or a student's teacher
+Authorization: Bearer <access_token> - required
+
+GET /parent/link/<id> - get link
+Authorization: Bearer <access_token> - required
+
+POST /parent/link/<id>/accept - accept an invite (the student or the student's teacher)
+Authorization: Bearer <access_token> - required
+
+DELETE /parent/link/<id> - decline an invite or remove a link
+Authorization: Bearer <access_token> - required
+`)
+}
+
+type PostLinkData struct {
+	StudentID int32 `json:"student_id" binding:"required"`
+}
+
+type GetLinkData struct {
+	ID         int32      `json:"id"`
+	ParentID   int32      `json:"parent_id"`
+	StudentID  int32      `json:"student_id"`
+	Status     LinkStatus `json:"status"`
+	CreateTime int64      `json:"create_time"`
+	AcceptTime *int64     `json:"accept_time"`
+}
+
+func fromLink(link *Link) GetLinkData {
+	var acceptTime *int64
+	if link.AcceptTime != nil {
+		t := link.AcceptTime.UnixMilli()
+		acceptTime = &t
+	}
+	return GetLinkData{
+		ID:         link.ID,
+		ParentID:   link.ParentID,
+		StudentID:  link.StudentID,
+		Status:     link.Status,
+		CreateTime: link.CreateTime.UnixMilli(),
+		AcceptTime: acceptTime,
+	}
+}
+
+func linkFromParam(c *gin.Context) (*Link, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return nil, false
+	}
+	link, err := GetByID(int32(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Link not found"})
+		return nil, false
+	}
+	return link, true
+}
+
+func inviteStudent(c *gin.Context) {
+	var data PostLinkData
+	if err := c.ShouldBindJSON(&data); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	link, err := Invite(account.Current(c).ID, data.StudentID)
+	switch {
+	case errors.Is(err, ErrNotAParent), errors.Is(err, ErrNotAStudent):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, ErrAlreadyLinked):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Account not found"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"location": fmt.Sprintf("%s/parent/link/%d", config.BaseURL, link.ID)})
+}
+
+func getLinks(c *gin.Context) {
+	links, err := GetAllByAccountID(account.Current(c).ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+
+	result := make([]GetLinkData, 0, len(links))
+	for i := range links {
+		result = append(result, fromLink(&links[i]))
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func getLink(c *gin.Context) {
+	link, ok := linkFromParam(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, fromLink(link))
+}
+
+func acceptLink(c *gin.Context) {
+	link, ok := linkFromParam(c)
+	if !ok {
+		return
+	}
+	if err := link.Accept(account.Current(c).ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	c.JSON(http.StatusOK, fromLink(link))
+}
+
+func deleteLink(c *gin.Context) {
+	link, ok := linkFromParam(c)
+	if !ok {
+		return
+	}
+	if err := link.Delete(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Success"})
+}
+
+// RegisterRoutes добавляет маршруты модуля parent к роутеру Gin.
+func RegisterRoutes(r *gin.Engine) {
+	parentGroup := r.Group(config.BaseURL + "/parent")
+	{
+		parentGroup.GET("", parentInfo)
+
+		linkID := authz.Param("id")
+		member := authz.Require(authz.Any(authz.LinkMember(linkID), authz.LinkApprover(linkID)))
+		parentGroup.POST("/link", account.RequireAuth(), authz.Require(authz.Role(account.Parent.String())), inviteStudent)
+		parentGroup.GET("/link", account.RequireAuth(), getLinks)
+		parentGroup.GET("/link/:id", account.RequireAuth(), member, getLink)
+		parentGroup.POST("/link/:id/accept", account.RequireAuth(), authz.Require(authz.LinkApprover(linkID)), acceptLink)
+		parentGroup.DELETE("/link/:id", account.RequireAuth(), member, deleteLink)
+	}
+}
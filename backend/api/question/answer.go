@@ -0,0 +1,279 @@
+package question
+
+import (
+	"api/media"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const maxAnswerLength = 200
+
+// Answer - вариант ответа вопроса. Position - порядковый номер с 0, в API варианты нумеруются с 1.
+type Answer struct {
+	QwizID        int32      `db:"qwiz_id"`
+	QuestionIndex int32      `db:"question_index"`
+	Position      int16      `db:"position"`
+	Body          string     `db:"body"`
+	Correct       bool       `db:"correct"`
+	EmbedUUID     *uuid.UUID `db:"embed_uuid"`
+	// Match - пара варианта в вопросе matching
+	Match *string `db:"match"`
+}
+
+type NewAnswerData struct {
+	Body      string              `json:"body"`
+	Correct   bool                `json:"correct"`
+	EmbedData *media.NewMediaData `json:"embed"`
+	Match     *string             `json:"match"`
+}
+
+// legacyAnswers собирает варианты из устаревших полей answer1..answer4 и correct/correct_answers.
+func (d *NewQuestionData) legacyAnswers() []NewAnswerData {
+	bodies := []string{d.Answer1, d.Answer2}
+	if d.Answer3 != nil {
+		bodies = append(bodies, *d.Answer3)
+	}
+	if d.Answer4 != nil {
+		bodies = append(bodies, *d.Answer4)
+	}
+
+	answers := make([]NewAnswerData, len(bodies))
+	for i, body := range bodies {
+		answers[i].Body = body
+		if d.Type == Multiple {
+			for _, c := range d.CorrectAnswers {
+				answers[i].Correct = answers[i].Correct || int(c) == i+1
+			}
+		} else {
+			answers[i].Correct = int(d.Correct) == i+1
+		}
+	}
+	return answers
+}
+
+// checkAnswers проверяет набор вариантов вопроса типа t: не меньше двух, у true_false ровно два,
+// у single и true_false один верный, у multiple хотя бы один. У ordering и matching верных
+// вариантов не бывает, зато варианты (и пары у matching) не должны повторяться, иначе их не различить.
+func checkAnswers(t Type, answers []NewAnswerData) error {
+	if !t.HasAnswers() {
+		if len(answers) > 0 {
+			return ErrInvalidQuestion
+		}
+		return nil
+	}
+	if len(answers) < 2 || (t == TrueFalse && len(answers) != 2) {
+		return ErrInvalidQuestion
+	}
+	correct := 0
+	bodies := make(map[string]bool)
+	matches := make(map[string]bool)
+	for _, a := range answers {
+		if a.Body == "" || len([]rune(a.Body)) > maxAnswerLength {
+			return ErrInvalidQuestion
+		}
+		if (a.Match != nil) != (t == Matching) {
+			return ErrInvalidQuestion
+		}
+		if a.Match != nil {
+			if *a.Match == "" || len([]rune(*a.Match)) > maxAnswerLength || matches[*a.Match] {
+				return ErrInvalidQuestion
+			}
+			matches[*a.Match] = true
+		}
+		if t == Ordering && bodies[a.Body] {
+			return ErrInvalidQuestion
+		}
+		bodies[a.Body] = true
+		if a.Correct {
+			correct++
+		}
+	}
+	switch t {
+	case Ordering, Matching:
+		if correct != 0 {
+			return ErrInvalidQuestion
+		}
+	case Multiple:
+		if correct == 0 {
+			return ErrInvalidQuestion
+		}
+	default:
+		if correct != 1 {
+			return ErrInvalidQuestion
+		}
+	}
+	return nil
+}
+
+// insertAnswers записывает варианты вопроса qwizID/index начиная с позиции 0.
+func insertAnswers(db sqlx.Queryer, qwizID, index int32, answers []NewAnswerData) ([]Answer, error) {
+	result := make([]Answer, 0, len(answers))
+	if len(answers) == 0 {
+		return result, nil
+	}
+
+	var positions []int16
+	var bodies []string
+	var corrects []bool
+	var embedUUIDs []*uuid.UUID
+	var matches []*string
+	for i, a := range answers {
+		positions = append(positions, int16(i))
+		matches = append(matches, a.Match)
+		bodies = append(bodies, a.Body)
+		corrects = append(corrects, a.Correct)
+		var embedUUID *uuid.UUID
+		if a.EmbedData != nil {
+			med, err := media.FromMediaData(a.EmbedData)
+			if err != nil {
+				return nil, err
+			}
+			embedUUID = &med.UUID
+		}
+		embedUUIDs = append(embedUUIDs, embedUUID)
+	}
+
+	err := sqlx.Select(db, &result, `INSERT INTO answer (qwiz_id, question_index, position, body, correct, embed_uuid, match)
+	SELECT $1, $2, * FROM UNNEST($3::INT2[], $4::TEXT[], $5::BOOL[], $6::UUID[], $7::TEXT[])
+	RETURNING *`, qwizID, index, pq.Array(positions), pq.StringArray(bodies), pq.BoolArray(corrects), pq.Array(embedUUIDs),
+		pq.Array(matches))
+	return result, err
+}
+
+// loadAnswers подставляет варианты в вопросы одной викторины.
+func loadAnswers(qwizID int32, questions []Question) error {
+	var answers []Answer
+	if err := DB.Select(&answers, "SELECT * FROM answer WHERE qwiz_id=$1 ORDER BY question_index, position", qwizID); err != nil {
+		return err
+	}
+	byIndex := make(map[int32][]Answer)
+	for _, a := range answers {
+		byIndex[a.QuestionIndex] = append(byIndex[a.QuestionIndex], a)
+	}
+	for i := range questions {
+		questions[i].Answers = byIndex[questions[i].Index]
+		if questions[i].Answers == nil {
+			questions[i].Answers = []Answer{}
+		}
+	}
+	return nil
+}
+
+// newAnswerDatas - текущие варианты вопроса в виде для проверки checkAnswers.
+func (q *Question) newAnswerDatas() []NewAnswerData {
+	answers := make([]NewAnswerData, len(q.Answers))
+	for i, a := range q.Answers {
+		answers[i] = NewAnswerData{Body: a.Body, Correct: a.Correct, Match: a.Match}
+	}
+	return answers
+}
+
+// ReplaceAnswers заменяет все варианты вопроса.
+func (q *Question) ReplaceAnswers(answers []NewAnswerData) error {
+	if err := checkAnswers(q.Kind(), answers); err != nil {
+		return err
+	}
+	tx, err := DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM answer WHERE qwiz_id=$1 AND question_index=$2", q.QwizID, q.Index); err != nil {
+		return err
+	}
+	inserted, err := insertAnswers(tx, q.QwizID, q.Index, answers)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	q.Answers = inserted
+	return nil
+}
+
+// UpdateAnswer меняет текст варианта number (с 1). number на единицу больше числа вариантов добавляет
+// новый неверный вариант, nil удаляет вариант, следующие сдвигаются.
+//
+// Deprecated: оставлен для new_answers в PATCH, используйте ReplaceAnswers.
+func (q *Question) UpdateAnswer(number uint8, newAnswer *string) (bool, error) {
+	position := int(number) - 1
+	answers := q.newAnswerDatas()
+	switch {
+	case position < 0 || position > len(answers):
+		return false, ErrInvalidQuestion
+	case newAnswer == nil && position == len(answers):
+		return false, nil
+	case newAnswer == nil:
+		answers = append(answers[:position], answers[position+1:]...)
+	case position == len(answers):
+		answers = append(answers, NewAnswerData{Body: *newAnswer})
+	default:
+		answers[position].Body = *newAnswer
+	}
+	if err := checkAnswers(q.Kind(), answers); err != nil {
+		return false, err
+	}
+
+	tx, err := DB.Beginx()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	switch {
+	case newAnswer == nil:
+		_, err = tx.Exec("DELETE FROM answer WHERE qwiz_id=$1 AND question_index=$2 AND position=$3", q.QwizID, q.Index, position)
+		if err == nil {
+			// answer_pkey отложенный (DEFERRABLE): уникальность проверяется после всего сдвига, а не по строкам
+			_, err = tx.Exec("UPDATE answer SET position=position-1 WHERE qwiz_id=$1 AND question_index=$2 AND position>$3",
+				q.QwizID, q.Index, position)
+		}
+	case position == len(q.Answers):
+		_, err = tx.Exec("INSERT INTO answer (qwiz_id, question_index, position, body, correct) VALUES ($1, $2, $3, $4, false)",
+			q.QwizID, q.Index, position, *newAnswer)
+	default:
+		_, err = tx.Exec("UPDATE answer SET body=$1 WHERE qwiz_id=$2 AND question_index=$3 AND position=$4",
+			*newAnswer, q.QwizID, q.Index, position)
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, q.reloadAnswers()
+}
+
+// SetCorrect отмечает верными варианты с номерами correct (с 1), остальные - неверными.
+func (q *Question) SetCorrect(correct []int32) error {
+	answers := q.newAnswerDatas()
+	for i := range answers {
+		answers[i].Correct = false
+		for _, c := range correct {
+			answers[i].Correct = answers[i].Correct || int(c) == i+1
+		}
+	}
+	for _, c := range correct {
+		if c < 1 || int(c) > len(answers) {
+			return ErrInvalidQuestion
+		}
+	}
+	if err := checkAnswers(q.Kind(), answers); err != nil {
+		return err
+	}
+
+	_, err := DB.Exec("UPDATE answer SET correct=(position+1 = ANY($1::INT[])) WHERE qwiz_id=$2 AND question_index=$3",
+		pq.Int32Array(correct), q.QwizID, q.Index)
+	if err != nil {
+		return err
+	}
+	return q.reloadAnswers()
+}
+
+func (q *Question) reloadAnswers() error {
+	q.Answers = []Answer{}
+	return DB.Select(&q.Answers, "SELECT * FROM answer WHERE qwiz_id=$1 AND question_index=$2 ORDER BY position", q.QwizID, q.Index)
+}
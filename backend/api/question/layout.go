@@ -0,0 +1,57 @@
+package question
+
+import "math/rand"
+
+// Layout - порядок, в котором участнику показаны варианты вопроса. Answers[i] - индекс варианта
+// (с 0), показанного i-м, Matches[i] - индекс варианта, чья пара показана i-й (только у matching).
+type Layout struct {
+	Answers []uint8
+	Matches []uint8
+}
+
+// DefaultLayout - порядок без перемешивания: ListOrder и MatchOrder.
+func (q *Question) DefaultLayout() Layout {
+	layout := Layout{Answers: q.ListOrder()}
+	if q.Kind() == Matching {
+		layout.Matches = q.MatchOrder()
+	}
+	return layout
+}
+
+// ShuffledLayout перемешивает варианты генератором rng. У true_false порядок "верно/неверно"
+// не меняется, пары matching перемешиваются отдельно от вариантов.
+func (q *Question) ShuffledLayout(rng *rand.Rand) Layout {
+	layout := Layout{Answers: identityOrder(len(q.Answers))}
+	if q.Kind() != TrueFalse {
+		shuffleOrder(rng, layout.Answers)
+	}
+	if q.Kind() == Matching {
+		layout.Matches = identityOrder(len(q.Answers))
+		shuffleOrder(rng, layout.Matches)
+	}
+	return layout
+}
+
+func shuffleOrder(rng *rand.Rand, order []uint8) {
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+}
+
+// originalNumber переводит номер n (с 1) в порядке order в исходный номер варианта (с 1).
+func originalNumber(order []uint8, n uint8) (uint8, bool) {
+	if n < 1 || int(n) > len(order) {
+		return 0, false
+	}
+	return order[n-1] + 1, true
+}
+
+// displayNumber - под каким номером (с 1) в порядке order показан вариант с исходным индексом idx.
+func displayNumber(order []uint8, idx uint8) uint8 {
+	for i, o := range order {
+		if o == idx {
+			return uint8(i + 1)
+		}
+	}
+	return 0
+}
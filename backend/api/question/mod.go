@@ -2,8 +2,9 @@ package question
 
 import (
 	"api/media"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -13,13 +14,24 @@ import (
 
 type NewQuestionData struct {
 	Index     *int32
+	Type      Type `json:"type"`
 	Body      string
-	Answer1   string
-	Answer2   string
-	Answer3   *string
-	Answer4   *string
-	Correct   int16
+	Answers   []NewAnswerData `json:"answers"`
 	EmbedData *media.NewMediaData
+	TimeLimit *int16 `json:"time_limit"`
+	// Weight - сколько баллов стоит вопрос, 0 - по умолчанию, DefaultWeight
+	Weight float64 `json:"weight"`
+	// Устаревший вид вариантов: используется, если Answers не заданы
+	Answer1        string
+	Answer2        string
+	Answer3        *string
+	Answer4        *string
+	Correct        int16
+	CorrectAnswers []int32 `json:"correct_answers"`
+	// Поля других типов вопросов, см. Type
+	AcceptedAnswers []string `json:"accepted_answers"`
+	NumericAnswer   *float64 `json:"numeric_answer"`
+	Tolerance       float64  `json:"tolerance"`
 }
 
 type Error struct {
@@ -34,13 +46,19 @@ var DB *sqlx.DB
 func GetQuestionByQwizIDIndex(qwizID int32, index int32) (*Question, error) {
 	var q Question
 	err := DB.Get(&q, "SELECT * FROM question WHERE qwiz_id=$1 AND index=$2", qwizID, index)
-	return &q, err
+	if err != nil {
+		return &q, err
+	}
+	return &q, q.reloadAnswers()
 }
 
 func GetAllQuestionsByQwizID(qwizID int32) ([]Question, error) {
 	var questions []Question
 	err := DB.Select(&questions, "SELECT * FROM question WHERE qwiz_id=$1 ORDER BY index", qwizID)
-	return questions, err
+	if err != nil {
+		return questions, err
+	}
+	return questions, loadAnswers(qwizID, questions)
 }
 
 type errorType int
@@ -68,15 +86,44 @@ type Question struct {
 	QwizID    int32      `db:"qwiz_id"`
 	Index     int32      `db:"index"`
 	Body      string     `db:"body"`
-	Answer1   string     `db:"answer1"`
-	Answer2   string     `db:"answer2"`
-	Answer3   *string    `db:"answer3"`
-	Answer4   *string    `db:"answer4"`
-	Correct   int16      `db:"correct"`
 	EmbedUUID *uuid.UUID `db:"embed_uuid"`
+	// TimeLimit - ограничение по времени в секундах, nil - без ограничения
+	TimeLimit *int16 `db:"time_limit"`
+	Type      Type   `db:"question_type"`
+	// AcceptedAnswers - ответы Text, NumericAnswer и Tolerance - Numeric.
+	AcceptedAnswers pq.StringArray `db:"accepted_answers"`
+	NumericAnswer   *float64       `db:"numeric_answer"`
+	Tolerance       float64        `db:"tolerance"`
+	// Weight - сколько баллов стоит полностью верный ответ
+	Weight float64 `db:"weight"`
+	// Answers - варианты вопросов с выбором, хранятся в таблице answer
+	Answers []Answer `db:"-"`
+}
+
+// insert записывает вопрос без вариантов.
+func (q *Question) insert(db sqlx.Ext) error {
+	rows, err := sqlx.NamedQuery(db, `INSERT INTO question (qwiz_id, index, body, embed_uuid,
+		time_limit, question_type, accepted_answers, numeric_answer, tolerance, weight)
+		VALUES (:qwiz_id, :index, :body, :embed_uuid,
+		:time_limit, :question_type, :accepted_answers, :numeric_answer, :tolerance, :weight) RETURNING *`, q)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return rows.StructScan(q)
 }
 
 func FromQuestionData(qwizID int32, data *NewQuestionData) (*Question, error) {
+	if err := data.normalize(); err != nil {
+		return nil, err
+	}
+
 	var embedUUID *uuid.UUID
 	if data.EmbedData != nil {
 		med, err := media.FromMediaData(data.EmbedData)
@@ -87,56 +134,88 @@ func FromQuestionData(qwizID int32, data *NewQuestionData) (*Question, error) {
 		}
 	}
 
+	// Сдвиг вопросов, сам вопрос и его варианты записываются вместе: без вариантов вопрос не остаётся
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var realIndex int32
 	if data.Index != nil {
 		// shift all existing questions after current index by 1
-		row := DB.QueryRow(`UPDATE question SET index=index+1 WHERE index>= $1 AND qwiz_id=$2 RETURNING index`, data.Index, qwizID)
+		row := tx.QueryRow(`UPDATE question SET index=index+1 WHERE index>= $1 AND qwiz_id=$2 RETURNING index`, data.Index, qwizID)
 		if err := row.Scan(&realIndex); err != nil {
 			return nil, err
 		}
 	} else {
-		row := DB.QueryRow(`SELECT COALESCE(MAX(index) + 1, 0) FROM question WHERE qwiz_id=$1`, qwizID)
+		row := tx.QueryRow(`SELECT COALESCE(MAX(index) + 1, 0) FROM question WHERE qwiz_id=$1`, qwizID)
 		if err := row.Scan(&realIndex); err != nil {
 			return nil, err
 		}
 	}
 
-	q := &Question{}
-	err := DB.QueryRow(`INSERT INTO question (qwiz_id, index, body, answer1, answer2, answer3, answer4, correct, embed_uuid) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`,
-		qwizID, realIndex, data.Body, data.Answer1, data.Answer2, data.Answer3, data.Answer4, data.Correct, embedUUID).Scan(
-		&q.QwizID, &q.Index, &q.Body, &q.Answer1, &q.Answer2, &q.Answer3, &q.Answer4, &q.Correct, &q.EmbedUUID)
+	q := &Question{
+		QwizID:          qwizID,
+		Index:           realIndex,
+		Body:            data.Body,
+		EmbedUUID:       embedUUID,
+		TimeLimit:       data.TimeLimit,
+		Type:            data.Type,
+		AcceptedAnswers: data.AcceptedAnswers,
+		NumericAnswer:   data.NumericAnswer,
+		Tolerance:       data.Tolerance,
+		Weight:          data.Weight,
+	}
+	if err := q.insert(tx); err != nil {
+		return nil, err
+	}
+	answers, err := insertAnswers(tx, qwizID, q.Index, data.Answers)
 	if err != nil {
 		return nil, err
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	q.Answers = answers
 
 	return q, nil
 }
 
 func FromQuestionDatas(qwizID int32, datas []NewQuestionData) ([]Question, error) {
 	var indexes []int32
-	var bodies, answers1, answers2, answers3, answers4 []string
-	var corrects []int16
+	var bodies []string
+	var timeLimits []sql.NullInt32
 	var medias []*media.NewMediaData
-
-	for _, d := range datas {
-		indexes = append(indexes, int32(len(indexes)))
-		bodies = append(bodies, d.Body)
-		answers1 = append(answers1, d.Answer1)
-		answers2 = append(answers2, d.Answer2)
-
-		if d.Answer3 != nil {
-			answers3 = append(answers3, *d.Answer3)
+	// Массивы ответов передаются литералами text[]: UNNEST двумерного массива разворачивает его целиком
+	var types []string
+	var acceptedAnswers []sql.NullString
+	var numericAnswers []sql.NullFloat64
+	var tolerances []float64
+	var weights []float64
+
+	for i := range datas {
+		d := &datas[i]
+		if err := d.normalize(); err != nil {
+			return nil, err
+		}
+		types = append(types, string(d.Type))
+		acceptedAnswers = append(acceptedAnswers, arrayLiteral(pq.StringArray(d.AcceptedAnswers)))
+		if d.NumericAnswer != nil {
+			numericAnswers = append(numericAnswers, sql.NullFloat64{Float64: *d.NumericAnswer, Valid: true})
 		} else {
-			answers3 = append(answers3, "")
+			numericAnswers = append(numericAnswers, sql.NullFloat64{})
 		}
+		tolerances = append(tolerances, d.Tolerance)
+		weights = append(weights, d.Weight)
 
-		if d.Answer4 != nil {
-			answers4 = append(answers4, *d.Answer4)
+		indexes = append(indexes, int32(len(indexes)))
+		bodies = append(bodies, d.Body)
+		if d.TimeLimit != nil {
+			timeLimits = append(timeLimits, sql.NullInt32{Int32: int32(*d.TimeLimit), Valid: true})
 		} else {
-			answers4 = append(answers4, "")
+			timeLimits = append(timeLimits, sql.NullInt32{})
 		}
-
-		corrects = append(corrects, d.Correct)
 		if d.EmbedData != nil {
 			medias = append(medias, d.EmbedData)
 		} else {
@@ -154,35 +233,51 @@ func FromQuestionDatas(qwizID int32, datas []NewQuestionData) ([]Question, error
 
 	log.Printf("Executing query with qwizID: %d and data: %v", qwizID, indexes)
 
-	rows, err := DB.Query(`INSERT INTO question (qwiz_id, index, body, answer1, answer2, answer3, answer4, correct, embed_uuid)
-	SELECT $1, * FROM UNNEST($2::INT[], $3::TEXT[], $4::TEXT[], $5::TEXT[], $6::TEXT[], $7::TEXT[], $8::INT2[], $9::UUID[])
-	AS t(index, body, answer1, answer2, answer3, answer4, correct, embed_uuid)
-	RETURNING *`, qwizID, pq.Array(indexes), pq.StringArray(bodies), pq.StringArray(answers1), pq.StringArray(answers2), pq.StringArray(answers3), pq.StringArray(answers4), pq.Array(corrects), pq.Array(embedUUIDs))
+	// Вопросы и их варианты записываются вместе, как в FromQuestionData
+	tx, err := DB.Beginx()
 	if err != nil {
-		log.Printf("Query error: %v", err)
 		return nil, err
 	}
-	defer func() {
-		if closeErr := rows.Close(); closeErr != nil && err == nil {
-			err = closeErr
-		}
-	}()
+	defer tx.Rollback()
 
 	var result []Question
-	for rows.Next() {
-		var q Question
-		if err := rows.Scan(&q.QwizID, &q.Index, &q.Body, &q.Answer1, &q.Answer2, &q.Answer3, &q.Answer4, &q.Correct, &q.EmbedUUID); err != nil {
-			log.Printf("Scan error: %v", err)
+	err = tx.Select(&result, `INSERT INTO question (qwiz_id, index, body, embed_uuid, time_limit,
+		question_type, accepted_answers, numeric_answer, tolerance, weight)
+	SELECT $1, index, body, embed_uuid, time_limit,
+		question_type::question_type, accepted_answers::TEXT[], numeric_answer, tolerance, weight
+	FROM UNNEST($2::INT[], $3::TEXT[], $4::UUID[], $5::INT2[], $6::TEXT[], $7::TEXT[], $8::FLOAT8[], $9::FLOAT8[], $10::FLOAT8[])
+	AS t(index, body, embed_uuid, time_limit, question_type, accepted_answers, numeric_answer, tolerance, weight)
+	RETURNING *`, qwizID, pq.Array(indexes), pq.StringArray(bodies), pq.Array(embedUUIDs), pq.Array(timeLimits),
+		pq.StringArray(types), pq.Array(acceptedAnswers), pq.Array(numericAnswers), pq.Array(tolerances), pq.Array(weights))
+	if err != nil {
+		log.Printf("Query error: %v", err)
+		return nil, err
+	}
+
+	// Варианты вставляются после того, как все строки вопросов прочитаны: пока курсор открыт,
+	// соединение транзакции занято
+	for i := range result {
+		q := &result[i]
+		log.Printf("Question retrieved: %v", *q)
+		if q.Answers, err = insertAnswers(tx, qwizID, q.Index, datas[q.Index].Answers); err != nil {
 			return nil, err
 		}
-		log.Printf("Question retrieved: %v", q)
-		result = append(result, q)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 
 	log.Printf("Questions inserted successfully: %v", result)
 	return result, nil
 }
 
+// arrayLiteral - литерал массива Postgres, nil - NULL.
+func arrayLiteral(array driver.Valuer) sql.NullString {
+	value, _ := array.Value()
+	literal, ok := value.(string)
+	return sql.NullString{String: literal, Valid: ok}
+}
+
 func (q *Question) Delete() error {
 	_, err := DB.Exec(`WITH deleted AS (
 		DELETE FROM question WHERE qwiz_id=$1 AND index=$2 RETURNING qwiz_id, index
@@ -190,53 +285,54 @@ func (q *Question) Delete() error {
 	return err
 }
 
+// UpdateIndex переносит вопрос на newIndex, остальные вопросы сдвигаются. Вопрос не удаляется,
+// а временно переезжает в свободный индекс: варианты ответа следуют за ним по ON UPDATE CASCADE.
+// Все шаги идут в одной транзакции, так что при ошибке вопрос не остаётся в свободном индексе.
 func (q *Question) UpdateIndex(newIndex int32) (bool, error) {
-	switch {
-	case newIndex == q.Index:
+	if newIndex == q.Index {
 		return false, nil
+	}
 
-	case newIndex > q.Index:
-		_, err := DB.Exec("DELETE FROM question WHERE qwiz_id=$1 AND index=$2", q.QwizID, q.Index)
-		if err != nil {
-			return false, err
-		}
-
-		_, err = DB.Exec("UPDATE question SET index=index-1 WHERE index>$1 AND qwiz_id=$2", q.Index, q.QwizID)
-		if err != nil {
-			return false, err
-		}
-
-		err = DB.Get(&q.Index, `INSERT INTO question (qwiz_id, index, body, answer1, answer2, answer3, answer4, correct, embed_uuid)
-    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING index`,
-			q.QwizID, newIndex, q.Body, q.Answer1, q.Answer2, q.Answer3, q.Answer4, q.Correct, q.EmbedUUID)
-		if err != nil {
-			return false, err
-		}
-
-		return true, nil
-
-	case newIndex < q.Index:
-		_, err := DB.Exec("DELETE FROM question WHERE qwiz_id=$1 AND index=$2", q.QwizID, q.Index)
-		if err != nil {
-			return false, err
-		}
+	tx, err := DB.Beginx()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
 
-		_, err = DB.Exec("UPDATE question SET index=index+1 WHERE index>=$1 AND index<$2 AND qwiz_id=$3", newIndex, q.Index, q.QwizID)
-		if err != nil {
-			return false, err
-		}
+	var count int32
+	if err := tx.Get(&count, "SELECT count(*) FROM question WHERE qwiz_id=$1", q.QwizID); err != nil {
+		return false, err
+	}
+	if newIndex < 0 || newIndex >= count {
+		return false, fmt.Errorf("index %d is out of range", newIndex)
+	}
 
-		err = DB.Get(&q.Index, `INSERT INTO question (qwiz_id, index, body, answer1, answer2, answer3, answer4, embed_uuid, correct)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING index`,
-			q.QwizID, newIndex, q.Body, q.Answer1, q.Answer2, q.Answer3, q.Answer4, q.EmbedUUID, q.Correct)
-		if err != nil {
-			return false, err
-		}
+	_, err = tx.Exec("UPDATE question SET index=$1 WHERE qwiz_id=$2 AND index=$3", count, q.QwizID, q.Index)
+	if err != nil {
+		return false, err
+	}
 
-		return true, nil
+	if newIndex > q.Index {
+		_, err = tx.Exec("UPDATE question SET index=index-1 WHERE index>$1 AND index<=$2 AND qwiz_id=$3", q.Index, newIndex, q.QwizID)
+	} else {
+		_, err = tx.Exec("UPDATE question SET index=index+1 WHERE index>=$1 AND index<$2 AND qwiz_id=$3", newIndex, q.Index, q.QwizID)
+	}
+	if err != nil {
+		return false, err
 	}
 
-	return false, fmt.Errorf("unexpected condition in UpdateIndex")
+	_, err = tx.Exec("UPDATE question SET index=$1 WHERE qwiz_id=$2 AND index=$3", newIndex, q.QwizID, count)
+	if err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	q.Index = newIndex
+	for i := range q.Answers {
+		q.Answers[i].QuestionIndex = newIndex
+	}
+	return true, nil
 }
 
 func (q *Question) UpdateBody(newBody string) error {
@@ -246,44 +342,63 @@ func (q *Question) UpdateBody(newBody string) error {
 	return err
 }
 
-func (q *Question) UpdateAnswer(answerNumber uint8, newAnswer *string) (bool, error) {
-	switch answerNumber {
-	case 1:
-		if newAnswer == nil {
-			return false, nil
-		}
-		return true, DB.Get(&q.Answer1, "UPDATE question SET answer1=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING answer1",
-			*newAnswer, q.QwizID, q.Index)
+// UpdateCorrect делает верным вариант newCorrect (с 1) вопроса single или true_false.
+func (q *Question) UpdateCorrect(newCorrect int16) error {
+	if q.Kind() == Multiple {
+		return ErrInvalidQuestion
+	}
+	return q.SetCorrect([]int32{int32(newCorrect)})
+}
 
-	case 2:
-		if newAnswer == nil {
-			return false, nil
-		}
-		return true, DB.Get(&q.Answer2, "UPDATE question SET answer2=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING answer2",
-			newAnswer, q.QwizID, q.Index)
-	case 3:
-		if newAnswer == nil {
-			newAnswer = new(string) // Go's sqlx doesn't handle nil pointers well for nullable database fields
-		}
-		return true, DB.Get(&q.Answer3, "UPDATE question SET answer3=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING answer3",
-			*newAnswer, q.QwizID, q.Index)
-	case 4:
-		if newAnswer == nil {
-			newAnswer = new(string) // Go's sqlx doesn't handle nil pointers well for nullable database fields
-		}
-		return true, DB.Get(&q.Answer4, "UPDATE question SET answer4=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING answer4",
-			*newAnswer, q.QwizID, q.Index)
+// UpdateTypedAnswer меняет верный ответ вопросов multiple (номера верных вариантов с 1), text и numeric.
+// Поля, которые не заданы, остаются прежними; поля не того типа вопроса - ошибка ErrInvalidQuestion.
+func (q *Question) UpdateTypedAnswer(correctAnswers []int32, acceptedAnswers []string, numericAnswer, tolerance *float64) error {
+	if (correctAnswers != nil && q.Kind() != Multiple) || (acceptedAnswers != nil && q.Kind() != Text) ||
+		((numericAnswer != nil || tolerance != nil) && q.Kind() != Numeric) {
+		return ErrInvalidQuestion
+	}
+	if correctAnswers != nil {
+		return q.SetCorrect(correctAnswers)
+	}
 
-	default:
-		return false, errors.New("invalid answer number")
+	data := NewQuestionData{
+		Type:            q.Kind(),
+		AcceptedAnswers: q.AcceptedAnswers,
+		NumericAnswer:   q.NumericAnswer,
+		Tolerance:       q.Tolerance,
+	}
+	if acceptedAnswers != nil {
+		data.AcceptedAnswers = acceptedAnswers
+	}
+	if numericAnswer != nil {
+		data.NumericAnswer = numericAnswer
+	}
+	if tolerance != nil {
+		data.Tolerance = *tolerance
+	}
+	if err := data.normalize(); err != nil {
+		return err
 	}
+
+	return DB.QueryRowx(`UPDATE question SET accepted_answers=$1, numeric_answer=$2, tolerance=$3
+		WHERE qwiz_id=$4 AND index=$5 RETURNING accepted_answers, numeric_answer, tolerance`,
+		pq.StringArray(data.AcceptedAnswers), data.NumericAnswer, data.Tolerance, q.QwizID, q.Index).
+		Scan(&q.AcceptedAnswers, &q.NumericAnswer, &q.Tolerance)
 }
 
-func (q *Question) UpdateCorrect(newCorrect int16) error {
-	err := DB.Get(&q.Correct, "UPDATE question SET correct=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING correct",
-		newCorrect, q.QwizID, q.Index)
+// UpdateTimeLimit задаёт ограничение по времени в секундах, nil снимает его.
+func (q *Question) UpdateTimeLimit(newTimeLimit *int16) error {
+	return DB.Get(&q.TimeLimit, "UPDATE question SET time_limit=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING time_limit",
+		newTimeLimit, q.QwizID, q.Index)
+}
 
-	return err
+// UpdateWeight задаёт, сколько баллов стоит вопрос.
+func (q *Question) UpdateWeight(newWeight float64) error {
+	if !validWeight(newWeight) {
+		return ErrInvalidQuestion
+	}
+	return DB.Get(&q.Weight, "UPDATE question SET weight=$1 WHERE qwiz_id=$2 AND index=$3 RETURNING weight",
+		newWeight, q.QwizID, q.Index)
 }
 
 func (q *Question) UpdateEmbed(newData *media.NewMediaData) error {
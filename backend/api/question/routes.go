@@ -2,6 +2,7 @@ package question
 
 import (
 	"api/account"
+	"api/authz"
 	"api/config"
 	"api/media"
 	"api/utils"
@@ -18,16 +19,35 @@ import (
 func questionInfo(c *gin.Context) {
 	c.String(http.StatusOK, `
 GET /question/<qwiz_id>/<index> - get question data by qwiz id and index
+Authorization: Bearer <access_token> - optional, the creator of the qwiz also gets the solution
+Answers of ordering are listed alphabetically, pairs of matching - in matches, also alphabetically.
+solution: {
+	correct: Vector of Integer - correct answers of single, multiple and true_false,
+	sequence: Vector of Integer - the correct answer of ordering and matching in the format of solve,
+	accepted_answers, numeric_answer, tolerance - the answer of text and numeric
+} - only for the creator
 
 POST /question/<qwiz_id> - add a question to an existing qwiz
-creator_password: String - required
+Authorization: Bearer <access_token> - required
 question: {
+	type: single/multiple/true_false/text/numeric/ordering/matching - optional, single by default
 	body: String - required,
-	answer1: String - required,
-	answer2: String - required,
-	answer3: String - optional,
-	answer4: String - optional,
-	correct: 1/2/3/4 - required,
+	answers: Vector of {
+		body: String - required,
+		correct: Boolean - optional, one correct answer for single and true_false, at least one for multiple,
+		match: String - required for matching, the pair of this answer,
+		embed: {
+			data: String - required
+			media_type: MediaType - required
+		} - optional
+	} - required for single and multiple (at least 2), true_false (exactly 2, "True"/"False" by default),
+		ordering (at least 2, in the correct order) and matching (at least 2),
+	answer1..answer4, correct: 1/2/3/4, correct_answers: Vector of 1/2/3/4 - deprecated, instead of answers,
+	accepted_answers: Vector of String - required for text, matched ignoring case and extra spaces,
+	numeric_answer: Float - required for numeric,
+	tolerance: Float - optional for numeric, 0 by default,
+	time_limit: Integer - optional, seconds to answer in a live qwiz
+	weight: Float - optional, points for a fully correct answer, 1 by default
 	embed: {
 		data: String - required
 		media_type: MediaType - required
@@ -35,30 +55,41 @@ question: {
 } - required
 
 PATCH /question/<qwiz_id>/<index> - update question data
-creator_password: String - required
+Authorization: Bearer <access_token> - required
 new_index: i32 - optional
 new_body: String - optional
+replace_answers: Vector of { body, correct, embed, match } - optional, replaces all answers
 new_answers: Vector of {
-	index: 1/2/3/4 - required,
+	index: Integer - required, from 1, one more than the number of answers adds an answer,
 	content: String - optional (null to delete)
-} - optional
+} - optional, deprecated
+new_correct: Integer - optional, single and true_false only
+new_correct_answers: Vector of Integer - optional, multiple only
+new_accepted_answers: Vector of String - optional, text only
+new_numeric_answer: Float - optional, numeric only
+new_tolerance: Float - optional, numeric only
+new_time_limit: Integer - optional, seconds (0 to remove the limit)
+new_weight: Float - optional, greater than 0
 new_embed: {
 	data: String - required
 	media_type: MediaType - required
 } - optional
 
 DELETE /question/<qwiz_id> - delete question
-creator_password: String - required
+Authorization: Bearer <access_token> - required
 `)
 }
 
 type Qwiz struct {
-	ID            int32     `db:"id"`
-	Name          string    `db:"name"`
-	CreatorID     int32     `db:"creator_id"`
-	ThumbnailUUID uuid.UUID `db:"thumbnail_uuid"`
-	Public        bool      `db:"public"`
-	CreateTime    time.Time `db:"create_time"`
+	ID               int32     `db:"id"`
+	Name             string    `db:"name"`
+	CreatorID        int32     `db:"creator_id"`
+	ThumbnailUUID    uuid.UUID `db:"thumbnail_uuid"`
+	Public           bool      `db:"public"`
+	CreateTime       time.Time `db:"create_time"`
+	ShuffleQuestions bool      `db:"shuffle_questions"`
+	ShuffleAnswers   bool      `db:"shuffle_answers"`
+	Reveal           string    `db:"reveal"`
 }
 
 func GetQwizByID(id int32) (*Qwiz, error) {
@@ -97,51 +128,130 @@ func getQuestionByQwizIDIndex(c *gin.Context) {
 		return
 	}
 
+	// Верный ответ видит только автор викторины
+	author, err := authz.Allowed(c, authz.CreatorOwnsQwiz(authz.Param("id")))
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
 	// Convert your question to the GetQuestionData struct
-	questionData, err := GetQuestionDataFromQuestion(*question)
+	var questionData *GetQuestionData
+	if author {
+		questionData, err = GetAuthorQuestionData(*question)
+	} else {
+		questionData, err = GetQuestionDataFromQuestion(*question)
+	}
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
 
 	// Return the result
 	c.JSON(http.StatusOK, questionData)
 }
 
+type GetAnswerData struct {
+	Body  string              `json:"body"`
+	Embed *media.GetMediaData `json:"embed"`
+}
+
 type GetQuestionData struct {
-	Index   int32               `json:"index"`
-	Body    string              `json:"body"`
-	Answer1 string              `json:"answer1"`
-	Answer2 string              `json:"answer2"`
-	Answer3 *string             `json:"answer3"`
-	Answer4 *string             `json:"answer4"`
-	Embed   *media.GetMediaData `json:"embed"`
+	Index   int32           `json:"index"`
+	Type    Type            `json:"type"`
+	Body    string          `json:"body"`
+	Answers []GetAnswerData `json:"answers"`
+	// Matches - пары вопроса matching, их номера в ответе - по этому порядку
+	Matches []string `json:"matches,omitempty"`
+	// Answer1..Answer4 - первые четыре варианта для старых клиентов, устарели
+	Answer1   string              `json:"answer1"`
+	Answer2   string              `json:"answer2"`
+	Answer3   *string             `json:"answer3"`
+	Answer4   *string             `json:"answer4"`
+	Embed     *media.GetMediaData `json:"embed"`
+	TimeLimit *int16              `json:"time_limit"`
+	Weight    float64             `json:"weight"`
+	// Solution - верный ответ в той же нумерации, только в виде для автора
+	Solution *Solution `json:"solution,omitempty"`
+}
+
+func getMediaData(embedUUID *uuid.UUID) (*media.GetMediaData, error) {
+	if embedUUID == nil {
+		return nil, nil
+	}
+	med, err := media.GetByUUID(embedUUID)
+	if err != nil {
+		return nil, err
+	}
+	return &media.GetMediaData{
+		URI:       med.URI,
+		MediaType: media.Type(med.MediaType),
+	}, nil
 }
 
 func GetQuestionDataFromQuestion(question Question) (*GetQuestionData, error) {
-	var mediaData *media.GetMediaData
-	if question.EmbedUUID != nil {
-		med, err := media.GetByUUID(question.EmbedUUID) // Assuming this returns (*Media, error)
+	return GetQuestionDataInLayout(question, question.DefaultLayout())
+}
+
+// GetAuthorQuestionData - данные вопроса вместе с верным ответом, для автора викторины.
+func GetAuthorQuestionData(question Question) (*GetQuestionData, error) {
+	layout := question.DefaultLayout()
+	data, err := GetQuestionDataInLayout(question, layout)
+	if err != nil {
+		return nil, err
+	}
+	solution := question.SolutionIn(layout)
+	data.Solution = &solution
+	return data, nil
+}
+
+// GetQuestionDataInLayout - данные вопроса с вариантами и парами в порядке layout.
+func GetQuestionDataInLayout(question Question, layout Layout) (*GetQuestionData, error) {
+	mediaData, err := getMediaData(question.EmbedUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &GetQuestionData{
+		Index:     question.Index,
+		Type:      question.Kind(),
+		Body:      question.Body,
+		Answers:   make([]GetAnswerData, 0, len(question.Answers)),
+		Embed:     mediaData,
+		TimeLimit: question.TimeLimit,
+		Weight:    question.Weight,
+	}
+	for i, idx := range layout.Answers {
+		a := question.Answers[idx]
+		embed, err := getMediaData(a.EmbedUUID)
 		if err != nil {
 			return nil, err
 		}
-		// Convert *Media to *GetMediaData
-		mediaData = &media.GetMediaData{
-			URI:       med.URI,
-			MediaType: media.Type(med.MediaType),
+		data.Answers = append(data.Answers, GetAnswerData{Body: a.Body, Embed: embed})
+
+		body := a.Body
+		switch i {
+		case 0:
+			data.Answer1 = body
+		case 1:
+			data.Answer2 = body
+		case 2:
+			data.Answer3 = &body
+		case 3:
+			data.Answer4 = &body
 		}
 	}
-
-	return &GetQuestionData{
-		Index:   question.Index,
-		Body:    question.Body,
-		Answer1: question.Answer1,
-		Answer2: question.Answer2,
-		Answer3: question.Answer3,
-		Answer4: question.Answer4,
-		Embed:   mediaData,
-	}, nil
+	matches := question.Matches()
+	for _, idx := range layout.Matches {
+		data.Matches = append(data.Matches, matches[idx])
+	}
+	return data, nil
 }
 
 type PostQuestionData struct {
-	CreatorPassword string          `json:"creator_password"`
-	Question        NewQuestionData `json:"question"`
+	Question NewQuestionData `json:"question"`
 }
 
 func createQuestion(c *gin.Context) {
@@ -160,34 +270,11 @@ func createQuestion(c *gin.Context) {
 		return
 	}
 
-	quiz, err := GetQwizByID(int32(intQwizID))
-	if err != nil {
-		utils.DbErrToStatus(err, http.StatusNotFound)
-		c.JSON(http.StatusNotFound, gin.H{"error": "Qwiz not found"})
-		return
-	}
-
-	acct, err := account.GetByID(quiz.CreatorID)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	isValid, err := acct.VerifyPassword(questionData.CreatorPassword)
-	if err != nil {
-		// Handle the error, could be a database error or something similar
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+	question, err := FromQuestionData(int32(intQwizID), &questionData.Question)
+	if errors.Is(err, ErrInvalidQuestion) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-
-	question, err := FromQuestionData(int32(intQwizID), &questionData.Question)
 	if err != nil {
 		utils.DbErrToStatus(err, http.StatusBadRequest)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Bad request"})
@@ -203,12 +290,19 @@ type NewAnswer struct {
 }
 
 type PatchQuestionData struct {
-	CreatorPassword string              `json:"creator_password"`
-	NewIndex        *int32              `json:"new_index"`
-	NewBody         *string             `json:"new_body"`
-	NewAnswers      []NewAnswer         `json:"new_answers"`
-	NewCorrect      *uint8              `json:"new_correct"`
-	NewEmbed        *media.NewMediaData `json:"new_embed"`
+	NewIndex     *int32              `json:"new_index"`
+	NewBody      *string             `json:"new_body"`
+	NewAnswers   []NewAnswer         `json:"new_answers"`
+	NewCorrect   *uint8              `json:"new_correct"`
+	NewTimeLimit *int16              `json:"new_time_limit"`
+	NewWeight    *float64            `json:"new_weight"`
+	NewEmbed     *media.NewMediaData `json:"new_embed"`
+
+	ReplaceAnswers     []NewAnswerData `json:"replace_answers"`
+	NewCorrectAnswers  []int32         `json:"new_correct_answers"`
+	NewAcceptedAnswers []string        `json:"new_accepted_answers"`
+	NewNumericAnswer   *float64        `json:"new_numeric_answer"`
+	NewTolerance       *float64        `json:"new_tolerance"`
 }
 
 // updateQuestion handles PATCH requests to update a question.
@@ -243,31 +337,6 @@ func updateQuestion(c *gin.Context) {
 		return
 	}
 
-	quiz, err := GetQwizByID(int32(intQwizID))
-	if err != nil {
-		utils.DbErrToStatus(err, http.StatusNotFound)
-		c.JSON(http.StatusNotFound, gin.H{"error": "Qwiz not found"})
-		return
-	}
-
-	acct, err := account.GetByID(quiz.CreatorID)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	isValid, err := acct.VerifyPassword(newQuestionData.CreatorPassword)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
 	if newQuestionData.NewIndex != nil {
 		if _, err := question.UpdateIndex(*newQuestionData.NewIndex); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new index"})
@@ -282,6 +351,14 @@ func updateQuestion(c *gin.Context) {
 		}
 	}
 
+	if newQuestionData.ReplaceAnswers != nil {
+		if err := question.ReplaceAnswers(newQuestionData.ReplaceAnswers); err != nil {
+			utils.DbErrToStatus(err, http.StatusBadRequest)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new answers"})
+			return
+		}
+	}
+
 	for _, newAnswer := range newQuestionData.NewAnswers {
 		if _, err := question.UpdateAnswer(newAnswer.Index, newAnswer.Content); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new answer"})
@@ -296,6 +373,35 @@ func updateQuestion(c *gin.Context) {
 		}
 	}
 
+	if newQuestionData.NewCorrectAnswers != nil || newQuestionData.NewAcceptedAnswers != nil ||
+		newQuestionData.NewNumericAnswer != nil || newQuestionData.NewTolerance != nil {
+		err := question.UpdateTypedAnswer(newQuestionData.NewCorrectAnswers, newQuestionData.NewAcceptedAnswers,
+			newQuestionData.NewNumericAnswer, newQuestionData.NewTolerance)
+		if err != nil {
+			utils.DbErrToStatus(err, http.StatusBadRequest)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new answer"})
+			return
+		}
+	}
+
+	if newQuestionData.NewTimeLimit != nil {
+		timeLimit := newQuestionData.NewTimeLimit
+		if *timeLimit == 0 {
+			timeLimit = nil
+		}
+		if err := question.UpdateTimeLimit(timeLimit); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new time limit"})
+			return
+		}
+	}
+
+	if newQuestionData.NewWeight != nil {
+		if err := question.UpdateWeight(*newQuestionData.NewWeight); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid new weight"})
+			return
+		}
+	}
+
 	if newQuestionData.NewEmbed != nil {
 		if err := question.UpdateEmbed(newQuestionData.NewEmbed); err != nil {
 			utils.InternalErr(err)
@@ -307,21 +413,11 @@ func updateQuestion(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
-type DeleteQuestionData struct {
-	CreatorPassword string `json:"creator_password"`
-}
-
 // deleteQuestion handles DELETE requests to delete a question.
 func deleteQuestion(c *gin.Context) {
 	qwizID := c.Param("id")
 	index := c.Param("index")
 
-	var deleteQuestionData DeleteQuestionData
-	if err := c.BindJSON(&deleteQuestionData); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
-		return
-	}
-
 	// Convert qwizID from string to int32
 	intQwizID, err := strconv.Atoi(qwizID)
 	if err != nil {
@@ -343,31 +439,6 @@ func deleteQuestion(c *gin.Context) {
 		return
 	}
 
-	quiz, err := GetQwizByID(int32(intQwizID))
-	if err != nil {
-		utils.DbErrToStatus(err, http.StatusNotFound)
-		c.JSON(http.StatusNotFound, gin.H{"error": "Quiz not found"})
-		return
-	}
-
-	acct, err := account.GetByID(quiz.CreatorID)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	isValid, err := acct.VerifyPassword(deleteQuestionData.CreatorPassword)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-	if !isValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
 	if err := question.Delete(); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete question"})
 		return
@@ -381,9 +452,10 @@ func RegisterRoutes(r *gin.Engine) {
 	questionGroup := r.Group(config.BaseURL + "/question")
 	{
 		questionGroup.GET("", questionInfo)
-		questionGroup.GET("/:id/:index", getQuestionByQwizIDIndex)
-		questionGroup.POST("/:id", createQuestion)
-		questionGroup.PATCH("/:id/:index", updateQuestion)
-		questionGroup.DELETE("/:id/:index", deleteQuestion)
+		questionGroup.GET("/:id/:index", account.OptionalAuth(), getQuestionByQwizIDIndex)
+		ownsQwiz := authz.Require(authz.CreatorOwnsQwiz(authz.Param("id")))
+		questionGroup.POST("/:id", account.RequireAuth(), ownsQwiz, createQuestion)
+		questionGroup.PATCH("/:id/:index", account.RequireAuth(), ownsQwiz, updateQuestion)
+		questionGroup.DELETE("/:id/:index", account.RequireAuth(), ownsQwiz, deleteQuestion)
 	}
 }
@@ -0,0 +1,41 @@
+package question
+
+// Solution - верный ответ на вопрос в нумерации раскладки, в которой вопрос показан.
+// Correct - номера верных вариантов single, multiple и true_false, Sequence - верный ответ
+// ordering и matching в том виде, в каком его присылает участник (см. ParseResponseIn),
+// AcceptedAnswers - ответы text, NumericAnswer и Tolerance - ответ numeric.
+type Solution struct {
+	Correct         []int    `json:"correct,omitempty"`
+	Sequence        []int    `json:"sequence,omitempty"`
+	AcceptedAnswers []string `json:"accepted_answers,omitempty"`
+	NumericAnswer   *float64 `json:"numeric_answer,omitempty"`
+	Tolerance       float64  `json:"tolerance,omitempty"`
+}
+
+// SolutionIn - верный ответ вопроса, варианты и пары которого показаны в порядке layout.
+func (q *Question) SolutionIn(layout Layout) Solution {
+	var s Solution
+	switch q.Kind() {
+	case Text:
+		s.AcceptedAnswers = q.AcceptedAnswers
+	case Numeric:
+		s.NumericAnswer, s.Tolerance = q.NumericAnswer, q.Tolerance
+	case Ordering:
+		// Верный порядок - порядок вариантов в вопросе
+		for i := range q.Answers {
+			s.Sequence = append(s.Sequence, int(displayNumber(layout.Answers, uint8(i))))
+		}
+	case Matching:
+		// Для каждого показанного варианта - номер его пары среди показанных пар
+		for _, idx := range layout.Answers {
+			s.Sequence = append(s.Sequence, int(displayNumber(layout.Matches, idx)))
+		}
+	default:
+		for i, idx := range layout.Answers {
+			if q.Answers[idx].Correct {
+				s.Correct = append(s.Correct, i+1)
+			}
+		}
+	}
+	return s
+}
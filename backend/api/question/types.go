@@ -0,0 +1,398 @@
+package question
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type - тип вопроса: как задаётся верный ответ и в каком виде присылается ответ участника.
+type Type string
+
+const (
+	// Single - один верный вариант из Answers.
+	Single Type = "single"
+	// Multiple - несколько верных вариантов, засчитывается только точный набор.
+	Multiple Type = "multiple"
+	// TrueFalse - два варианта, первый - "верно", второй - "неверно".
+	TrueFalse Type = "true_false"
+	// Text - короткий ответ строкой, сравнивается с accepted_answers без учёта регистра и лишних пробелов.
+	Text Type = "text"
+	// Numeric - число, верно в пределах tolerance от numeric_answer.
+	Numeric Type = "numeric"
+	// Ordering - расставить Answers по порядку, верный порядок - порядок вариантов в вопросе.
+	Ordering Type = "ordering"
+	// Matching - сопоставить каждому варианту его пару Match.
+	Matching Type = "matching"
+)
+
+const maxTextAnswerLength = 200
+
+// DefaultWeight - сколько баллов стоит вопрос, если вес не задан.
+const DefaultWeight = 1.0
+
+var (
+	ErrInvalidQuestion = errors.New("invalid question")
+	ErrInvalidResponse = errors.New("invalid answer")
+)
+
+// IsChoice сообщает, что ответ - выбор из вариантов.
+func (t Type) IsChoice() bool {
+	return t == Single || t == Multiple || t == TrueFalse
+}
+
+// HasAnswers сообщает, что у вопроса есть варианты: выбор, упорядочивание или сопоставление.
+func (t Type) HasAnswers() bool {
+	return t.IsChoice() || t == Ordering || t == Matching
+}
+
+// Kind - тип вопроса; пустой Type, как у вопросов, созданных без типа, означает Single.
+func (q *Question) Kind() Type {
+	if q.Type == "" {
+		return Single
+	}
+	return q.Type
+}
+
+// Response - ответ на вопрос. Choices - номера вариантов с 1 в исходном порядке вопроса.
+// Sequence у ordering - номера вариантов в том порядке, в котором их расставил участник,
+// у matching - для каждого варианта номер варианта, чью пару ему сопоставили.
+// В обоих случаях ответ верен целиком, если Sequence[i] == i+1.
+type Response struct {
+	Choices  []uint8
+	Text     *string
+	Number   *float64
+	Sequence []uint8
+}
+
+// AnswerBodies - тексты вариантов по порядку, у вопросов без вариантов - пусто.
+func (q *Question) AnswerBodies() []string {
+	bodies := make([]string, len(q.Answers))
+	for i, a := range q.Answers {
+		bodies[i] = a.Body
+	}
+	return bodies
+}
+
+// Matches - пары вариантов matching по порядку, у остальных вопросов - пусто.
+func (q *Question) Matches() []string {
+	matches := []string{}
+	for _, a := range q.Answers {
+		if a.Match != nil {
+			matches = append(matches, *a.Match)
+		}
+	}
+	return matches
+}
+
+// ListOrder - в каком порядке варианты показываются при решении викторины: индексы вариантов с 0.
+// У ordering варианты идут по алфавиту, чтобы не выдать верный порядок, у остальных - как в вопросе.
+func (q *Question) ListOrder() []uint8 {
+	if q.Kind() != Ordering {
+		return identityOrder(len(q.Answers))
+	}
+	return alphabeticalOrder(q.AnswerBodies())
+}
+
+// MatchOrder - в каком порядке показываются пары matching: индексы вариантов с 0, по алфавиту пар.
+func (q *Question) MatchOrder() []uint8 {
+	return alphabeticalOrder(q.Matches())
+}
+
+func identityOrder(n int) []uint8 {
+	order := make([]uint8, n)
+	for i := range order {
+		order[i] = uint8(i)
+	}
+	return order
+}
+
+func alphabeticalOrder(values []string) []uint8 {
+	order := identityOrder(len(values))
+	sort.SliceStable(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+	return order
+}
+
+// CorrectChoices - номера верных вариантов с 1 по возрастанию, у вопросов без вариантов - пусто.
+func (q *Question) CorrectChoices() []uint8 {
+	choices := []uint8{}
+	for i, a := range q.Answers {
+		if a.Correct {
+			choices = append(choices, uint8(i+1))
+		}
+	}
+	return choices
+}
+
+// NormalizeText приводит текстовый ответ к виду для сравнения: нижний регистр, пробелы по краям
+// отброшены, внутри схлопнуты в один.
+func NormalizeText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// ParseResponse разбирает ответ в формате типа вопроса: single - номер варианта, multiple - массив
+// номеров, true_false - true/false или 1/2, text - строка, numeric - число (или строка с числом).
+// У ordering и matching - массив номеров с 1 в порядке ListOrder и MatchOrder: варианты по порядку
+// или пары для каждого варианта вопроса.
+func (q *Question) ParseResponse(raw json.RawMessage) (Response, error) {
+	return q.ParseResponseIn(raw, q.DefaultLayout())
+}
+
+// ParseResponseIn - ParseResponse для вариантов, показанных в порядке layout: номера в ответе
+// переводятся в исходную нумерацию вопроса.
+func (q *Question) ParseResponseIn(raw json.RawMessage, layout Layout) (Response, error) {
+	var r Response
+	var numbers []uint8
+	switch q.Kind() {
+	case Ordering, Matching, Multiple:
+		if err := json.Unmarshal(raw, &numbers); err != nil {
+			return r, ErrInvalidResponse
+		}
+	case TrueFalse:
+		var value bool
+		if err := json.Unmarshal(raw, &value); err == nil {
+			if value {
+				r.Choices = []uint8{1}
+			} else {
+				r.Choices = []uint8{2}
+			}
+			break
+		}
+		var choice uint8
+		if err := json.Unmarshal(raw, &choice); err != nil {
+			return r, ErrInvalidResponse
+		}
+		numbers = []uint8{choice}
+	case Text:
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return r, ErrInvalidResponse
+		}
+		r.Text = &text
+	case Numeric:
+		var number float64
+		if err := json.Unmarshal(raw, &number); err != nil {
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				return r, ErrInvalidResponse
+			}
+			if number, err = strconv.ParseFloat(strings.Replace(strings.TrimSpace(text), ",", ".", 1), 64); err != nil {
+				return r, ErrInvalidResponse
+			}
+		}
+		r.Number = &number
+	default:
+		var choice uint8
+		if err := json.Unmarshal(raw, &choice); err != nil {
+			return r, ErrInvalidResponse
+		}
+		numbers = []uint8{choice}
+	}
+
+	switch q.Kind() {
+	case Ordering:
+		for _, n := range numbers {
+			original, ok := originalNumber(layout.Answers, n)
+			if !ok {
+				return r, ErrInvalidResponse
+			}
+			r.Sequence = append(r.Sequence, original)
+		}
+	case Matching:
+		// numbers[i] - пара для i-го показанного варианта
+		if len(numbers) != len(layout.Answers) {
+			return r, ErrInvalidResponse
+		}
+		r.Sequence = make([]uint8, len(numbers))
+		for i, n := range numbers {
+			original, ok := originalNumber(layout.Matches, n)
+			if !ok {
+				return r, ErrInvalidResponse
+			}
+			r.Sequence[layout.Answers[i]] = original
+		}
+	case Single, Multiple, TrueFalse:
+		for _, n := range numbers {
+			original, ok := originalNumber(layout.Answers, n)
+			if !ok {
+				return r, ErrInvalidResponse
+			}
+			r.Choices = append(r.Choices, original)
+		}
+	}
+	return r, q.Validate(r)
+}
+
+// Validate проверяет, что ответ подходит вопросу: варианты существуют, у single и true_false
+// выбран ровно один, у multiple - хотя бы один и без повторов. У ordering и matching каждый
+// вариант встречается в Sequence ровно один раз.
+func (q *Question) Validate(r Response) error {
+	switch q.Kind() {
+	case Ordering, Matching:
+		if len(r.Sequence) != len(q.Answers) {
+			return ErrInvalidResponse
+		}
+		seen := make(map[uint8]bool)
+		for _, n := range r.Sequence {
+			if n < 1 || int(n) > len(q.Answers) || seen[n] {
+				return ErrInvalidResponse
+			}
+			seen[n] = true
+		}
+		return nil
+	case Text:
+		if r.Text == nil || len([]rune(*r.Text)) > maxTextAnswerLength {
+			return ErrInvalidResponse
+		}
+		return nil
+	case Numeric:
+		if r.Number == nil || math.IsNaN(*r.Number) || math.IsInf(*r.Number, 0) {
+			return ErrInvalidResponse
+		}
+		return nil
+	}
+
+	if len(r.Choices) == 0 || (q.Kind() != Multiple && len(r.Choices) != 1) {
+		return ErrInvalidResponse
+	}
+	count := len(q.Answers)
+	seen := make(map[uint8]bool)
+	for _, c := range r.Choices {
+		if c < 1 || int(c) > count || seen[c] {
+			return ErrInvalidResponse
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// Grade проверяет уже разобранный ответ: верен ли он целиком.
+func (q *Question) Grade(r Response) bool {
+	return q.Score(r) == 1
+}
+
+// Points - баллы за ответ с учётом веса вопроса.
+func (q *Question) Points(r Response) float64 {
+	return q.Score(r) * q.Weight
+}
+
+func validWeight(weight float64) bool {
+	return weight > 0 && !math.IsInf(weight, 0)
+}
+
+// Score - доля от 0 до 1, на которую верен уже разобранный ответ. Частичный зачёт есть только
+// у ordering и matching: доля вариантов на своём месте или с верной парой. Остальные типы
+// засчитываются целиком или никак.
+func (q *Question) Score(r Response) float64 {
+	switch q.Kind() {
+	case Ordering, Matching:
+		if len(q.Answers) == 0 || len(r.Sequence) != len(q.Answers) {
+			return 0
+		}
+		placed := 0
+		for i, n := range r.Sequence {
+			if int(n) == i+1 {
+				placed++
+			}
+		}
+		return float64(placed) / float64(len(q.Answers))
+	case Text:
+		if r.Text == nil {
+			return 0
+		}
+		text := NormalizeText(*r.Text)
+		for _, accepted := range q.AcceptedAnswers {
+			if text == NormalizeText(accepted) {
+				return 1
+			}
+		}
+		return 0
+	case Numeric:
+		if r.Number == nil || q.NumericAnswer == nil {
+			return 0
+		}
+		// Небольшой запас на погрешность float: 0.1+0.2 должно совпасть с 0.3
+		if math.Abs(*r.Number-*q.NumericAnswer) <= q.Tolerance+1e-9 {
+			return 1
+		}
+		return 0
+	}
+
+	correct := q.CorrectChoices()
+	if len(r.Choices) != len(correct) {
+		return 0
+	}
+	given := append([]uint8(nil), r.Choices...)
+	sort.Slice(given, func(i, j int) bool { return given[i] < given[j] })
+	for i := range given {
+		if given[i] != correct[i] {
+			return 0
+		}
+	}
+	return 1
+}
+
+// normalize проверяет новый вопрос и приводит поля к его типу: лишние для типа поля очищаются,
+// варианты из устаревших answer1..answer4 переносятся в Answers, у true_false по умолчанию
+// подставляются подписи вариантов.
+func (d *NewQuestionData) normalize() error {
+	if d.Type == "" {
+		d.Type = Single
+	}
+	if d.Weight == 0 {
+		d.Weight = DefaultWeight
+	}
+	if !validWeight(d.Weight) {
+		return ErrInvalidQuestion
+	}
+	if d.Answers == nil && d.Type.IsChoice() {
+		if d.Type == TrueFalse && d.Answer1 == "" && d.Answer2 == "" {
+			d.Answer1, d.Answer2 = "True", "False"
+		}
+		if d.Type == TrueFalse && (d.Answer3 != nil || d.Answer4 != nil) {
+			return ErrInvalidQuestion
+		}
+		d.Answers = d.legacyAnswers()
+		for _, c := range d.CorrectAnswers {
+			if c < 1 || int(c) > len(d.Answers) {
+				return ErrInvalidQuestion
+			}
+		}
+	}
+	d.Answer1, d.Answer2, d.Answer3, d.Answer4 = "", "", nil, nil
+	d.Correct, d.CorrectAnswers = 0, nil
+	if err := checkAnswers(d.Type, d.Answers); err != nil {
+		return err
+	}
+
+	switch d.Type {
+	case Single, TrueFalse, Multiple, Ordering, Matching:
+	case Text:
+		if len(d.AcceptedAnswers) == 0 {
+			return ErrInvalidQuestion
+		}
+		for _, accepted := range d.AcceptedAnswers {
+			if NormalizeText(accepted) == "" || len([]rune(accepted)) > maxTextAnswerLength {
+				return ErrInvalidQuestion
+			}
+		}
+	case Numeric:
+		if d.NumericAnswer == nil || math.IsNaN(*d.NumericAnswer) || math.IsInf(*d.NumericAnswer, 0) || d.Tolerance < 0 {
+			return ErrInvalidQuestion
+		}
+	default:
+		return ErrInvalidQuestion
+	}
+
+	if d.Type != Text {
+		d.AcceptedAnswers = nil
+	}
+	if d.Type != Numeric {
+		d.NumericAnswer = nil
+		d.Tolerance = 0
+	}
+	return nil
+}
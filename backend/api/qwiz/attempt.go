@@ -0,0 +1,348 @@
+package qwiz
+
+import (
+	"api/assignment"
+	"api/question"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/lib/pq"
+	"math/rand"
+	"time"
+)
+
+// AttemptsPerPage - сколько попыток возвращается на одной странице истории.
+const AttemptsPerPage = 50
+
+var ErrAttemptSubmitted = errors.New("attempt already submitted")
+
+// ErrAttemptOutdated - вопросы или варианты викторины изменились после начала попытки,
+// и ответы в её раскладке уже не сопоставить с вопросами.
+var ErrAttemptOutdated = errors.New("qwiz changed since the attempt started")
+
+// Attempt - начатое решение викторины. Порядок вопросов и вариантов выбирается по Seed, поэтому
+// у каждой попытки он свой и одной шпаргалкой с номерами ответов не обойтись. Настройки
+// перемешивания, порядок вопросов (QuestionIndexes) и раскладка вариантов (Layouts)
+// запоминаются на момент начала: правка викторины не меняет того, что видел участник.
+type Attempt struct {
+	ID               int32     `db:"id"`
+	QwizID           int32     `db:"qwiz_id"`
+	Seed             int64     `db:"seed"`
+	ShuffleQuestions bool      `db:"shuffle_questions"`
+	ShuffleAnswers   bool      `db:"shuffle_answers"`
+	StartTime        time.Time `db:"start_time"`
+	// Кто решал: аккаунт или гость. DisplayName запоминается при отправке, гости со временем удаляются.
+	AccountID    *int32  `db:"account_id"`
+	GuestID      *int32  `db:"guest_id"`
+	DisplayName  *string `db:"display_name"`
+	AssignmentID *int32  `db:"assignment_id"`
+	// QuestionIndexes - индексы вопросов в порядке попытки, Layouts - раскладки вариантов
+	// в том же порядке (storedLayout). У попыток, начатых без них, раскладка восстанавливается по Seed.
+	QuestionIndexes pq.Int32Array      `db:"question_indexes"`
+	Layouts         types.NullJSONText `db:"layouts"`
+	// Остальное заполняется при отправке. Answers - ответы как их прислали,
+	// Scores - доля верного по вопросам, как в Result.
+	SubmitTime *time.Time         `db:"submit_time"`
+	Answers    types.NullJSONText `db:"answers"`
+	Scores     pq.Float64Array    `db:"scores"`
+	Score      *float64           `db:"score"`
+	MaxScore   *float64           `db:"max_score"`
+	// Penalty - доля баллов, снятая за опоздание, Score дан уже с её учётом
+	Penalty float64 `db:"penalty"`
+}
+
+// Solver - кто решает викторину: аккаунт или гость (id гостя в таблице guest), либо никто.
+type Solver struct {
+	AccountID   *int32
+	GuestID     *int32
+	DisplayName *string
+}
+
+// storedLayout - question.Layout в колонке layouts. []uint8 в JSON стал бы base64-строкой,
+// поэтому номера хранятся как int.
+type storedLayout struct {
+	Answers []int `json:"answers"`
+	Matches []int `json:"matches,omitempty"`
+}
+
+func toStoredLayout(layout question.Layout) storedLayout {
+	stored := storedLayout{Answers: make([]int, len(layout.Answers))}
+	for i, a := range layout.Answers {
+		stored.Answers[i] = int(a)
+	}
+	if layout.Matches != nil {
+		stored.Matches = make([]int, len(layout.Matches))
+		for i, m := range layout.Matches {
+			stored.Matches[i] = int(m)
+		}
+	}
+	return stored
+}
+
+func (s storedLayout) layout() question.Layout {
+	layout := question.Layout{Answers: make([]uint8, len(s.Answers))}
+	for i, a := range s.Answers {
+		layout.Answers[i] = uint8(a)
+	}
+	if s.Matches != nil {
+		layout.Matches = make([]uint8, len(s.Matches))
+		for i, m := range s.Matches {
+			layout.Matches[i] = uint8(m)
+		}
+	}
+	return layout
+}
+
+// StartAttempt начинает попытку solver с настройками перемешивания викторины и запоминает её раскладку.
+// assign - задание, в рамках которого она начата: такая попытка считается в его MaxAttempts и Duration,
+// попыток не осталось - assignment.ErrNoAttemptsLeft.
+func StartAttempt(qwiz *Qwiz, solver Solver, assign *assignment.Assignment) (*Attempt, error) {
+	questions, err := question.GetAllQuestionsByQwizID(qwiz.ID)
+	if err != nil {
+		return nil, err
+	}
+	attempt := &Attempt{Seed: rand.Int63(), ShuffleQuestions: qwiz.ShuffleQuestions, ShuffleAnswers: qwiz.ShuffleAnswers}
+	arranged, layouts := attempt.shuffle(questions)
+	indexes := make(pq.Int32Array, len(arranged))
+	stored := make([]storedLayout, len(layouts))
+	for i := range arranged {
+		indexes[i] = arranged[i].Index
+		stored[i] = toStoredLayout(layouts[i])
+	}
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	assignmentID, err := reserveAttempt(tx, assign, solver)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Get(attempt, `
+		INSERT INTO attempt (qwiz_id, seed, shuffle_questions, shuffle_answers, start_time, account_id, guest_id, assignment_id,
+			question_indexes, layouts)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING *
+	`, qwiz.ID, attempt.Seed, attempt.ShuffleQuestions, attempt.ShuffleAnswers, time.Now().UTC(), solver.AccountID,
+		solver.GuestID, assignmentID, indexes, types.JSONText(raw))
+	if err != nil {
+		return nil, err
+	}
+	return attempt, tx.Commit()
+}
+
+// reserveAttempt проверяет в tx, что у solver остались попытки задания assign, и возвращает его id.
+// Без задания - nil.
+func reserveAttempt(tx *sqlx.Tx, assign *assignment.Assignment, solver Solver) (*int32, error) {
+	if assign == nil {
+		return nil, nil
+	}
+	if err := assign.ReserveAttempt(tx, *solver.AccountID); err != nil {
+		return nil, err
+	}
+	id := int32(assign.ID)
+	return &id, nil
+}
+
+// SubmitWithoutAttempt записывает решение, отправленное без начатой попытки: вопросы и варианты
+// шли по порядку, начало совпадает с отправкой. В рамках задания assign такая попытка тоже
+// считается в MaxAttempts, попыток не осталось - assignment.ErrNoAttemptsLeft.
+func SubmitWithoutAttempt(qwizID int32, solver Solver, assign *assignment.Assignment, answers []json.RawMessage,
+	result *Result) (*Attempt, error) {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	assignmentID, err := reserveAttempt(tx, assign, solver)
+	if err != nil {
+		return nil, err
+	}
+	attempt := &Attempt{}
+	err = tx.Get(attempt, `
+		INSERT INTO attempt (qwiz_id, seed, shuffle_questions, shuffle_answers, start_time, account_id, assignment_id)
+		VALUES ($1, 0, false, false, $2, $3, $4)
+		RETURNING *
+	`, qwizID, time.Now().UTC(), solver.AccountID, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := attempt.submit(tx, solver, assignmentID, answers, result); err != nil {
+		return nil, err
+	}
+	return attempt, tx.Commit()
+}
+
+// BelongsTo сообщает, может ли solver отправить попытку: начатую аккаунтом или гостем - только он.
+// Анонимные попытки не начинаются, а оставшиеся с прежних времён не отправляет никто: их номера
+// идут подряд, и чужую попытку можно было бы перезаписать.
+func (a *Attempt) BelongsTo(solver Solver) bool {
+	switch {
+	case a.AccountID != nil:
+		return solver.AccountID != nil && *solver.AccountID == *a.AccountID
+	case a.GuestID != nil:
+		return solver.GuestID != nil && *solver.GuestID == *a.GuestID
+	default:
+		return false
+	}
+}
+
+// Submit записывает ответы и результат попытки от имени solver. assign - задание, в рамках
+// которого решали, nil - без задания. Попытка, начатая вне задания, попадает в его MaxAttempts
+// только сейчас, попыток не осталось - assignment.ErrNoAttemptsLeft. Попытка отправляется один раз,
+// повторно - ErrAttemptSubmitted.
+func (a *Attempt) Submit(solver Solver, assign *assignment.Assignment, answers []json.RawMessage, result *Result) error {
+	tx, err := DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Начатая в рамках задания попытка уже посчитана при начале
+	assignmentID := a.AssignmentID
+	if assignmentID == nil {
+		if assignmentID, err = reserveAttempt(tx, assign, solver); err != nil {
+			return err
+		}
+	}
+	if err := a.submit(tx, solver, assignmentID, answers, result); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (a *Attempt) submit(db sqlx.Queryer, solver Solver, assignmentID *int32, answers []json.RawMessage,
+	result *Result) error {
+	raw, err := json.Marshal(answers)
+	if err != nil {
+		return err
+	}
+	err = sqlx.Get(db, a, `
+		UPDATE attempt SET account_id=$1, guest_id=$2, display_name=$3, assignment_id=$4, submit_time=$5,
+			question_indexes=$6, answers=$7, scores=$8, score=$9, max_score=$10, penalty=$11
+		WHERE id=$12 AND submit_time IS NULL RETURNING *
+	`, solver.AccountID, solver.GuestID, solver.DisplayName, assignmentID, time.Now().UTC(),
+		pq.Int32Array(result.Indexes), types.JSONText(raw), pq.Float64Array(result.Scores), result.Score, result.MaxScore,
+		result.Penalty, a.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAttemptSubmitted
+	}
+	return err
+}
+
+// GetAttemptsByAccountID возвращает попытки аккаунта, новые первыми, страница page - с 0.
+func GetAttemptsByAccountID(accountID int32, page int64) ([]Attempt, error) {
+	attempts := []Attempt{}
+	err := DB.Select(&attempts, "SELECT * FROM attempt WHERE account_id=$1 ORDER BY start_time DESC, id DESC LIMIT $2 OFFSET $3",
+		accountID, AttemptsPerPage, page*AttemptsPerPage)
+	return attempts, err
+}
+
+// GetAttemptsByQwizID возвращает попытки викторины, новые первыми, страница page - с 0.
+func GetAttemptsByQwizID(qwizID int32, page int64) ([]Attempt, error) {
+	attempts := []Attempt{}
+	err := DB.Select(&attempts, "SELECT * FROM attempt WHERE qwiz_id=$1 ORDER BY start_time DESC, id DESC LIMIT $2 OFFSET $3",
+		qwizID, AttemptsPerPage, page*AttemptsPerPage)
+	return attempts, err
+}
+
+func GetAttempt(id int32) (*Attempt, error) {
+	attempt := &Attempt{}
+	err := DB.Get(attempt, "SELECT * FROM attempt WHERE id=$1", id)
+	return attempt, err
+}
+
+// arrange раскладывает вопросы так, как их видит участник попытки: в её порядке и с раскладкой
+// вариантов каждого вопроса. Без попытки вопросы идут по порядку, варианты - question.DefaultLayout.
+// Если вопросы или их варианты изменились с начала попытки - ErrAttemptOutdated.
+func (a *Attempt) arrange(questions []question.Question) ([]question.Question, []question.Layout, error) {
+	if a == nil {
+		layouts := make([]question.Layout, len(questions))
+		for i := range questions {
+			layouts[i] = questions[i].DefaultLayout()
+		}
+		return questions, layouts, nil
+	}
+	if !a.Layouts.Valid {
+		arranged, layouts := a.shuffle(questions)
+		return arranged, layouts, nil
+	}
+
+	var stored []storedLayout
+	if err := json.Unmarshal(a.Layouts.JSONText, &stored); err != nil {
+		return nil, nil, err
+	}
+	if len(a.QuestionIndexes) != len(questions) || len(stored) != len(questions) {
+		return nil, nil, ErrAttemptOutdated
+	}
+	byIndex := make(map[int32]question.Question, len(questions))
+	for _, q := range questions {
+		byIndex[q.Index] = q
+	}
+	arranged := make([]question.Question, len(questions))
+	layouts := make([]question.Layout, len(questions))
+	for i, index := range a.QuestionIndexes {
+		q, ok := byIndex[index]
+		if !ok {
+			return nil, nil, ErrAttemptOutdated
+		}
+		// Раскладка подходит вопросу, только если число вариантов и пар не изменилось
+		layout, current := stored[i].layout(), q.DefaultLayout()
+		if len(layout.Answers) != len(current.Answers) || len(layout.Matches) != len(current.Matches) {
+			return nil, nil, ErrAttemptOutdated
+		}
+		arranged[i], layouts[i] = q, layout
+	}
+	return arranged, layouts, nil
+}
+
+// shuffle выбирает раскладку попытки по Seed. Генератор расходуется в одном и том же порядке,
+// поэтому раскладка по Seed всегда одна.
+func (a *Attempt) shuffle(questions []question.Question) ([]question.Question, []question.Layout) {
+	arranged := append([]question.Question(nil), questions...)
+	layouts := make([]question.Layout, len(arranged))
+	rng := rand.New(rand.NewSource(a.Seed))
+	if a.ShuffleQuestions {
+		rng.Shuffle(len(arranged), func(i, j int) {
+			arranged[i], arranged[j] = arranged[j], arranged[i]
+		})
+	}
+	for i := range arranged {
+		if a.ShuffleAnswers {
+			layouts[i] = arranged[i].ShuffledLayout(rng)
+		} else {
+			layouts[i] = arranged[i].DefaultLayout()
+		}
+	}
+	return arranged, layouts
+}
+
+// Questions - вопросы попытки в её порядке с вариантами в её раскладке.
+func (a *Attempt) Questions() ([]question.GetQuestionData, error) {
+	questions, err := question.GetAllQuestionsByQwizID(a.QwizID)
+	if err != nil {
+		return nil, err
+	}
+	questions, layouts, err := a.arrange(questions)
+	if err != nil {
+		return nil, err
+	}
+	datas := make([]question.GetQuestionData, 0, len(questions))
+	for i := range questions {
+		data, err := question.GetQuestionDataInLayout(questions[i], layouts[i])
+		if err != nil {
+			return nil, err
+		}
+		datas = append(datas, *data)
+	}
+	return datas, nil
+}
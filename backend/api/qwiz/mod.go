@@ -1,8 +1,11 @@
 package qwiz
 
 import (
+	"api/assignment"
 	"api/media"
 	"api/question"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -14,15 +17,63 @@ type NewQwizData struct {
 	CreatorID int32               `json:"creator_id"`
 	Thumbnail *media.NewMediaData `json:"thumbnail,omitempty"`
 	Public    bool                `json:"public"`
+	// Перемешивать вопросы и варианты в каждой попытке, см. StartAttempt
+	ShuffleQuestions bool `json:"shuffle_questions"`
+	ShuffleAnswers   bool `json:"shuffle_answers"`
+	// Reveal - когда показывать верные ответы после решения, пустая строка - RevealNever
+	Reveal Reveal `json:"reveal"`
 }
 
 type Qwiz struct {
-	ID            int32     `db:"id"`
-	Name          string    `db:"name"`
-	CreatorID     int32     `db:"creator_id"`
-	ThumbnailUUID uuid.UUID `db:"thumbnail_uuid"`
-	Public        bool      `db:"public"`
-	CreateTime    time.Time `db:"create_time"`
+	ID               int32     `db:"id"`
+	Name             string    `db:"name"`
+	CreatorID        int32     `db:"creator_id"`
+	ThumbnailUUID    uuid.UUID `db:"thumbnail_uuid"`
+	Public           bool      `db:"public"`
+	CreateTime       time.Time `db:"create_time"`
+	ShuffleQuestions bool      `db:"shuffle_questions"`
+	ShuffleAnswers   bool      `db:"shuffle_answers"`
+	Reveal           Reveal    `db:"reveal"`
+}
+
+// Reveal - когда решившему викторину показываются верные ответы. Автор видит их всегда.
+type Reveal string
+
+const (
+	// RevealNever - только результаты, без верных ответов.
+	RevealNever Reveal = "never"
+	// RevealAfterSubmit - верные ответы сразу вместе с результатами.
+	RevealAfterSubmit Reveal = "after_submit"
+	// RevealAfterAssignmentClose - только при решении в рамках задания, срок которого истёк.
+	RevealAfterAssignmentClose Reveal = "after_assignment_close"
+)
+
+var ErrInvalidReveal = errors.New("invalid reveal")
+
+// ParseReveal принимает название политики, пустая строка - RevealNever.
+func ParseReveal(name string) (Reveal, error) {
+	switch Reveal(name) {
+	case "", RevealNever:
+		return RevealNever, nil
+	case RevealAfterSubmit, RevealAfterAssignmentClose:
+		return Reveal(name), nil
+	default:
+		return "", ErrInvalidReveal
+	}
+}
+
+// Reveals сообщает, показывать ли верные ответы при решении в рамках задания assign (nil - без задания)
+// в момент now. Задание закрыто, когда срок прошёл и работы после срока не принимаются: иначе
+// ответы можно было бы подсмотреть и отправить заново, потеряв только штраф за опоздание.
+func (r Reveal) Reveals(assign *assignment.Assignment, now time.Time) bool {
+	switch r {
+	case RevealAfterSubmit:
+		return true
+	case RevealAfterAssignmentClose:
+		return assign != nil && assign.CloseTime != nil && assign.CloseTime.Before(now) && assign.LatePenalty == nil
+	default:
+		return false
+	}
 }
 
 type Error struct {
@@ -58,9 +109,14 @@ func GetByID(id int32) (*Qwiz, error) {
 }
 
 func FromQwizData(data NewQwizData) (*Qwiz, error) {
+	reveal, err := ParseReveal(string(data.Reveal))
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if creator ID exists
 	var accountID int32
-	err := DB.Get(&accountID, "SELECT id FROM account WHERE id=$1", data.CreatorID)
+	err = DB.Get(&accountID, "SELECT id FROM account WHERE id=$1", data.CreatorID)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +131,9 @@ func FromQwizData(data NewQwizData) (*Qwiz, error) {
 	}
 
 	var qwiz Qwiz
-	err = DB.Get(&qwiz, "INSERT INTO qwiz (name, creator_id, thumbnail_uuid, public) VALUES ($1, $2, $3, $4) RETURNING *",
-		data.Name, data.CreatorID, thumbnailUUID, data.Public)
+	err = DB.Get(&qwiz, `INSERT INTO qwiz (name, creator_id, thumbnail_uuid, public, shuffle_questions, shuffle_answers, reveal)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+		data.Name, data.CreatorID, thumbnailUUID, data.Public, data.ShuffleQuestions, data.ShuffleAnswers, reveal)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +151,18 @@ func (qwiz *Qwiz) UpdateName(newName string) error {
 	return err
 }
 
+// UpdateShuffle включает или выключает перемешивание вопросов и вариантов, nil оставляет как есть.
+// Уже начатые попытки сохраняют свои настройки.
+func (qwiz *Qwiz) UpdateShuffle(shuffleQuestions, shuffleAnswers *bool) error {
+	return DB.QueryRow(`UPDATE qwiz SET shuffle_questions=COALESCE($1, shuffle_questions),
+		shuffle_answers=COALESCE($2, shuffle_answers) WHERE id=$3 RETURNING shuffle_questions, shuffle_answers`,
+		shuffleQuestions, shuffleAnswers, qwiz.ID).Scan(&qwiz.ShuffleQuestions, &qwiz.ShuffleAnswers)
+}
+
+func (qwiz *Qwiz) UpdateReveal(reveal Reveal) error {
+	return DB.QueryRow("UPDATE qwiz SET reveal=$1 WHERE id=$2 RETURNING reveal", reveal, qwiz.ID).Scan(&qwiz.Reveal)
+}
+
 // UpdateThumbnail updates or sets a new thumbnail for the Qwiz.
 func (qwiz *Qwiz) UpdateThumbnail(newThumbnail media.NewMediaData) error {
 	if qwiz.ThumbnailUUID != uuid.Nil {
@@ -171,10 +240,41 @@ func GetRecent(days uint16, page int64) ([]GetShortQwizData, error) {
 	return qwizzes, nil
 }
 
-// Solve checks if the provided answers are correct for a qwiz.
-func Solve(qwizID int32, answers []uint8) ([]bool, error) {
-	var questions []question.Question
-	err := DB.Select(&questions, "SELECT correct FROM question WHERE qwiz_id=$1 ORDER BY index", qwizID)
+// Result - итог решения викторины. Scores - доля верного ответа по вопросам от 0 до 1,
+// Score - сумма баллов с учётом весов вопросов, MaxScore - сумма весов. Solutions - верные ответы
+// в том же порядке и нумерации, что и ответы; показывать ли их, решает Reveal. Indexes - индексы
+// вопросов в порядке ответов. Penalty - доля баллов, снятая за опоздание, уже учтена в Score.
+type Result struct {
+	Indexes   []int32
+	Scores    []float64
+	Score     float64
+	MaxScore  float64
+	Penalty   float64
+	Solutions []question.Solution
+}
+
+// ApplyPenalty снимает долю penalty баллов за отправку после срока задания.
+func (r *Result) ApplyPenalty(penalty float64) {
+	r.Penalty = penalty
+	r.Score *= 1 - penalty
+}
+
+// Passed сообщает, набрана ли доля threshold от максимума. Небольшой запас нужен на погрешность
+// сложения float: 0.7 от 10 баллов за 7 вопросов по 1 должно засчитываться.
+func (r *Result) Passed(threshold float64) bool {
+	return r.Score >= threshold*r.MaxScore-1e-9
+}
+
+// Solve grades the provided answers for a qwiz.
+// Each answer is raw JSON in the format of its question type, see question.Question.ParseResponseIn.
+// With an attempt the answers follow its question order and answer numbering, and so do the results.
+// If the questions changed since the attempt started, the error is ErrAttemptOutdated.
+func Solve(qwizID int32, answers []json.RawMessage, attempt *Attempt) (*Result, error) {
+	questions, err := question.GetAllQuestionsByQwizID(qwizID)
+	if err != nil {
+		return nil, err
+	}
+	questions, layouts, err := attempt.arrange(questions)
 	if err != nil {
 		return nil, err
 	}
@@ -185,14 +285,19 @@ func Solve(qwizID int32, answers []uint8) ([]bool, error) {
 		return nil, fmt.Errorf("not enough answers")
 	}
 
-	results := make([]bool, len(answers))
+	result := &Result{Scores: make([]float64, len(answers)), Solutions: make([]question.Solution, len(answers))}
 	for i, answer := range answers {
-		// Проверяем, что ответ входит в допустимый диапазон значений.
-		if answer < 1 || answer > 4 {
-			return nil, fmt.Errorf("answer %d is out of range", answer)
+		// Проверяем, что ответ подходит типу вопроса и вариант существует.
+		response, err := questions[i].ParseResponseIn(answer, layouts[i])
+		if err != nil {
+			return nil, fmt.Errorf("answer %d: %w", i+1, err)
 		}
-		results[i] = answer == uint8(questions[i].Correct)
+		result.Indexes = append(result.Indexes, questions[i].Index)
+		result.Scores[i] = questions[i].Score(response)
+		result.Solutions[i] = questions[i].SolutionIn(layouts[i])
+		result.Score += questions[i].Points(response)
+		result.MaxScore += questions[i].Weight
 	}
 
-	return results, nil
+	return result, nil
 }
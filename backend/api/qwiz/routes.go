@@ -3,15 +3,19 @@ package qwiz
 import (
 	"api/account"
 	"api/assignment"
+	"api/authz"
 	"api/config"
+	"api/guest"
 	"api/media"
 	"api/question"
 	"api/utils"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -21,26 +25,29 @@ import (
 func qwizInfo(c *gin.Context) {
 	c.String(http.StatusOK, `
 GET /qwiz/<id> - get qwiz data by id
+Authorization: Bearer <access_token> - optional, the creator gets questions with their solutions (see GET /question)
 
 GET /qwiz/best?<page>&<search> - get 50 best qwizes by name, rated by votes
 
 GET /qwiz/recent?<page> - get 50 best qwizes created in the last 2 weeks, rated by votes
 
 POST /qwiz - create a qwiz
-creator_password: String - required
+Authorization: Bearer <access_token> - required
 qwiz: {
 	name: String - required
-	creator_id: i32 - required
+	creator_id: i32 - optional (must match the caller)
 	thumbnail_uri: String - optional
 	public: bool - optional
+	shuffle_questions: bool - optional, shuffle questions in every attempt
+	shuffle_answers: bool - optional, shuffle answers in every attempt
+	reveal: never/after_submit/after_assignment_close - optional, never by default, when solve returns the solutions
 } - required
 questions: Vector of {
+	type: single/multiple/true_false/text/numeric/ordering/matching - optional, single by default (see GET /question),
 	body: String - required,
-	answer1: String - required,
-	answer2: String - required,
-	answer3: String - optional,
-	answer4: String - optional,
-	correct: 1/2/3/4 - required,
+	answers: Vector of { body, correct, embed, match } - required for choice, ordering and matching (see POST /question),
+	answer1..answer4, correct, correct_answers - deprecated, instead of answers,
+	accepted_answers / numeric_answer, tolerance - the answer of text and numeric,
 	embed: {
 		data: String - required
 		media_type: MediaType - required
@@ -48,16 +55,58 @@ questions: Vector of {
 } - required
 
 PATCH /qwiz/<id> - update qwiz data
-creator_password: String - required
+Authorization: Bearer <access_token> - required
 new_name: String - optional
 new_thumbnail: String - optional
+new_shuffle_questions: bool - optional
+new_shuffle_answers: bool - optional
+new_reveal: never/after_submit/after_assignment_close - optional
 
 DELETE /qwiz/<id> - delete qwiz
-creator_password: String - required
-
-POST /qwiz/<id>/solve - solve qwiz
+Authorization: Bearer <access_token> - required
+
+POST /qwiz/<id>/attempt?<assignment_id> - start solving a qwiz
+Authorization: Bearer <access_token> - required without guest_token, then only this account can submit the attempt
+guest_token: String - optional, start as a guest of this qwiz (not with assignment_id), then only this guest can submit it
+Returns: { id: Integer, start_time, deadline: Integer - null without a time limit, questions: [Question] } - questions
+	and their answers in the order of this attempt, shuffled if the qwiz shuffles them; pass id as attempt_id to solve
+With assignment_id the attempt counts towards max_attempts of the assignment and its duration starts,
+	403 if the assignment is not open, closed without late submissions or no attempts are left
+
+GET /qwiz/attempts?<page> - attempts of the caller, newest first, 50 per page from 0
+Authorization: Bearer <access_token> - required
+Returns: Vector of Attempt {
+	id, qwiz_id, account_id, guest_id, display_name, assignment_id, start_time, submit_time - null until submitted,
+	question_indexes: [Integer] - questions in the order of the attempt, answers: [answer as sent to solve],
+	scores: [Float], score: Float - with the late penalty, max_score: Float, penalty: Float
+}
 
-answers: Vec<1/2/3/4> - required
+GET /qwiz/<id>/attempts?<page> - attempts on a qwiz, newest first, 50 per page from 0
+Authorization: Bearer <access_token> - required, the creator of the qwiz
+Returns: Vector of Attempt
+
+POST /qwiz/<id>/solve?<assignment_id> - solve qwiz
+Authorization: Bearer <access_token> - required with assignment_id
+answers: Vector - required, one per question: 1/2/3/4 (single), Vector of 1/2/3/4 (multiple), true/false (true_false), String (text), Float (numeric),
+	Vector of answer numbers in the chosen order (ordering), Vector of match numbers, one per answer (matching) - numbers as listed by GET /question
+Returns: { correct, total, results: [Boolean] - fully correct, scores: [Float] - from 0 to 1, partial for ordering and matching,
+	score: Float - points with question weights, max_score: Float, passed: Boolean - score reaches the pass threshold
+	of the assignment (all points without assignment_id), assignment_complete - recorded when passed, guest,
+	solutions: [Solution] - correct answers (see GET /question) in the numbering of the answers, returned to the creator
+	and as the qwiz reveal allows: always (after_submit) or with the assignment_id of an assignment past close_time
+	that takes no late submissions (after_assignment_close),
+	penalty: Float - share of points taken off score for a late submission,
+	assignment_score: Float - share of points of the assignment by its score_policy over all submitted attempts }
+With assignment_id (or an attempt started with it) the submission is rejected with 403 if the assignment is not open,
+	closed without late submissions, out of attempts or the attempt duration is over; timed assignments require an attempt
+guest_token: String - optional, solve as a guest of this qwiz (not with assignment_id)
+attempt_id: Integer - optional (required if the qwiz shuffles questions or answers), answers (and results) follow
+	the question order and answer numbers of the attempt, an attempt is submitted once; every solve is saved as an attempt,
+	its id is returned in attempt_id; 409 if questions or answers were added or removed since the attempt started
+
+POST /qwiz/<id>/guest - solve a public qwiz without an account, returns a guest token for solve
+nickname: String - required, up to 30 characters, offensive nicknames are rejected
+Returns: { id: Integer (negative, never equal to an account id), nickname, guest_token, expire_time }
 `)
 }
 
@@ -70,10 +119,15 @@ type GetFullQwizData struct {
 	Questions  []question.GetQuestionData `json:"questions"`
 	Public     bool                       `json:"public"`
 	CreateTime int64                      `json:"create_time"`
+	// Перемешиваются ли вопросы и варианты в попытках
+	ShuffleQuestions bool   `json:"shuffle_questions"`
+	ShuffleAnswers   bool   `json:"shuffle_answers"`
+	Reveal           Reveal `json:"reveal"`
 }
 
 // NewGetFullQwizData creates a new GetFullQwizData instance from a Qwiz struct.
-func NewGetFullQwizData(qwiz Qwiz) (*GetFullQwizData, error) {
+// The author view includes the solution of every question.
+func NewGetFullQwizData(qwiz Qwiz, author bool) (*GetFullQwizData, error) {
 	questions, err := question.GetAllQuestionsByQwizID(qwiz.ID)
 	if err != nil {
 		log.Printf("Error fetching questions for quiz ID %d: %v", qwiz.ID, err)
@@ -82,7 +136,12 @@ func NewGetFullQwizData(qwiz Qwiz) (*GetFullQwizData, error) {
 
 	var getQuestionsData []question.GetQuestionData
 	for _, quest := range questions {
-		getQuestionData, err := question.GetQuestionDataFromQuestion(quest)
+		var getQuestionData *question.GetQuestionData
+		if author {
+			getQuestionData, err = question.GetAuthorQuestionData(quest)
+		} else {
+			getQuestionData, err = question.GetQuestionDataFromQuestion(quest)
+		}
 		if err != nil {
 			log.Printf("Error fetching question data for question: %v", err)
 			return nil, err // Handle error appropriately
@@ -112,6 +171,10 @@ func NewGetFullQwizData(qwiz Qwiz) (*GetFullQwizData, error) {
 		Questions:  getQuestionsData,
 		Public:     qwiz.Public,
 		CreateTime: qwiz.CreateTime.UnixNano() / int64(time.Millisecond),
+
+		ShuffleQuestions: qwiz.ShuffleQuestions,
+		ShuffleAnswers:   qwiz.ShuffleAnswers,
+		Reveal:           qwiz.Reveal,
 	}, nil
 }
 
@@ -134,8 +197,16 @@ func getQwizByID(c *gin.Context) {
 		return
 	}
 
+	// Верные ответы видит только автор
+	author, err := authz.Allowed(c, authz.CreatorOwnsQwiz(authz.Param("id")))
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not retrieve quiz"})
+		return
+	}
+
 	// Assuming fromQwiz is a function that converts a Qwiz to GetFullQwizData
-	qwizData, err := NewGetFullQwizData(*qwiz)
+	qwizData, err := NewGetFullQwizData(*qwiz, author)
 	if err != nil {
 		// Log the error, then return a 500 internal server error to the client
 		utils.InternalErr(err)
@@ -220,9 +291,8 @@ func getRecent(c *gin.Context) {
 }
 
 type PostQwizData struct {
-	CreatorPassword string                     `json:"creator_password"`
-	Qwiz            NewQwizData                `json:"qwiz"`
-	Questions       []question.NewQuestionData `json:"questions"`
+	Qwiz      NewQwizData                `json:"qwiz"`
+	Questions []question.NewQuestionData `json:"questions"`
 }
 
 func createQwiz(c *gin.Context) {
@@ -232,29 +302,12 @@ func createQwiz(c *gin.Context) {
 		return
 	}
 
-	log.Println("Before getting account by ID")
-	acct, err := account.GetByID(qwizData.Qwiz.CreatorID)
-	if err != nil {
-		log.Printf("Error getting account by ID: %v", err)
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-	if acct == nil {
-		log.Printf("Account object is nil")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Account not found"})
-		return
-	}
-
-	log.Println("Before verifying password")
-	passwordIsValid, err := acct.VerifyPassword(qwizData.CreatorPassword)
-	if err != nil {
-		log.Printf("Error verifying password: %v", err)
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "An error occurred while verifying the password"})
-		return
-	}
-	if !passwordIsValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+	// Создать викторину от имени другого аккаунта может только администратор
+	acct := account.Current(c)
+	if qwizData.Qwiz.CreatorID == 0 {
+		qwizData.Qwiz.CreatorID = acct.ID
+	} else if qwizData.Qwiz.CreatorID != acct.ID && !acct.IsAdmin() {
+		authz.Forbidden(c)
 		return
 	}
 
@@ -291,9 +344,12 @@ func createQwiz(c *gin.Context) {
 
 // PatchQwizData Define the struct for patching quiz data
 type PatchQwizData struct {
-	CreatorPassword string              `json:"creator_password"`
-	NewName         *string             `json:"new_name"`
-	NewThumbnail    *media.NewMediaData `json:"new_thumbnail"`
+	NewName      *string             `json:"new_name"`
+	NewThumbnail *media.NewMediaData `json:"new_thumbnail"`
+
+	NewShuffleQuestions *bool   `json:"new_shuffle_questions"`
+	NewShuffleAnswers   *bool   `json:"new_shuffle_answers"`
+	NewReveal           *string `json:"new_reveal"`
 }
 
 // Patch handler to update a quiz
@@ -317,23 +373,12 @@ func updateQwiz(c *gin.Context) {
 		return
 	}
 
-	acct, err := account.GetByID(qwiz.CreatorID)
-	if err != nil {
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	}
-
-	passwordIsValid, err := acct.VerifyPassword(newQwizData.CreatorPassword)
-	if err != nil {
-		// Handle the error according to its type or log it
-		utils.InternalErr(err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "An error occurred while verifying the password"})
-		return
-	}
-	if !passwordIsValid {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
+	var reveal Reveal
+	if newQwizData.NewReveal != nil {
+		if reveal, err = ParseReveal(*newQwizData.NewReveal); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 	}
 
 	if newQwizData.NewName != nil {
@@ -368,22 +413,27 @@ func updateQwiz(c *gin.Context) {
 		}
 	}
 
-	c.Status(http.StatusOK)
-}
+	if newQwizData.NewShuffleQuestions != nil || newQwizData.NewShuffleAnswers != nil {
+		if err := qwiz.UpdateShuffle(newQwizData.NewShuffleQuestions, newQwizData.NewShuffleAnswers); err != nil {
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+			return
+		}
+	}
 
-// DeleteQwizData represents the expected JSON payload
-type DeleteQwizData struct {
-	CreatorPassword string `json:"creator_password"`
+	if newQwizData.NewReveal != nil {
+		if err := qwiz.UpdateReveal(reveal); err != nil {
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+			return
+		}
+	}
+
+	c.Status(http.StatusOK)
 }
 
 // deleteQwizHandler handles the DELETE request to remove a quiz
 func deleteQwizHandler(c *gin.Context) {
-	var data DeleteQwizData
-	if err := c.BindJSON(&data); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
-		return
-	}
-
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid qwiz ID"})
@@ -401,43 +451,362 @@ func deleteQwizHandler(c *gin.Context) {
 		return
 	}
 
-	acct, err := account.GetByID(qwiz.CreatorID)
+	if err := qwiz.Delete(); err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// PostSolveQwizData Structs to bind and render data
+type PostSolveQwizData struct {
+	// Answers - ответ на каждый вопрос в формате его типа: номер варианта, массив номеров, true/false, строка или число
+	Answers    []json.RawMessage `json:"answers"`
+	GuestToken string            `json:"guest_token"`
+	AttemptID  *int32            `json:"attempt_id"`
+}
+
+type SolveQwizData struct {
+	Correct  uint32    `json:"correct"`
+	Total    uint32    `json:"total"`
+	Results  []bool    `json:"results"`
+	Scores   []float64 `json:"scores"`
+	Score    float64   `json:"score"`
+	MaxScore float64   `json:"max_score"`
+	Passed   bool      `json:"passed"`
+	// Penalty - доля баллов, снятая с Score за отправку после срока задания
+	Penalty float64 `json:"penalty,omitempty"`
+	// AssignmentScore - результат задания по его политике подсчёта, доля от максимума
+	AssignmentScore    *float64            `json:"assignment_score,omitempty"`
+	AssignmentComplete *bool               `json:"assignment_complete"`
+	Guest              *guest.GetGuestData `json:"guest,omitempty"`
+	// Solutions - верные ответы, если их разрешает показать Reveal викторины
+	Solutions []question.Solution `json:"solutions,omitempty"`
+	// AttemptID - попытка, под которой решение записано в историю
+	AttemptID int32 `json:"attempt_id"`
+}
+
+// recordSolution записывает решение в историю: в начатую попытку или в новую, если её не было.
+// assign - задание, в рамках которого решали, nil - без задания.
+func recordSolution(qwizID int32, attempt *Attempt, solver Solver, assign *assignment.Assignment, answers []json.RawMessage,
+	result *Result) (*Attempt, error) {
+	if attempt == nil {
+		return SubmitWithoutAttempt(qwizID, solver, assign, answers, result)
+	}
+	return attempt, attempt.Submit(solver, assign, answers, result)
+}
+
+// loadGuest находит гостя викторины qwizID по токену. При ошибке ответ уже записан.
+func loadGuest(c *gin.Context, qwizID int32, token string) (*guest.Guest, bool) {
+	g, err := guest.GetByToken(token, guest.QwizScope(qwizID))
+	if errors.Is(err, guest.ErrInvalidToken) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid guest token"})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		utils.InternalErr(err)
+		return nil, false
+	}
+	return g, true
+}
+
+// loadAssignment находит задание id, в рамках которого решают викторину qwizID: оно должно быть
+// выдано классу вызывающего и относиться к этой викторине. При отказе ответ уже записан.
+func loadAssignment(c *gin.Context, qwizID, id int32) (*assignment.Assignment, bool) {
+	// Не authz.Check: администратор проходит любые политики, а выполнение задания
+	// записывается только ученику класса
+	subject, ok := authz.CurrentSubject(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return nil, false
+	}
+	source := func(*gin.Context) (int32, error) { return id, nil }
+	inClass, err := authz.StudentInAssignmentClass(source)(c, subject)
 	if err != nil {
 		utils.InternalErr(err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return nil, false
+	}
+	if !inClass {
+		authz.Forbidden(c)
+		return nil, false
+	}
+	assign, err := assignment.GetByID(int(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Assignment not found"})
+		return nil, false
+	}
+	if int32(assign.QwizID) != qwizID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Assignment of another qwiz"})
+		return nil, false
+	}
+	return assign, true
+}
+
+func respondAssignmentErr(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, assignment.ErrNotOpen), errors.Is(err, assignment.ErrClosed),
+		errors.Is(err, assignment.ErrNoAttemptsLeft), errors.Is(err, assignment.ErrTimeOver):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
+
+func respondRecordErr(c *gin.Context, err error) {
+	if errors.Is(err, ErrAttemptSubmitted) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
 	}
+	respondAssignmentErr(c, err)
+}
 
-	if ok, err := acct.VerifyPassword(data.CreatorPassword); !ok || err != nil {
-		if err != nil {
-			utils.InternalErr(err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		} else {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		}
+// revealedSolutions - верные ответы для ответа на решение: автору всегда, остальным - по Reveal
+// викторины. assign - задание, в рамках которого решали, nil - без задания.
+func revealedSolutions(c *gin.Context, qwiz *Qwiz, result *Result, assign *assignment.Assignment) ([]question.Solution, error) {
+	author, err := authz.Allowed(c, authz.CreatorOwnsQwiz(authz.Param("id")))
+	if err != nil {
+		return nil, err
+	}
+	if author || qwiz.Reveal.Reveals(assign, time.Now()) {
+		return result.Solutions, nil
+	}
+	return nil, nil
+}
+
+// GetAttemptRecordData - попытка в истории. GuestID - id гостя, как в guest.GetGuestData.
+type GetAttemptRecordData struct {
+	ID              int32           `json:"id"`
+	QwizID          int32           `json:"qwiz_id"`
+	AccountID       *int32          `json:"account_id"`
+	GuestID         *int32          `json:"guest_id"`
+	DisplayName     *string         `json:"display_name"`
+	AssignmentID    *int32          `json:"assignment_id"`
+	StartTime       int64           `json:"start_time"`
+	SubmitTime      *int64          `json:"submit_time"`
+	QuestionIndexes []int32         `json:"question_indexes"`
+	Answers         json.RawMessage `json:"answers"`
+	Scores          []float64       `json:"scores"`
+	Score           *float64        `json:"score"`
+	MaxScore        *float64        `json:"max_score"`
+	Penalty         float64         `json:"penalty"`
+}
+
+func NewGetAttemptRecordData(attempt *Attempt) GetAttemptRecordData {
+	data := GetAttemptRecordData{
+		ID:              attempt.ID,
+		QwizID:          attempt.QwizID,
+		AccountID:       attempt.AccountID,
+		DisplayName:     attempt.DisplayName,
+		AssignmentID:    attempt.AssignmentID,
+		StartTime:       attempt.StartTime.UnixMilli(),
+		QuestionIndexes: attempt.QuestionIndexes,
+		Scores:          attempt.Scores,
+		Score:           attempt.Score,
+		MaxScore:        attempt.MaxScore,
+		Penalty:         attempt.Penalty,
+	}
+	if attempt.GuestID != nil {
+		participantID := (&guest.Guest{ID: *attempt.GuestID}).ParticipantID()
+		data.GuestID = &participantID
+	}
+	if attempt.SubmitTime != nil {
+		submitTime := attempt.SubmitTime.UnixMilli()
+		data.SubmitTime = &submitTime
+	}
+	if attempt.Answers.Valid {
+		data.Answers = json.RawMessage(attempt.Answers.JSONText)
+	}
+	return data
+}
+
+func attemptRecordDatas(attempts []Attempt) []GetAttemptRecordData {
+	datas := make([]GetAttemptRecordData, len(attempts))
+	for i := range attempts {
+		datas[i] = NewGetAttemptRecordData(&attempts[i])
+	}
+	return datas
+}
+
+// getOwnAttempts - попытки вызывающего.
+func getOwnAttempts(c *gin.Context) {
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "0"), 10, 32)
+	if err != nil || page < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
 		return
 	}
 
-	if err := qwiz.Delete(); err != nil {
+	attempts, err := GetAttemptsByAccountID(account.Current(c).ID, page)
+	if err != nil {
 		utils.InternalErr(err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		return
 	}
+	c.JSON(http.StatusOK, attemptRecordDatas(attempts))
+}
 
-	c.Status(http.StatusOK)
+// getAccountAttempts - попытки аккаунта из пути: для него самого и его родителя.
+func getAccountAttempts(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("accountParam"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "0"), 10, 32)
+	if err != nil || page < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	attempts, err := GetAttemptsByAccountID(int32(id), page)
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+	c.JSON(http.StatusOK, attemptRecordDatas(attempts))
 }
 
-// PostSolveQwizData Structs to bind and render data
-type PostSolveQwizData struct {
-	Answers  []uint8 `json:"answers"`
-	Username *string `json:"username"`
+// getQwizAttempts - попытки викторины для её автора.
+func getQwizAttempts(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid qwiz ID"})
+		return
+	}
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "0"), 10, 32)
+	if err != nil || page < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	attempts, err := GetAttemptsByQwizID(int32(id), page)
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+	c.JSON(http.StatusOK, attemptRecordDatas(attempts))
 }
 
-type SolveQwizData struct {
-	Correct            uint32 `json:"correct"`
-	Total              uint32 `json:"total"`
-	Results            []bool `json:"results"`
-	AssignmentComplete *bool  `json:"assignment_complete"`
+type PostAttemptData struct {
+	GuestToken string `json:"guest_token"`
+}
+
+type GetAttemptData struct {
+	ID        int32                      `json:"id"`
+	StartTime int64                      `json:"start_time"`
+	Deadline  *int64                     `json:"deadline"`
+	Questions []question.GetQuestionData `json:"questions"`
+}
+
+// startAttempt начинает попытку: вопросы возвращаются в её порядке, решение отправляется с attempt_id.
+func startAttempt(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid qwiz ID"})
+		return
+	}
+	qwiz, err := GetByID(int32(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Qwiz not found"})
+		return
+	}
+
+	var data PostAttemptData
+	if err := c.ShouldBindJSON(&data); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Попытку начинает аккаунт или гость: анонимную отправил бы кто угодно
+	var solver Solver
+	if acct := account.Current(c); acct != nil {
+		solver.AccountID = &acct.ID
+	}
+	if data.GuestToken != "" {
+		if c.Query("assignment_id") != "" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Guests can not complete assignments"})
+			return
+		}
+		g, ok := loadGuest(c, qwiz.ID, data.GuestToken)
+		if !ok {
+			return
+		}
+		solver = Solver{GuestID: &g.ID, DisplayName: &g.Nickname}
+	}
+	if solver.AccountID == nil && solver.GuestID == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Попытка в рамках задания считается в его лимит, время на неё идёт с этого момента
+	var assign *assignment.Assignment
+	if c.Query("assignment_id") != "" {
+		parsed, err := strconv.ParseInt(c.Query("assignment_id"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+			return
+		}
+		var ok bool
+		if assign, ok = loadAssignment(c, qwiz.ID, int32(parsed)); !ok {
+			return
+		}
+		if err := assign.CheckStart(*solver.AccountID, time.Now().UTC()); err != nil {
+			respondAssignmentErr(c, err)
+			return
+		}
+	}
+
+	attempt, err := StartAttempt(qwiz, solver, assign)
+	if err != nil {
+		respondAssignmentErr(c, err)
+		return
+	}
+	questions, err := attempt.Questions()
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+
+	var deadline *int64
+	if assign != nil {
+		if t := assign.Deadline(attempt.StartTime); t != nil {
+			ms := t.UnixMilli()
+			deadline = &ms
+		}
+	}
+
+	c.JSON(http.StatusCreated, GetAttemptData{
+		ID:        attempt.ID,
+		StartTime: attempt.StartTime.UnixMilli(),
+		Deadline:  deadline,
+		Questions: questions,
+	})
+}
+
+// joinAsGuest выдаёт гостевой токен для решения публичной викторины без аккаунта.
+func joinAsGuest(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid qwiz ID"})
+		return
+	}
+	qwiz, err := GetByID(int32(id))
+	if err != nil {
+		c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Qwiz not found"})
+		return
+	}
+	if !qwiz.Public {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		return
+	}
+	guest.Create(c, guest.QwizScope(qwiz.ID))
 }
 
 // solveQwiz handler function
@@ -458,16 +827,116 @@ func solveQwiz(c *gin.Context) {
 		return
 	}
 
-	_, err = GetByID(int32(qwizID))
+	qwiz, err := GetByID(int32(qwizID))
 	if err != nil {
 		utils.DbErrToStatus(err, http.StatusNotFound)
 		c.JSON(http.StatusNotFound, gin.H{"error": "Qwiz not found"})
 		return
 	}
 
-	results, err := Solve(int32(qwizID), solveQwizData.Answers)
+	// Решение записывается в историю от имени аккаунта или гостя
+	var solver Solver
+	if acct := account.Current(c); acct != nil {
+		solver = Solver{AccountID: &acct.ID, DisplayName: &acct.Username}
+	}
+
+	// Гость решает только ту викторину, для которой получен токен, и без заданий
+	var guestData *guest.GetGuestData
+	if solveQwizData.GuestToken != "" {
+		if assignmentID != "" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Guests can not complete assignments"})
+			return
+		}
+		g, ok := loadGuest(c, int32(qwizID), solveQwizData.GuestToken)
+		if !ok {
+			return
+		}
+		data := guest.FromGuest(g)
+		guestData = &data
+		solver = Solver{GuestID: &g.ID, DisplayName: &g.Nickname}
+	}
+
+	var attempt *Attempt
+	if solveQwizData.AttemptID != nil {
+		attempt, err = GetAttempt(*solveQwizData.AttemptID)
+		if err != nil {
+			c.JSON(utils.DbErrToStatus(err, http.StatusNotFound), gin.H{"error": "Attempt not found"})
+			return
+		}
+		if attempt.QwizID != int32(qwizID) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Attempt of another qwiz"})
+			return
+		}
+		if !attempt.BelongsTo(solver) {
+			authz.Forbidden(c)
+			return
+		}
+		if attempt.SubmitTime != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": ErrAttemptSubmitted.Error()})
+			return
+		}
+	}
+	// Без попытки варианты оценивались бы по порядку, а не в той раскладке, что видел участник
+	if attempt == nil && (qwiz.ShuffleQuestions || qwiz.ShuffleAnswers) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Attempt required"})
+		return
+	}
+
+	// Задание - из assignment_id или из попытки, начатой в его рамках. Сроки, число попыток
+	// и время на попытку проверяются до оценки
+	var assign *assignment.Assignment
+	var penalty float64
+	if assignmentID != "" || (attempt != nil && attempt.AssignmentID != nil) {
+		var id int32
+		if assignmentID != "" {
+			parsed, err := strconv.ParseInt(assignmentID, 10, 32)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+				return
+			}
+			id = int32(parsed)
+		}
+		if attempt != nil && attempt.AssignmentID != nil {
+			if assignmentID != "" && id != *attempt.AssignmentID {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Attempt of another assignment"})
+				return
+			}
+			id = *attempt.AssignmentID
+		}
+
+		var ok bool
+		if assign, ok = loadAssignment(c, int32(qwizID), id); !ok {
+			return
+		}
+		now := time.Now().UTC()
+		startTime := now
+		if attempt != nil && attempt.AssignmentID != nil {
+			startTime = attempt.StartTime
+		} else {
+			// Попытка не начата в рамках задания: время на неё не отсчитать, а в число попыток она
+			// ещё не попала
+			if assign.Duration != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Timed assignment requires an attempt started with assignment_id"})
+				return
+			}
+			if err := assign.CheckStart(account.Current(c).ID, now); err != nil {
+				respondAssignmentErr(c, err)
+				return
+			}
+		}
+		if penalty, err = assign.SubmitPenalty(startTime, now); err != nil {
+			respondAssignmentErr(c, err)
+			return
+		}
+	}
+
+	result, err := Solve(int32(qwizID), solveQwizData.Answers, attempt)
+	if errors.Is(err, ErrAttemptOutdated) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
-		if err.Error() == "too many answers" || err.Error() == "not enough answers" {
+		if err.Error() == "too many answers" || err.Error() == "not enough answers" || errors.Is(err, question.ErrInvalidResponse) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		} else {
 			// Handle other errors, possibly internal ones.
@@ -476,57 +945,82 @@ func solveQwiz(c *gin.Context) {
 		utils.InternalErr(err)
 		return
 	}
+	result.ApplyPenalty(penalty)
+	results := make([]bool, len(result.Scores))
+	for i, score := range result.Scores {
+		results[i] = score == 1
+	}
 
-	// Check if the assignment ID was provided along with a username
-	if assignmentID != "" && solveQwizData.Username != nil {
-		student, err := account.GetByUsername(*solveQwizData.Username)
+	// Assignment completion is recorded for the authenticated student only
+	if assign != nil {
+		student := account.Current(c)
+
+		solutions, err := revealedSolutions(c, qwiz, result, assign)
 		if err != nil {
-			utils.DbErrToStatus(err, http.StatusNotFound)
-			c.JSON(http.StatusNotFound, gin.H{"error": "Student not found"})
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 			return
 		}
 
-		// Convert assignmentID from string to int
-		assignID, err := strconv.Atoi(assignmentID)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+		if attempt, err = recordSolution(int32(qwizID), attempt, solver, assign, solveQwizData.Answers, result); err != nil {
+			respondRecordErr(c, err)
 			return
 		}
 
-		// Now you can use assignID as an int
-		assign, err := assignment.GetByID(assignID)
+		// Задание выполнено, если результат по ScorePolicy задания набирает порог
+		assignmentScore, err := assign.StudentScore(student.ID)
 		if err != nil {
-			utils.DbErrToStatus(err, http.StatusNotFound)
-			// Handle error, assignment not found or other errors
-			c.JSON(http.StatusNotFound, gin.H{"error": "Assignment not found"})
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 			return
 		}
-
-		solved := utils.AllTrue(results) // A function to check if all values in the results slice are true
-
-		if solved {
-			if _, err := assign.CompleteByStudentID(int(student.ID)); err != nil {
-				utils.InternalErr(err)
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark assignment as complete"})
-				return
-			}
+		solved := assignmentScore != nil && assign.Passed(*assignmentScore)
+		if err := assign.SetCompleted(student.ID, solved); err != nil {
+			utils.InternalErr(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark assignment as complete"})
+			return
 		}
 
 		c.JSON(http.StatusOK, SolveQwizData{
 			Correct:            uint32(utils.CountCorrect(results)), // A function to count true values in the results slice
 			Total:              uint32(len(results)),
 			Results:            results,
+			Scores:             result.Scores,
+			Score:              result.Score,
+			MaxScore:           result.MaxScore,
+			Penalty:            result.Penalty,
+			Passed:             result.Passed(assign.PassThreshold),
+			AssignmentScore:    assignmentScore,
 			AssignmentComplete: &solved,
+			Solutions:          solutions,
+			AttemptID:          attempt.ID,
 		})
 		return
 	}
 
-	// If no assignment_id is provided, or there's no username with a provided assignment_id
+	// If no assignment_id is provided
+	solutions, err := revealedSolutions(c, qwiz, result, nil)
+	if err != nil {
+		utils.InternalErr(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		return
+	}
+	if attempt, err = recordSolution(int32(qwizID), attempt, solver, nil, solveQwizData.Answers, result); err != nil {
+		respondRecordErr(c, err)
+		return
+	}
 	c.JSON(http.StatusOK, SolveQwizData{
 		Correct:            uint32(utils.CountCorrect(results)),
 		Total:              uint32(len(results)),
 		Results:            results,
+		Scores:             result.Scores,
+		Score:              result.Score,
+		MaxScore:           result.MaxScore,
+		Passed:             result.Passed(assignment.DefaultPassThreshold),
 		AssignmentComplete: nil,
+		Guest:              guestData,
+		Solutions:          solutions,
+		AttemptID:          attempt.ID,
 	})
 }
 
@@ -535,13 +1029,24 @@ func RegisterRoutes(r *gin.Engine) {
 	qwizGroup := r.Group(config.BaseURL + "/qwiz")
 	{
 		qwizGroup.GET("", qwizInfo)
-		qwizGroup.GET("/:id", getQwizByID)
-		qwizGroup.POST("", createQwiz)
-		qwizGroup.PATCH("/:id", updateQwiz)
-		qwizGroup.DELETE("/:id", deleteQwizHandler)
-		qwizGroup.POST("/:id/solve", solveQwiz)
+		qwizGroup.GET("/:id", account.OptionalAuth(), getQwizByID)
+		qwizGroup.POST("", account.RequireAuth(), createQwiz)
+		qwizGroup.PATCH("/:id", account.RequireAuth(), authz.Require(authz.CreatorOwnsQwiz(authz.Param("id"))), updateQwiz)
+		qwizGroup.DELETE("/:id", account.RequireAuth(), authz.Require(authz.CreatorOwnsQwiz(authz.Param("id"))), deleteQwizHandler)
+		qwizGroup.GET("/attempts", account.RequireAuth(), getOwnAttempts)
+		qwizGroup.POST("/:id/attempt", account.OptionalAuth(), startAttempt)
+		qwizGroup.GET("/:id/attempts", account.RequireAuth(), authz.Require(authz.CreatorOwnsQwiz(authz.Param("id"))),
+			getQwizAttempts)
+		qwizGroup.POST("/:id/solve", account.OptionalAuth(), solveQwiz)
+		qwizGroup.POST("/:id/guest", joinAsGuest)
 		qwizGroup.GET("/best", getBestQwizes)
 		qwizGroup.GET("/recent", getRecent)
 
 	}
+	accountGroup := r.Group(config.BaseURL + "/account")
+	{
+		// Родитель с подтверждённой связью может читать данные ученика, см. пакет parent
+		owner := authz.Param("accountParam")
+		accountGroup.GET("/:accountParam/attempts", account.RequireAuth(), authz.Require(authz.Any(authz.Self(owner), authz.ParentOfStudent(owner))), getAccountAttempts)
+	}
 }
@@ -66,17 +66,10 @@ func TestInvalidGetClassesTeacher(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "not_the_password",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/10/classes", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("GET", "/api/account/10/classes", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", "Bearer invalid")
 
 	router.ServeHTTP(w, req)
 
@@ -90,17 +83,10 @@ func TestValidGetClassesTeacher(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "pAssword1234&",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/10/classes", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("GET", "/api/account/10/classes", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 10))
 
 	router.ServeHTTP(w, req)
 
@@ -126,6 +112,7 @@ func TestInvalidPatchPasswordTeacher(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/10", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 10))
 
 	router.ServeHTTP(w, req)
 
@@ -141,7 +128,6 @@ func TestInvalidPatchTypeTeacher(t *testing.T) {
 
 	// Структура данных для запроса изменения пароля
 	passwordData := map[string]string{
-		"password":         "pAssword1234&",
 		"new_account_type": "lol",
 	}
 
@@ -151,6 +137,7 @@ func TestInvalidPatchTypeTeacher(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/10", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 10))
 
 	router.ServeHTTP(w, req)
 
@@ -164,17 +151,10 @@ func TestInvalidDeletePasswordTeacher(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "1",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/account/10", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("DELETE", "/api/account/10", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", "Bearer invalid")
 
 	router.ServeHTTP(w, req)
 
@@ -188,17 +168,10 @@ func TestValidDeleteTeacher(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "pAssword1234&",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/account/10", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("DELETE", "/api/account/10", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 10))
 
 	router.ServeHTTP(w, req)
 
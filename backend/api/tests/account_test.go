@@ -1,11 +1,13 @@
 package tests
 
 import (
+	"api/account"
 	"bytes"
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -177,6 +179,7 @@ func TestInvalidPatchID(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/-1", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -202,6 +205,7 @@ func TestInvalidPatchPassword(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/5", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -217,7 +221,6 @@ func TestInvalidPatchType(t *testing.T) {
 
 	// Структура данных для запроса изменения пароля
 	passwordData := map[string]string{
-		"password":         "Password123!",
 		"new_account_type": "lol",
 	}
 
@@ -227,6 +230,7 @@ func TestInvalidPatchType(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/5", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -242,7 +246,6 @@ func TestValidPatchType(t *testing.T) {
 
 	// Структура данных для запроса изменения пароля
 	passwordData := map[string]string{
-		"password":         "Password123!",
 		"new_account_type": "Parent",
 	}
 
@@ -252,6 +255,7 @@ func TestValidPatchType(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/5", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -267,7 +271,6 @@ func TestValidProfilePicturePatch(t *testing.T) {
 
 	// Структура данных для запроса обновления изображения профиля
 	profilePictureData := map[string]interface{}{
-		"password": "Password123!",
 		"new_profile_picture": map[string]string{
 			"data":       "/9j/4AAQSkZJRgABAQAAAQABAAD/2wBDAAgGBgcGBQgHBwcJCQgKDBQNDAsLDBkSEw8UHRofHh0aHBwgJC4nICIsIxwcKDcpLDAxNDQ0Hyc5PTgyPC4zNDL/2wBDAQkJCQwLDBgNDRgyIRwhMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjL/wAARCAUABQADASIAAhEBAxEB/8QAGwAAAwEBAQEBAAAAAAAAAAAAAAECAwQFBgf/xAAzEAACAgEEAgICAgEEAwACAgMAAQIRAwQSITEFQRMiUWEUMkIGI3GRFVKBM2IWJENTof/EABgBAQEBAQEAAAAAAAAAAAAAAAABAgME/8QAHxEBAQEBAQEBAQADAQAAAAAAAAERAjESIUEDIlFx/9oADAMBAAIRAxEAPwD53E/oEmLH/UUjk9XJbioysy9lxDTZxTXJKhH8kTk1EyhkbkVHRPhcGLyTXo3j9uyliiypXPHLN+i1K+zSWOK6MZcdBk26KizNWzSKIG2awMJOi4SAWV8M8XVTe+j18svqzxdU/wDcMqMduaPZ02K42eRgrej6DRxWxBWmOLR0qlHlotQWy0cetnLHibRVayzY+tyIjOMpUmfLZNfl/kSiehoNTOc/sB9BsVGcsdmsZxWNNv0EZwk+GRGMcCfYTwR9G8lS4MnfsDB4ES9OjqSRaigPOlpzJ6RM9VwTHHFEo+ezaba+jB3A93PgTs8nUY9rKMVJj3MjplpFgpSZpGTISQ00Ua7uBEqSKTRBcFybJcGUTVMAqhNjbM7IKbJbFYrAGyWDYrIEyRsRABYAagpMpUZ2FhGnAcGe4NwFMRNgmQO+RjUSnSQGM3Ry5JHRmf7OKbZGjTsdIzjZTbCoyUOGTaZZZM55ZGiQaajJZwz5NZTsyZoVA1XRkuCrIFLsI9jGkQar+pI10IAAAKAAABAAFFRMM77NjnzvsMVw5mczZtmZztlZUSwTEzSJYJDA0hpFUJUVwVAkWkSikUUkUiUUiKpDEhoBAXQUEJDQ0iqQEoZSQUXEIpRCkMIpcFXwZ2S5lRU2c0+S5SM2yjJx5FtLYioVC2lASiKCimSAqENktgACsV/8ANkvjoLF2A7LiyUi4oo1izRMyXRVgaWS5ENkuQF7g3GW5hZRpYmybBMuBiGFBE0TJGtESQEJGkUSkWkSqtFolFozWoYxAZUxAAKAACoBiABgAANFEosBAAAAAAAADAzYhsky0GIYghCGID9Gg/qDFHhAzk78o9mkWl2QE7UeCxpWbJCMOWccdTiUn9jl8hlnHHwfNy1uSOR2/ZWX2+PU431I6I5E+j4rTeTaf2ke3pfKYuLkEr3XbI2NmMPJaZ/5G8ddpn0yskoUVtFLU4n0xxywZBjkTFFSN24yLjGIHFkT2s8XVN/IfR5YLazwNZB/IZVngk/kifR6Jv4z5rCmssT6jQr/AGgrvg3Rz+QS/jNnVFfU5fIRk9O0gr42dPVyR62ihTTPN/jZXrJOj3dFp5JK0B055zjiVfg59HmySyNP8ndqMV4kq9HLpMTWR/8AJkexGmlYpxXoGntVfgmKfsDOmgtmzSM5ICXIalREiHZRrJKSZ5eqx88HpRbo5c0LZR484OwSaO2eGzN4X+CxHPbJbZ0PDIl4ZFGKkzSLYLFKzWONgOLdFxkS4tISdMg1k+DNsHIiwKsLJsVgVZICIAAABoBDCkzN2a0G0qMeQ5NdobUQZclxu+S6RLr0RGu6KRjkzRXsyyykkedmyzVhXZPMn7MrTOCOWTZ1Ym2RpukhSoppkSTCspKzi1FxPQSs4tVHsDksZDGUaIZKZSIGUiCkBouhAnwBAAAFAAAAgAEUDZy5n2dMjkzM1HPr1w5n2c9muZmFlZWgYkM0hDEMrKbY02Oh0UNMpMgdlGyaLTRz7h7yK6E0VaOZZAeQDp3L8huRyfJ+x/IB17kUpI4vlKWQo7FJD3I5Fl/Y/lKOvciXNHN8ovkCN3P9kORnuYWwzTchWLkCoGybBkMCr5HZFjsBtisLEAmyWymiWmIEybKoKAnkuKscYNmkYMAjEtRHFDKJ6E2NslgJtidlUOgMwL2j2mhNDSHQ0ihUOh0OiIW0mSNaREkKM0i0hJFpGVNIoSKM1qAQCMKYCGWBgIChgAAFhYAEUh2ShgOwsQBTsLEADsLEAEsQ2SZUMQxBCEMQH6O1RLNJNGbi2zm78hFSaoWx0RK0IV5/lK+I+L1Te+Vfk+x8k/8AaPkNQv8Acl/yaZcG+a9sqOozR6mzTarHtj+BiYvHr9RF/wBzuweTzLuZ5c1+DNOXpmkfV6fytVukehi8rB+z4XfkXtmuLPli/wCzA/Q8PkccvZ24tbjZ+f4NbOPcj0MPkmu5EH2jzwlF0eTq9rlZx4fKR28sjLrIz6IqoTjHNE+k0OeHx0fGvK3kTR6+g1TUkmyYr6/HzG0GaCljpmelzweFGjmpEHmrRw+ZvaehjxQjFcBtV2OwoyxUo0Y4sSjKzZO+xuvRFabo1yROUfRlLcSlL2RWidsUgXApMFSxbLCzXG17CMWqMpNG+VX0cs07KK2xaFsh+CU2gssRWyP4JeKP4Kse5FGPxRvor4lXRW9JjeaFAc+SCSORumdebLFrg4Zu5MBuQrIHTAqwEose1kAAbWBQAAAJsaFVguANEiqRCmkJ5UkQVJpGUsqRll1CSODNq0vZEd0tRFEw1EZSo8XJrOexafV/7vLA+gy08dnlailZ1S1UfiXPo8zUamLugqISW49DTSTPGjlW49DSZLaIr2KTM8iQ4O6DIrDTFNI4dW07OuUWjh1DrsDmaJZW5MOwBMtMz2spJogsdDi+BkAmMmmV6AAFYygABtMCQQDRREzjzM68nBxZmajn164cz4MTXLyYvg0ytDFFWDRUAyaKRplaoHRnuDcBbaomxWQ5AXZLkydwdgPcxOTHQqoLCtjtgmMrQTY02CQ+CpTtjTYKikrKzU2ykwol9lZaxZokYxZvFqghUJmnoloDJkM1cSHECB8j2se1gSFFUACoKHaGgJ2i2mqiP47ArFjstwo0xJR7HOSA53wZuRczFpgOylyQkzSKKKUR7Rpoq0BntHRVoLNiaChjAVAOgoIRMkaUTJEvghIpEopGFUhiTHaM1qEIdiMVSKJKEUAAGgDEMAAAAaGSh2AwFYwAAAAAAAiQhyEZCYhvsQQhDEB+jtOzSNfgiUlY4zRh35btLacuVpHQ53E5c/8AUQ6eT5Ga+M+V1ElvkfR+Sf0Z8tmf3l/yaZZPljSYo9mqKyylH8kpG06MqKilFMTjXRUeBvkDF7l0xrJNf5FuNkuDINIaia/yOnHqZe5HDsY6a9sivVhql7OvT61RyLk+f3NeyoZJKS5A/Q9H5OOxKz1MOsjI/OtPrnj7kerpvORg0mzKvvFmTiHyJnzGH/UGOSStHfp/JxzPhhXsOaQLMjC3OKaJ2MysdfyxE8kTlp/kUrX5I06XkTJbs54tlqdAXY/k2mMsqRz5M6KOx6iK7MJ6mFnn5Mzfs55Sk/YR6UtTEFqEeXtk/bKW5cWVHp/yES86OBX+SlF/kDpllszlN/kzpisBuTJsTkKwKsqLRi2EJ0UdaqhtoxWVIUsyA0bQqsw+ZGkcyApohuinmRhPKgNlkS7IlmRxzy/sxlmA7JZjKeXjs5HmM5ZgKzZHXZ5mecm+zqyZbRx5JWyI5Jud9jwuSn2VLsMaqQHbLJL419jim5tvk6ruJm4WQYwtM9LRS5OFQOzSLkNPcxzNdyZxxbRqslEVc0meZq8MpXR6PyIwy5ortBXlfDJDquztcoy6RnLC5dAc9odocsDRlKWzsg0/4DfRg9SlwZvLu6IO1Zo9F9qzz4Rk5XbO2E6hyAxkfIh7rAuLVluSZz3RUZFGlACYSZRllZ5+olVnbkkedqX2WMVh2TLG2XjjZ1Qw2aRyQxtDcGdvw0J4SsuL42NwpHb8RlONIqOCadiSdmmRpSITVlTDae0xknZ0X9TNq2TTGUU2zZRHGHJr8fAVnFDnHg0jjLeKxo4qZVUdP8dkTxOJWmSYm7FJNDgrLPWTRtFmaVFG4zTlNIm7Mprk0xxNRmrijaJCRaQRaKJQwE6IaLJAVDoEuS30QZNEM0ZNBEpMuMWXGJrGBREYm0YoFGiugMpuujNyZWRmVgNksdgUKhpAkUkAIOS0h7QMqYcmlCo0qVZSCgAY7RIrCNbRnMVibIEhiQzFUAAGa1AAAZqmUSUIoAAKAAAAAAABDEAIYkMBjQhgAAAESEOQjITENiCEIdAB+iyjyTTs0lImzDtypOkZZ5LaU5cHNnn9SnTyPJS+jPlsr/3Jf8n0fkZ/V8nzWSX+5L/kMEi1KjNMGaSqlKwXJBpAqB8AmE0JIC0NISLTAW0ho0slsDJxI2uzZk9ckVm4S28Myakn2zpeRJUZuVkCw5JxnxJ/9n0fidU/kScj56GO5Hs+KwP5SNPvdPqE8Uf+DpeZHmafE1jj/wAHRtaRlp0/MiZ5UznphtZGmqkO7MugWSmFVPG5GE9LJ/k6VnRosy/QZebLRy/YLStHqb0/wS2mBwLBSIlgfZ3tESdqgjzZP4+zGWtjHg7sukeTo4MvhpSd8gS9fF8C/lKXszfhpxd/YcfHyi/YGnyj+Whx0j6NV49y/JRzT1CMXqUjvl4iT/Jz5PCy/YHM9avyQ9Zb7NJeGl+GR/4mUfyALU/staj9kf8Aj3H8kS0rj+Sjb+QvyJ5b9nO8biHQBkyGMslhkVmTQA5MTtksNwRMkzCcf2dDkjOVAczVMIypjmZewjpjM03I5Ys0b4Mq03I7NHyzzdx36Cf2DT19pE00ap2RkQWMrOPUWzt22cOqlssiso5/j7OmGviu6PFzZbM1Jv2B9A9TGa9HFmg59HPinSNXqlEg5J6eW72XDG0zR6lSdcG+OPyegCC4o02No6YaFuO6i/gpUQcKxts2hhbN44fsdUMVIDgnhcY2Yrhnp6lbYHkZciTZRsp0TPKcj1BnPUFG2TKjkyPeZ5M1l4PuGcb6fFZ6GLFXonTYejtjCgZXLLGT8Z1SiCh+jSZXK8fBxZ41Z6k1SZ5uofZWceXl/sZrs1y/2M6Jpir4BO5EvhCg/sUx14oWzsWDgx0sbkevDFcUTTHnrAXHFR3vD+iPjomxXP8AEvwc2px0enGNnJro1ZrYV4mQMY8gsZqM3xo2LcDjZO06T1ints2hDgUIm8Ym4xUUNItoVFQIYgCgQxUAIp9CQNgSwih0VFERcIm8YkQRomANEMqUjOTKMchnRu1ZO0DKgougoBJFIVFIopFeiUOwEwCxWVQIAAkTGIIQBQdACGJDM1QAAZrUAABlTQ10IpEAAAEAAAAAAAn0AxACGAACGugGAAABUsmi2KjKpEUxBE0A6AD9AvktRsFj+3R0Rx8GXblg8Vo5tRi+p6E3tiefqM/FEp0+f8lCos+ZyKskv+T6jyMt0WfNZF93/wAlc2SZXYqKiWA2lwQJFpGmamRJU3RG4oqx2RuHZBVgTuDcFVQtti3h8lckClg9mTjtZv8AyL4sThv5AWGdSPf8VP8A3EeB8ex2duh1nxZOyNP0XA7xR/4NJrg8HR+V3RS3HrY9R8qXJls5SoI5LLli3eiFi2kaVJ2ZSVmnYmRWe0uPAmw3BlqpUVvOfeUmErbeKubIQ99Co2jkUS/5SSo5W7I2WQdMtQpccGD+z6JUKLXAChDmzqxvac++h/IB2vOkiFlU/Rw5stIxwar7dlHsLCp+iZaG/QtPquuTujm3FHlz0H6OXJ42/wDE99uyGB81PxLf+JhLxD/B9U0S4WB8bl8a4+jCWgf4Prc2l3Poxegv0aHyM9FRjLTV6Prp+Ov/ABOafi7/AMQPlngoznio+ln4v/8AU5M3jqX9SI+byRowfZ7Go0dXwedkw7WBjFmnZl0xqZFVR26HhnFF2d+jXJFj14yHJ2TFDcQ1BE8vyC7PUXBxarF8lhXz2XsmOSjvzaP9GP8AC/RBms3BlPJbOtaH9DXj+egObFHdNHu6LHVGGDx9NcHsafTbI9EHXj//ABVRhONyN0qjQtpBioclv6o02kZF9QOHWZfqeBnycs9vVY9yPJy6a2+Cjz3Mzkzt/ifomWk/RRxxi5Hp6HBdE4NH+j1tLp9lEtWRvgxUbuAR+pouSDL4rGsVI3US9v1ZUeRqHts8bU5qlR7urx9nz+qxXMrLjnO2RuN/47f5J+B2X8T9ZNhF/Y3/AI3BWPTPd0Nhldej/se9j/ojydLg2tHsQjUEYU5LgycbNnyCiBnHHRweRVJnq9Hm+RW5Msv6j53IxY5GuTELHhOkYrSKsbgNLaWnZ059YojGjaMeCEbQXB0jFQ0S0bNGbRuIzAb7JAYUAwooFEqKtmkYERmoFqJqsZWz9AZrgNxUlRlIBuVkvkQ0UNIHEa4BsCGiWi2ICKGOh0AhjSHRRAimhURSEU0SUAmDZLYQxMLCwBDEhmaoAAJWoAADKmP0IfolKAACM6AAAoAAAAAABDEhgNDRK6KQAAAAUFDQGWkskskImgGAH37zUyZ63ZHs+RfnW/8AIzn5lyVbiZXXnqR9DqfLUmtx4+Tyu6bW48rLrd/s5fk+1jKnVlerqNX8kezzG90mDyWqJTGVhUokJUW3YqGATLUiKGVBLkhIpiLoaiPaJMdgG0W0dhY1UNE16NBUQQsX2s6I8KjLdQ1IDWStGMY7ZWXuCrIrpwaz4n2ezo/Mcpbj534zbF/tuyfLevutN5H5K+x6EZ7z4TDr/i9ntaDynyNfYmVr6j6JwozaFizfJ7NnGyZV2OdktGs40ZPsmISLRcMe4p46BiUQ2U3RPZKmDcP5CKHtIYveFiUS9oTEUFFtEMoxzq0cN/G7O7I7OPJGyjTFrNvs9PS6zfXJ8/JbTTBqvj9gfYYp7i5cHiaPXbq5PUx5PkAtyoqLsmUQXBQS7EXtsHGijJkM0kjKTouiJQs5s2n3R6OlzIlOyGPF1Giu+Dx9VoaTdH1kobjj1Ok3Q6Bj4bNh2yfByvg+m1Wh5bo8fPptrfBFcUJno6KdtHnShtOzx7tkXHuwZb7Mk9qRSlY2KU5VZjKVm0o7jKcaFsXGE4WOGCxspZNpnTFrTcdEvFtZSzkudjTK2xujrjlqJ56nQ3mpE1fmux5+exrMed832NFk4B813fMDnuRxfIP5hqZW8sW5mM9JfoazjeosumMf4f6D+Ffo1+Yfz/sbBEdLs9GijtJeeyfkszf1vlq5GuN2cbmOOfaMHdKdFxlaPP8Ams1hmoqY2y6f5E+Dz8vjd0+jseppdmMtV9uxrOVivFfX+pD8X9v6nbHV8diep57GmOZeL4/qVHxlP+pv/K/Yv5f7Jph49Ht9GzxUjF6v9kPVX7BjZR5K2nNHNbNflJplW0cmow77N3kJ32WVmyvP/wDH7vRUfG1/id6dEZM+06c+sWPF1mn+O+Dlij0NVL5LOaOP9Hbn1zsuHCJvGNIiKoHOjpyxYpozkgcyXI3GcQ0SwlIzc+SrjVDM4ytmsVZNVePs6ow4MMUeTsSpECUBuIOVGc8gRORHPIueSzO7KJNIomh3QDfBLZaW4lxAmwEyd1FFlJGSmWpBWqiVt4JjLgpv6hEuImiZZOSfkM6ptENA5kOY0DZDY2yGzWoW4uLszLgTRaGAEWAPYAStQAKwsiq9DJsdmaUwFYEZwwEMhgAAoaYAGA0wkMAGmBdFIQDTDEMAYaAQNkbMkV/sYxCEaJA4jB5lDoP/AIOyqKCgGAUNAADAQwGAgsgGIYACAAAAAAAAoKIIfY0iqHRQki0TQwq0yiLHZVUzXDqfgMNxMmRX0eg8q5VbPdwazf8A5H59HUvD0d+l8rJVyyVX3fyKXsuMNx4Gi1/yVbPb0+VS9ma1rpitpE5jlIxk7M1dN8iGhWRKAEKyI0TKsysNxRqyWrI3i3gTmVI4JyO3NK0cMyjDKzncqOpxsxnj/QRrptRto9vSavrk+WnkeMrF5CUPyUfdYsqn7OzHBS9o+Eh5iUfydeLzs17YH2MoqPtEOj57F5Z5OWzoj5C/8gr1nFGUoHJHWJ/5G0c8X/kgHLGZOBrvT/yRLa/JRntIyxuNGjZnN8EHmanDaPH1Om74Pfyqzh1GK0Qj5fUYKvgWhhtl/wDT0tTh7ObTw2yI1ruk+AgxPoSdEVujOasW4Lsis2iHE0kQ2MNJRLUSV0WmTFlG3glxLvgllxdZbaYXRTFQw0rCx0FExNKwsdBtGJaNwrHQbRjNqo8lVRHQ1KyxudTDYttlIpIq/URVCcqLaJcSYn1C3WChYUUiJsUsfAOJafAmDWLQqNGhJcjDU0Laa7ROIxdTFGqIXA7JlPoSZMZCmyUxJ+s2t0znzmsWZZeTrzP1iuDKZpm2VGNHbn1zq0wasIor0dYxUOHBhkdF5MlcGEnuNMspT+1DXI3DkrHFuVEFQjz0dmLHZWHBfo7Y4FGNkVMMCSsuUaQt9cEznwWIym6OecjWbsyaKMWwTLcSJcAUmMzTLi7AadDux1Y1EBbbD4b/AAWlRdgZfCHx0W5GcpgPomUuDOWQzeSwCT5Jsb55JaMAcidwMRUFiHRSiUQaQHtKSoAAYg1AIGBFACsLMqYE2UShgILIKAQWSihk2FmRQCsYACAAGAAUNAIZQEsr0SwJ9lIiykwNUwZCZXoK80BAAyiRgMBDAYCGAwEBAwAAAAAAAYAAABBSQqKXQioQAIKLHZNibKp2KUibGlZFS1uFbh0a7A2WSq6NLrZY6PqPGa3fVs+PpRO3Tax4qoyuv0DFkjP/ACQ5Jej5jSeTfFs9rTaqGRczMrrrAuLjJcMmSIJYhMjcwNkgpGakWpcAKSM2aNmUijObbOad/g64pSfI8uKNdhHnxtlOFmuxIUkkB5+pxrk5o40dOrlVnPibZQ/jVlKKQOxWwFLUvH0T/wCRmvyRONmTggOleVyL8m0PMT/Z5riQ3QV7+Py8m+Wzuw+QUu5HyHzSiNa/JHqyo+8x6mEu5o1lkxuP90fALy+WPVmuLzOWUqdkH2UpRb/sjDMo12eHj8jJq2a/zt3bIavVQXJ58Y0zqnnjL2Zx2v2RpNlRK2r9EtURdaJIifBNsic/yItociWyXkX5Jc1+TWM60TLTOdZF+S1NfkYuttxLZO9fklzj+SYurvkpUc/yL8jWVflDD6bhwY/IvyHyr8kw1twBh8q/I/lX5QxLW3AcGPyr8oXyL8jGdb0mFUZLKvyDyr8jxda2NSMFkX5LjOP5BrWx9kb4f+xLyx/9ga1Ax+WP/sUskf8A2GGtbAjfD8g8kP8A2Qw02xWT8kf/AGDevyMNXuHZluX5Gpx/Iw+osBpx/ISlFLsYfRNEqIlNP2UpL8ic/pqkZzG5r8kOSZ05n6zWGSJztUdUzmmdefXOldESnXsmUmc85uzpGaMkuTPdyacNcmTT3cGk9dGNWzuwae2nRzabG3JWj3sGFLFbBiMeFRjZOaSjHho0zTUIcM8vJnbk0RRLK9zF8rZD/JFiJW12HBluE5lRcmjKQt7ZSVgQkaxQ4wLSSIKjEbVC3US5lDbozcxORnJkDlkZm5sGICHJgrsvagUUgKS4JaKEzIhoVFAigUS4xEi0VDUQkqGmDAzEyhBUvsBslmWoTYrBktkWHZVmd8lpkq07HZNjsiKsCbHZKKCybHZkUmMlFAMBDABiBFDAAKAllEMCW+QTJbEnyBsmVZmmWmFeeAAAIokYDAAAYCGADEBA0MSKAQwAAAAAAGBAwACoQAACJaKCirqDTGiaLhwRdabURNUWTIi65pN/gIzaNnGyHjfpEppLVTg+D0dJ5LImjzvhb9FxjKPRldfZaHXqUVukexiyRnG7Pz3Dqs0JxSR9NoNcvj+zIuvclRm0YQ1MJezphKMvYE0x8mlKiWkBDZDLdE8A1nyiZzkzakJxRRzKyZ2dLgkc+akB5msfZlp+Q1jfJOkTdAdMkiaQTtGbbAmafoyaf4OuMU+xSggOKSZnJHVNIwnQHPKJm4mzJaKjFwQ4xp2XtKUQJeSUVxZm9TNM1lHgy+NtmQo6rI2dWDPNmMMDvo7cGCu0Rp045SfZ0KNkwgkaqiKSxo8vXz+O6PWPF8k3yOfS+ON6iRL1EjGyWdcY10fyJFrUSOZFJkxddH8iRL1EjK2SyYa0eokL+RIxdi5GGt/5Eg/kSMOQ5GGt/wCTIP5EjDkORhrf+RIP5DMORcjDW71Mio6iT7OdFJpGKsmun+RImWqkujncr6D45z6QX5a/y5+ilqMj9Dw6HNP/AAPQxeMytcwBjz/ny/gn+TlT6PX/APG5K/oc8/H5E/6lMca1WTaZS1k0ztnocqi/qebmw5Iy5QxG0dbJs3jqmzyuUzaEpGsTXpfyHQlqWcTk6Ic2MR6i1TCeqb9nlrJIHkkxg9GOpf5L/knlb5IfyyEjT1lns0hNs8zFOTO7DbNyfrN8bNmGRmsnRzzkdIzUSMJRN+GG1M3GLHJzuSOzT4HNptCjppSmmkezpNMoxtoumK0+kjGFmuSahCkzolKEMLV8nj6nM9zoauIz5220cbbcrG5Ny5LaW0lMTZIqdjoalJtktsurDaVlMUbwiZpJF7kgNXSMpyomWQylJsC/kE5mTk0LcBruJbFYwARVFbGBFhZe1goMCOQo1UGUsf6MjDax7X+DoWMfxFHNToOToeP9ESgEZbik7E4saRVAmUSxRLIZTIZlYTIbKbIbI1BfJojL2aIlDCxAQUFiGShhYhmRSLM4lgMEAAMAAoYCGUHozkamUgMmxWEifYGsWaJmUTRBXGAgAYCABjEADBAMAAYEDQ6CJfAEUFF8BwBAFcBx+wEA+AIEA+AtBCAdhZQhisZQDQAFXaIkxO0Ig0grOnHjT7MMZ14zNU/hiZTxI6UyJUQ1h8aXNAs+SEkkaNErG27Jiyu7TamfFnsafU8cs8LHFpHRDJNDFfSQ1EXHspzTR4eHNO+z0IZfryxg3ciVMzlkVERlbA6twWRFMqmFEmceonV2dbPM8hJq6A4s7U+jo0WPo48Tcuz1NKkqAnNBI5ZRO7MrOWUX+AIToUmKSaJbCMptmErOiTRi1YGLTBQbNlDk3hjRUcqxsp43R2xxJl/Dx0QeYsUmzbHp3fKO6GFXyjdY4pdEVyw06/BtHEo+jZbV6HJr0RYxlwTFjmyYtEajVM8fySvdR6U5fg83VtO7HPp148ltR7C0zPUOnwPDbR11zaFJE0y0AyGaWiXFvoismOh/HKyljYEUBo40jKQBwPgzV2URT4AVjjyUwuiXCUukdEdPPJ/U9LR+Om63Ix1crrzxseZh0eafUT3vH+KnKt0T2vHeNx8bon0Gn0WGHUTO618PN0PicSX2R6kPG6dLo6VCEOh2D4cM9Bg/By5NBgvo9LJLhnDlmrKnw4s2hw/G6R87r/HdtI+q3J9mOox4pYnwaZ+X53n0zhk6MLSdH0PkdOrbSPncmKXyNUys3k3JENotabK10KWnyL0VnEqSLTXsy+Kd9FrFN9IJFSaM7NP4+R+ioaPK/QaXg9HpYaObDo8qOuGnyR9GolicrOPI2ds8M/ZzZMMjcrOMoyN8WOU5KlwYfFPcv+T29Dijt+xrUx0aPTQ2XI6JuEIumLcoRdHBnyuy6mM9RnlupdHHOTbtmk5X7MJv8DQWvQK2zJKW46YQYA4KiHGuzodKPJzZskUuBE6FxHaOP5eS4TbKy3k66MJyZo02L4pMDFSkzWEWzSOnkdGPTy/AHBkg/RKxzZ6ctM/aLhp17QHnRwzfo1jgl+D1oYoJdDcMaA8tYn7LUEdU1D0ZOrAhQQ/jRaLpUBhsRcYIq1Yb4ogpQQ3FE/LEl5ohDlFGM4oqWaJjLKiiZJE0DyInegiiGDkiXIKTIaLtBSIsYtEM3kkYzRFRfJomYtOzSPRKLGSBFUMkZKKAQzIcTQziXYFAIYDABqihUFF0gKIukZSki5nPNsBSkrFfJi29xabA3izVJmGN/Y6eNoVwAABAAAAwAApjXYhrsBgAEDTHZIgLsLM7CwNLFZIAVYWQxWQapibJsLDJ2K2AWVRZSfJIAabkUppGPP5E0yq2lNEp30ZKMmdGLE2Qa4kzojwLFiZbg0ShOdC+RMyyRZCTsyOpNNm8EmcuNM68cWFbxhwPZya41UQlJIpqYcM6oze04/kW41jlRFb72aYsiUuTleRUR8tAe1DLBo0tPo8XHqku2dmHVxkFdck2eZr8baZ6kMkZGGqipWB4eLG0ehg4M9iiXB0RWs2mZuiZTIc/2Bllkkc05GmW2zBwYEtuy4qxVRcWkBpGHBrGAoTRupKiocKXZpuhRzymkZfJyQdilG+ByfBzQk77NZy+pBE8lExypmWVuXRlBST5I065O+iKYRZoqIrJxZ5+qhLk9ekcepS5HPpXzeoi1LkvBSResXPBlC0b1jHRwKmyE2axY0xKTs1ivyTaGmNMbJRBpUZqwcvQ1cTOqMHG2bPkSSsmmMdlClwbyao55pvoauJit3R1YNLPJ0TpNO5yPqfFeP3bbiNXGPivGSdbkfRw8fCH+J3aTRxxV9TryRivRz6v69P+Pn/Vx4MShXB2RaRjx6HuZNb+GrmhbzGU6IeRF0+FZJcM8/K3uOuUrRyzjbLqfLK2Z5LcaNmia5Naz8PI1OllNPg86HipSzdH1kccZejo0+lx/Je0usXl84vCy+NPaY5PCzf+J+hQ0+L40tgv4eJ/4F1z+X5tLwmT/wBf/wDgYvCZL6P0h6DE/wDBFY/H4k/6DU+XwOPwU/8A1OvF4KX/AKn3sNDiX+CNY6XEv8ENMfDR8K1/iKfiJL/E+7enxf8AojKemxv/ABLqWPz7L4qf4OTJ4uf4P0PJooP/AAOPL4+P/qi/TOPg342afRccUsSPr8mgjT+p5Or0L5pGp0ljw5za4OXI2zs1GlnGRzPE12XUxySi2Z7XZ1yjRi5JM1KmJjFdmqpIm0+g2Nl0Rml9eDzsu+TfJ6bwSlwStDL2WM15WPDkbO7DpZnfg0e18o9DHihHtFR5cdLJdm0dPXaPRlLHH0Yyyw9IDOGOK7RtD416OeWRPozlNgdOSeMz+SHo4puT9iipfkDt+RejOUmZxsoDOVtk8mroiTQAmU5qjBzIlkINJTM5ZDKWTkzcgjSWR/kylll+SWyHYDeSX5J3y/IUKiorc/yNSZIBF7n+RpshItCqdjsklsyNG0ZuiXIW4jRtIQWBAwEMKYxDJQxiGZDRRCKsCrHZFjsC7GiLKTKLQ/RFjTKFJHPkR0NmEwOaS5GhvsAKg+Tp3fU5Y9m18BXMAAEAAADAACmNdiGuwGAAQANDSHQEbQougoCKCiqCgJoW0ugogzqg/wCymiWghFJCSNIR5AI42zWOnbNsUDuwwX4Krz46KTL/AIMj2scF+DSUYpdIo8SGiZ049K0egnH8Ivcl6A5I4a9CliOmU0iHkRBxTwNmf8dnoqSfomTRkcccdHRBqJMpq+hXaA6PmSVGU8tnPKTRn8lMo35b7LUmkc6zpCnqVXoi66JZ6RjLUo4smfd0zJNyfbA7Zah/k10upe7s89xbKxXF9kV9Rp9UuDqnmUj57T5mq5PQx5NwVtkkjFzKmmzGUWFU5kNiqvyFECbQrX6FJGbdAW42JYW37CORJnTjyxCso4ZI02tI6FNNdClyio5ZWZpPcbyiZy+vJBUZbR5Myo5Z50jmyai0Qx6WnXzSpHZk0Moxs4/BzU8v55Pq9XjSxrj0Rp8x8LiNcHXmS5OSToi41irOHWfWzsx5Ejk1cfkuiLY8HVO2Z41Z159HKTNdP4yUq7Nazjmjhcujqx6Cc1xZ6+l8NKTXDPpNF4Byj/UmmPiF4nK/bNY+Hy/s/R8X+nnX9Ddf6fr/AAGmPzZ+IybfZhLxORP2fqD8FxWw58ngH3sGmPzSXjpwVuzlyYnDs/RdZ4NqH9T5nW+Hlb4ZNWR8xzJ0b4NJLIzvxeGn8j77Pc8d4Zpq0NXHB47xck02j6/xujWKridGl8XsS+p6UdPs9DV+UzivSOXKmdkkc2Xgxa9HH5HNQuhymkZvIia2jJKjB5OR5Z2YO2y61joUrKqzLHFnRGPBdTGEsZGxo66IlEup8s8fEjvwyS5o4VGpWbKVRLrF5j1MWddHXDJFo8LHkal2d2PNx2X6c/l6inH8GkZx/B50cv7NPloaz8vR+RfgHkRwLMaLLZdT5dEpoW5GDmCmNTHSqfoznBP0iY5CvkRfpMc+TCn6OLNpFJPg9RyTE0muhOmbHyOr8c27o8nPoXG+D7vLgUk/qjydVo07pG50zj4fPgcTzpwlvPr9V41u+Dx83jmp3RqVLHmY8TbO2GndWXDBslydakowqjUqMIY1HtG26CX9UZzyI55TZqM11fJBekZzzL0c7bIdsrJ5cjfswTb9stxbKhjCoSZM3R0rGYZcdAEI7huNChkUOxTzJhA5pEvKjGc7M3bYV0PKjKWQnaxODoGM5zMnkt0VODMlB7gYumw2s1iuB7bIjDawaNtgPGVHPQqN/iYvjCMaEbfGTKBUSi0SlQ+iAbMpMtszkyKhsEJjTIq0MSYEWGMQwpjJRRKGAhmQwAAGMQwGikSikUNDTEgKFJ8HPNm0jnl2BL7AdDoBLs2irMkbQ7CuUAAIAAAGAAFMa7ENdgMAAlFRKZMRtgACsLAYWKwAdg2IRAmxDoaQMCNIuiaGkB0QyJHTj1SR57Qij2Y65L8BPXpni2/yUrft/wDZR6sdWi/5aPKXA3koD0J6mzP+R+zz5ZRKZB6sdSEtQefGRVkG083Jcc/BxuNlLgDplOzGYt9EymBnKTMpSb9ly5ZO3kCEnZrDgFEGqIrZTTBsxTopysiujBK2expVdHj6WNs9vSKqCumUDNwNsk6ZksthWM4CUCsmRCjMgTx2jKWBs6VMNxVcLwOyowa9nVKRjKdAaQ4Ro5VE4panaYz1y6sDsnmSOTNqVRyz1e7iznnPcBc8ttmUuSF2b44biD1PAvZlt/k+s1uqXxr/AIPlfG4tkrPW1juC/wCDLTnnnUmznnKwxYtzOuOic/RFccFZ14tG8p6Ol8K8lfU+g0Pg3GvqzKvmYeEc6+p7Oh/043T2M+n0/jNlfU9vSadY1/VDUfNaXwXx19D3tLo1ij/VHo2l6H2htMZQUYqtq/6NKi/8V/0S4cjSomhPEnztX/RM8UXGtq/6NfkpUQ8lsaPN1WjU41tPB1Ph98n9D6+XKMJJfgmrI+Mx+Bqbew9PTeLWL/E91NJ9IcpJIa1I894VBLgwynZnmefkkNXHNkdHDmyWdWeRwZFZm1158YTlZFWbLFaNYaaw1rheJsa0/PR6cdJ+jWOl/RWo86GBpGqx0d/wUZyxjWnG4CeM63iIlCi6jlcCJRN5PmjOfQ1mxhe1msM1HPNmEslDWMerDUmj1P7PDjqeezX+Ta7LrOPWjqqNo6tfo8H+RXsa1X7L9MY+iWqTKWezwseq/Z049TfsfSY9dZSlkPOjns3hkGpZHapGsejkhI6IS4NSs2NG+PRzzipeipTonealZrmy6dSi+Dy9VolTdHv7rVHPqIbsTOnLNfF6nFskzglP7Ue95DB2zwJYv95o1GalpsnZZ1LD9QWI3Ga51hbKWBv8nUoUUVlxPDQlHadOWVHHky0Fabkvwc+aaM3nObNn/YDyOzPayFlspZQKUGWoEfOP+QBqo/olozeoIecCpRv0ZSjXIPORLNu4AfyJcFLIcz7sE6IjsU0XuRxLLXspZgOu/wDgls5/m/Y/ksrLVtfgl8k7rHuCJcSGXKRjKYCbIkNyERUNAabbD4yKhMsWyhkWGAhhTGIZKGMQzIEUJDAENAMBoYkUuigoKGgRREkYSjydbXBhKP2AzUR0aJEtAZ+zXF/YzZeJ/YK56FQnMW8qKodCTsqiUIVlUPaQTY0x7AaoGhFGe6hqYTa1URuJEchXyA2jaG0XyCcwbToRLmLf+wasDPeLeDWm4e4zXIxjTTeG8zFRcGvyfsTmRQUBW4uMqMiogbOZm3YABLQJUUANNSotZDIQw1t8hXycHOUmS/hWjkS2Q2KzKL3DTMrHYNbXYVZmmWpBSaolMqbM12StR3aWdHsafL0eBjltPS0mW6M61HrSW8SxG+kh8tHoR0V+ia1I8aWnv0C09HsvR16Kjor9E+q18x4vxUaR025dHr/+O/8A1Ljo9q6H1THiS036MZ6W/R9DLR2rownpq9D6qY+byaG/RzS8bb6PpMmKjkyPaX6qY8R+MrmiP4XNUetLPbohcuxqPPj4/wDR0Y9Ft9HapGkXuJrWMsMfjOmcvkVF49Pv9Ho6bxm+vqNXHFo9Lurg+g0fj91cHTovEVX1PoNJoPjrgmtYx0Pj9lcHtYdPt9BihsOiLM9WyLJ+p2UWnQmybOX3W/mCTtlRnRmwH1U+Y0+QTyGTQD6p8w3KyU+QZFj6p8xvutEyjZCkVvL9U+YzlCjHJKjolLg5svI1ccWbIcOTId+XHZxzwX6Gjjl9yVp93o7sem/R0Q09eg1HBj0n6OmGmr0dsMVGmyga4violqjqmjlyFX6rKUjCTHNmUplX6pvJSOfJl9Fy5RzTXJU+qiTuRE5/UJOjDLP6msifVY5chxZctlZsnZySdsvzE2j5KZSz/sxkjPoYOt5rF8hybxPIXEd0c+32bw1lezyHkGshMiY+gx639nbi1d+z5rHkO7DmoYWPo8ep/Z0x1PHZ4GPUHbiy2Vix6Ty7mUpHHCZ0RdxLGbGnyUx5Mv8AtswZnmnWNmpazY87XStM+fmqzNnqa7PSfJ4GXV1kfJfqs2O5zqJjLUUcM9Zx2cs9Xfs68XYxY9Oes2+znn5GvZ5uTUX7OWc7Npj08vkr9nJPW7vZwS5JoDs/k37M5T3GCRaIH8m0PlIkrEohG2+xp2ZLge6gNBMjeJzCGybE5iuwNN/7JcyaCgE5C3BtDaBSkXGRmkUkNRspA50ZpikNTFudmbdioZNCopMQE2i1Me8zGNFuRDkBD7IsXY7IXDKRNVSKRKKQFJDoENMKVDQMSApD6EgbAdj3GbY0wrZclJGcDaIREpUYSlcjolCzF4+SqF0SzRRqJnJAZseN/YmXBnGdSAUoUQ4nesO70N6a10B58Xyb/wCJt/GphLHSFHPY1IcoUxJED3EykU0RIM1DdglYjSKCHFDaB8CsAoGgsAFQqKAKhoFEqgoAQwArQAKGAAAAA0IaAoVgABYAAAArCwGS2OyWSpRuFuF7EZDsdkDAtSLTM12UgKkyUwl0GNWyNQp5NvsvDq3j9lTw7jnni2Br9fV+I127byfWaWfyUfmmg1Pw0fX+K8hurkzW5497N9Tiyaz4vZ34qzezHPoVP8GV15WTzOz/ACOeXnP/ANjtzeLT/ByT8SvwgrJ+e4f3OTJ55t/3N5+MS9I58ni1V0EZvzG7/Jmc9dvXZnk0agzHZUqAtZ7ndnTjy2c8cHujq0uFyyVREdOJbj0tLpd76NtFoN1fU+k0Hi+vqRpw6Tx119T3tJ46q+p6On8btS4O+Gn2eia0wwaXbXB1qG0qKouibVYydFQdjlD9DjGideNQxMbEcmiEMQCAAKEzNo0E0BArG0SwE5EPkbJbKIeOyHhNbHYGCxbSkqLZD4CndCcjKU6M3kKNJuzlyIqWQwnkKMciMJIvJM55TKKbMJhKfJMnaNIxycI4c8+zsyvg83US7Kjkyz5Zz7h5Jcsx3GlatmWSQnMzlKwiXMlzBomihqRpAzjE6cUCDTEjrx8GeKB1QgEVCR34JcHEo8nbhQZrrhI6oS4OKLo2hMsZrocuDl1E/wDbZo5fU5dQ7gys14XkMnDPmM+b/dkfS6+NxZ83nxf7rKw5p5uDnebk6p4jneHno68eM9IeUh5DV4f0L4f0b1llvHuL+IpYwIRSRaxlKH6GiNthtNdoqIjJxJaNWiGgM6FRdCoImgqiqBrgCNwWS1yUkUMBpD2kCApolgFg2KwCCxiABgAEQAAAAqGBFhFIQwqikQUgLGRY7CqBdiTGA7E2BEgsDY0yBoDogzeJzwN4MCzNrk1SE4AZPoykbtEOIHNNcHK+JHoTh9TiyRpgfQx0/BX8dUdjxNLoxkpL0SK4s2LbGzzMk2pNHsZYykqpnFPTNu9oHnOTYrO2Wma9HPPG4+gjOxMTbRO6wgouKJTKQQ5E2EmRYRYEpjsKoBWMAHQhhRQABVAAAAAwAAAACwsAALAAAAEAAFAUidFRQqLYjEZRQ6GNFUJFJAkWioW2xbdoTdERk5MNG8rIlJyN1isfxIixzw+p6mj1rw1ycE4UYyyOL4I1L+PstL5hquWdq8pKa9nyGhzW1Z9l4nSwzRVtdEXWE/IT/Ev+jF66bfUv+j6ReJxS9xNYeExN9xI2+ajlnP8Axf8A0VO/jbo+uj4TFHG3ceDxPI6aGKMkmiD5DV5Wp0c2FueeqZ16nHu1VI9PxvjN+RPaZG2j0HywX1Pc0XhVuT2nseN8UvjXB9Bg8dGMU+DKvK0niVFLg9rTaJQo3hgUTVfUmq1+PakKSJc3XsncZ1pSRokYplbibWlSJbJlJislqqEKwsypiFYWACsLFZQ/RDY5PgwlPkDVshk7gsAZDZTJZRDYtw2iGgKUrJmyW6JcrKqJswlI1kYyRRlKZhOZvJGE0VHPORzykdM48mUoFHPfIOXBUo0c+SVIoyzZODy8+S2zo1GXs8vLluTNImcuTJsTlbJsoqxFRVhJUIJaJoTkLcUawidWKJxxkdGPIQejigdUIHDiyL8nXDIvyEbKB1Y48HJGa/J0QmvyGa2LiZKVs2j0Gaq/qYZVaN/RE42jUYrxtZjuLPn8+L/cZ9Vqsf0Z8/qYVNlSvNljM/h56N5diR048YrL4B/xkbKirVG0c70yJenOlsltMI5nhohwo6miHEDnUCXCjrUBSxoDhlEylwdssf6MJ4wjlcidxtKBm4hE7irsloTdAVRSRjuY1NkG9AZKb/Y94Fvohi3CbATY0JggigACgCwABgIZADoRSAQwAKF2MQWQVYWSFlVaZVmaZSAomRQUFjNjXobiNIDSBvAwiaxZB0xRW0iDNl0Bi4k7Dah7eAOecPqcOXH2enNcHLlhwB97/Ci0ZT0ET0Izht7M8mTHt/sYbeZLQQv0RLx8K9HVPLFPszeeH/sWJXn59BBL0eTqtMo2e7nzQ2/2PH1c4NOmVHhZoJNnMjtzU2znjEM1CTKdo1UEKUUEc8m/wRbN5RI2ASmx2y1AaigITZSs0UUNRQVHIzSkDSCswG+xAAAMAAAAAAAABAAAAAAAIBlIixkoLEAnZmIYIkaNC0WmZopBFtWPHBEopNIo2aSM22NSsOGRpD5OfLA6mkS4pkGWCbx0fReO8nLEkrPA2JFLNKDVWF19xh8tJ+z0sHknXLPj9DllKJ3fPkj0jLpH1/8A5P8A2pLd6PA12qlknStnnrVZ3JLazv02CeaScomWnFg0ssupi3E+18R4yO2Lox0HjIupNH0uj0/xxSMK9DS6SMMS6OlpRjRnCTUKJlNmWj3clJmS7No0c1Ogop0K1+TO1oJDoVoLJtaFL8kvgUpE7rCqsQgKh2KwdCbQBYrFaAoG+DBrk3ZNIDMC6QnRRJL6KbRLYEsmigQGOTgyRrlMk/2angHEzlE1tESa/JRzTRzzdezoyNHHkkURJmcv+SZSIcuCiZnDnlUWdWSXB5+olwyo83U5OWefOVyOvO3uZyV9iiRl7eCWiiodlZFwRB8lZHaERzsiTotmczSpc6Gs7Xsxm2Yyk0RHow1TR1Y9WzxIzZ048jIPahqmd+DPaPAhkfB6OnmwzXs4520d2LmJ5GGbtHqYZLaErX2XttER7OiCTRWOnFqMV4zwdXg5fB9XlgnA8jVYFyVl8nmxuLZyOVM9rW4aTPEmmpM6ceM9HuFvZNoZpkfIwU2KkHAFKTKRA9wRaY7Ri5MSkwNWkyJQT9huYrKjOWNGMsZ0slpAcksZDxnW4oIwTlyRHA8TvoTxv8HsLBBx7Ilgj6ZFeTtaE7PQngSOeeJ/gDltjsuWNr0ZtNFQWUjNtlRYFjECKGAAVAAAAy0uDM0XRKQgG6EFAAIAsVhyIKaZpFmSNIgax5ZaiTA1QVm4io1aJaASLTIHYHRCS/JtGSPP+Rpm0MjIO1UU/wCpzxma7ltAh90Z5I/U0T+xcopxA97+VkjjtnBk8jJTps7NTCsTo+a1UprK6OTo9Weu+t2ceTyEr4Z5cs2TpmXyybN8pXoZNfkZyz1M5dmabZaiVlnbfZSRe1BQQiHZpwOkEYUNRNJRSJAKQmMVAIlyZdESQUtzHuYJcmijwBlbFyW0rHSAkFZVIdAIAZDsC20TZm2wVgagQrKTXsAYiuBNfgAsTJdlR/YEc2axfHJaUKMZ3fBKL4AzVlKyB0OgQyoaGqJFbNRFyZCkxNtggNN1BvIdiVkG0ZWWjPGbJEVLQKCbVltAosivY0GxRVs9THCEz5vHknFqj2dFOcpRM12nj3NPoYTaZ7mj8elXBzeMxbops+n0eCO3kzWl6TSKMT0YYlGIYoxjE242nNWf6BpBIizm0dJD3NBQqMtE5sW92VRNGWhvY1NkisitLsLSMtxE5tdFg6Ny9Cs54zZakVGjkZSnQpTMJS5A33lqRzRZrF8FGzfBG52Fk+wLvgmTK9ESKMnJiUmD7EgKsTYEyYGeRnPKVGuRnPNm4JllZPyNkyJsqJnNs5MknZvNnNkZRm3yHolvkd8FGGXo87PfJ6OTo4cyQR5WWLcjBwdndOFyJ+Io43FkNM7nhddGUsTKORJjds2+JicGuxBzNGckdEkjCdGkc8kYySN59mE7IJpHRjo5uTbHZB2Y6PQwHnY2d2F9BK9PCehhk6POwM78XQSuuDOmD4OODOmDKzWk23E4c8bR6LVxOTNHgM14WsxJx6Pn9XjUW2j6jVw4Z8/rYdm+XPp4yk9zNU2Rt+zNEjSJk2JNlyQoooltiTZUkCQB2WkiX0VFhFJITSHZLfBRnJmMpM0k+TGQQObCM2mQJ9AdUcxpHImefulZpCcjKvQSixSxQfs545GarIURkwx9HLkxfg7W0yXFMI8qeNhGLXZ6LxRZlLEl0UcrRPJ0PGzNwZUZD5HtY9oAihBZQxWFohsgu+QsgpAUh0JFpATQmi2hUFTFGiRKKQFotMzTKTCrslsL/YrAGxbgZIDRadEoZBrGbLWRmKLTA2UjRSswTNEwPrdRgbxs+b1mnam3R9zkwqWJ8Hz/AJLSVGTSOTq+QzJJtHMk9x06mEo5pIxXZrlKuBqqMkWmaZW6IYWIIENCQwhNiHJiQQmCGwCnREkaromSAzVWa8bTFvktSAhp7h0yt8R74gQk7KfQ7REnwFK0DaM3YuWEXwVHaZJMbUgNZ16MWn6Kgm+zaMEFYJS9mkV+TVwRLi10EKkQ4v0aJMtRXsK5anZpGP5OjbGuiGvwSojag2l0LggigovgHRpGdMKf4LoVBE8gkVtGosBUKiw4I0qBraMolNko0VG8IpnNFnViIrSGO5I97x2BtxPMwQTkj6jxmnva6MO/Pj6DxeGoI+gwJRgedoMVYz048IyrRSaNFJmCTs2XRhpTfBKf2G3wZp0zm06VVCbRn8ioTmRpTaJbI3CbIptkNg2KwC2RK2VaE5xXZRK4DfRMsiMZTv2RGspmfLYlbNoQbKHCLNkuBwhwVQEcgNoQFXwRIr0RIDN9iQ2xFDZlJlyZhOQETkc8mXORi2dOfETJmbZUmZSZRE5HPNmk2YyZRDYbuCZMhvgInJI48j5NckjknNWUFJyNIwTOf5FuN8eRFGjxJozlhs6Iziy/qwjgen/RhqMO1HrqMTHVY01wiwfPTi0c80z0s2Fq+DhyQaNjlaM5I2cSHEzRg0a40S0aQMjeCO7CmcuJI7sSCV2YTvxPg4MR24gldcOzqx+jmxnRBhmum1tOfKrRe7giXJWXnaqH1PA1uPvg+n1MfqeDrYcM1Genz0o1Jk+jfLGmzBsrJMEKwTKgkCE2CZQpAgkJdBFpkth/9JkyiJMxky5MybCCwXJJUeyito0ilVA6IpXRSkSCA0UmUmZoaYGtodJmabByoCpRRjKCNNyYnyVHPKNENHRJGUkBi0yGmb7RrHYHNUg2s7Fh/QPFwEclFJlyhXoz6YGiKRnFmqABFshhSASYwKTGmQOwLsLJsLCmIAAaY7JCwNEykzNMaYGyZSkYplJgfqUMkXjo4fIYoywNpCx5Wi9RNS07RydXwXkMO3NJnlt1No+j8njVyZ81k4zM1ylbRKSM4M2TNMk0IptEsIExkqxrsImY4BJWOPADZPsbYAUuiZFIzkwMpdj2t9FqNyOvBpnOSCuD+PkfNlLDM+ix+Obx3RL8c76A8L4pB8Uj2/8Ax0mVHxk2wPDeBon4qPo//ETa4M5eDyPqwPBpITpnsT8DmfVmX/gc69sDy1JRK+eKPQl4DO+rM3/p7U+r/wCgOT54v8FKaZ0f/wAf1K7v/oa8Rmx9tgYKh0bPTSx9ktbewM6YbGy7RpFr8ArncGQ4M7KsTx2RHFtYbWdbwkvCypXNRSNHjohqgHwJtE2HJApMlMbTFGLJVaRZTZKiwcWZaaRZ14Vyjkx42z0dPhboivS0eJuUT7TxOn+qPnPHaZ2j7bxWJRhyjLtPHq6XFUDbpmmFJQIkuTDZqi93BmkBhVXfsJJ0THs1lW0y057djTYn2G5IirE2ZyzJIxyalL8FG0siRnLMkceXVpHFl1q/JUeq9TFHPl1aR5E9d+zmnqXPpi+D3FqN3RrBuR5WlcpVyexpsbZgbY4HXjiTjhRsuAKVJEtoGyGyhvohlbiQG+jOTLl0ZsCBoQyiJnLkfZ05DizMDGUrIsTYJo6c+JSkYTZs/ZzzNIykzGTLmzJgRJmcnwXIyn0Uc2WXZw5Z0dOZnBmfYRDy1I1hnOCTe4cZP8lHrwzm8dQjx4zf5NVlf5A9iOdDnli0eTHO/wAlS1FexBvnlFnnZafovJqEzBzTOlRnssiWJnTCmabEzKvMnjYoxaO/Ji/Rg8dEorE6PRwHmxVM79PLgiV34zrxHHjkdeNhHXB8G8ZHLFmsWGa6b4AmLtFpFSsc6uB4mthwz6DLH6nj62HBYx0+Y1EabOKTPT1cabPLn2VlNjTJQ6KCTCIpDiENgMVFQiJGjMpFGM2ZsufZD7KGhrsSKSDJplEjRWjQwQwAdiYmQOyZNiYRQBFM0SYKkVuQKlozaNG0ZtWRCVGsaMlFmkQOiKjXQSiqIi6HuERhkh+jnljdnoUmRLEUeeotM0TNZ46OeSaA0crJfJCZpFWFSk7GW40SAgAQU7AQ0BQAhgSwBgUNMaZIwLTGmRY7A+/tqQsuasdGc8yZzZZbonN0efr5qcWfOZcL+Vs+jzYXKzino230a5K8atofJR6q8XLK6VlS/wBPTSumVl5KyWNSs6c/ip4OXZybdvARpYbkjO7BqwjVfYdURB7TRuyaYzkyo8kyEppDTG3owk/sX8iZLhcrLqt8EN0ke/oNLvlHg8TA9kk2e94/yMceSKdDYPp9N4vdhvaOXiX/AOp2eP8AMY5YlH6nrY8scsbSRFx81/4yu4lw0Ki/6n0r06krpGM9L+gY8jHpof8AojphpIP/AAR149I3I646b41bQP158fHQl/gipeIh/wD61/0eh/Ijh7SMc/nMeLvaNP1wvxkId40Y5MWHF3jiRq/9UYua2nh6v/UEMt00DK7tTqdPC/8AbgeLq9bg5qETg1WueW6keVmlKX+TGmV1anUQl0kedkkn0Di37D42NhlZ2aRkS4UNRESytFNItZUY/G2P4Wypjo+WInNMyWBlxwuwWE1uMnhbO2GBm8NOQkeV/GYfxme5HSX6NV49v0QfPfxmXHTM95+Nf4Kj49r0Sq8SOkbG9Ez346OvRtDRbvRmtR4eHRPjg9bSaF8cHrabxTlX1PVweM2VwZVl47RNVwfT6LDsiYaPTqC6PRh9UZ2O3PjeDpEt8kOZO8zrTa+BWQpDMNKXA5TqJDlRjknwTGthyypP0YZM6RjlyUcGbUfsYjpy6tI4c2t/ZyZ8/fJ5mfUteyyU16GXW/s4smrb9nmz1N+zGWe/ZtNjtnqX+Tr0l5K5PJxY3m69n0vifHt7SdeGx6WhwPg9/TYaXRy6XT/HXB6OOSijlihqiXIc5WQUXVkyVFKVImUrQRlu5qy0ZOP2stSpAN9GbKc0ZuRQvZRFjcgIynDmZ15JWcmVXYxXLY0P4x7aOnPjNRI5sh0TlRy5JmjYwkQypci2lNZSMZ9HRKPBz5OEEcGY4cqs7M0uaOSfJRySjchqDs1ceRoCNjE0/wAm1kSYGfKM8kmkaNmU+SxGG5/kuMifjHtNjoxzX5OmGRHDE3hZkdDaZlOJSBsiueUeTpw8IyatmsETEdmOVHXjmcMIs68aoI7Ycm0Tmxyrg6ITCV0wN4KznxuzphwNQ8kPqeTrY8HtSdxo4dTpnkXCNSsdSvj9Yuzx8nbPq9b4yXPZ4Gp0Tx2GcefE0SEoUWkVMZyQ4oqSCLooTQUNsVlRLMplynRjLIVESM32aPkmigRaXBF0UpDA65HQWFl02AdismwauyRADQVHskaIKcqIcwkrM3EgveXHkxSNoAaKI9pcehshWbJvkpsFC3ZWWkGa8NGCVGifAEZI2ujknj5O1uzOSsEcKhTLjwbOBLxsKluyS3jaFtAzZDNXElxCpRSFtGgbFIr0Sikwahispio0bCKFQwgAAC6+ner/AGL+YvycCjaE8Zh013PVIrDlWWdHnbTXTT+LJYZ6fTaHSXJPaj34aBZYRW08LxWu+SSjZ9jppbIRl+iMvlfNeE2472+j4PX6N4HI/aNYv5kdvfFHwn+p/DvDGT2+rCvz6EuTpxx3GHxbJM2xT2gVPHQ4xoJ5rIWQBziYSjydF2GywrGETphHgrHgs64abjoDl9Cg2sids7P4v6GtH7oDv8fq3Ca+x9l4vyO5RjaPgseHZKz2NBqvhyJ2Gn6fge/EmayxcdHzeh82lBR3dHo5PMqGJPcDXXkksHLODU+YilVrg8nX/wCoFNNbj5zUeR3yf2Cvc13mlz9j5zV+Qee6kcuWfzGcdNREcueM539n/wBmMNNJ+3/2eotPfo6cOkv0FeZj0j/YS0jPocehv0brxe7/ABA+W/ifotaJv0fULw9/4msfD/8A6kV8ovGuXopeKb9H2ePxHH9TVeKr/E1yj46HiX+DaPh2/R9atBt/xLWn2+jVSvlV4Z10C8M0+j63469CcP0ZqPl4+Ka9MuPj2vR9C4EOH6Ijx46Tb6N8eKvR06j6Kzzsmu+O+TUHVkqPo5Z50vwefqfL8f2POn5Td/kB9HikslHoafF+j5bR+TquT3tL5NSrkxfVfQ6aax1wj08LWT0fNR1e72exodTSXJiq9aPBW4xjl3FpnG+u3PirF7AKDS0XfBndIHLgqicjlzTpFzyHFqctRAwz574PMz5a5Hmz/Z8nnavU1Hs0Fmz2ednnusxyarl8nPPU37CJyz2Bh/3jmzZN56Xh9P8AJt4Ky9rxWiuuGfZ+NwLHXB5vitHt28H0GPHsJfDn1boEDAw6AAAgQhsT7ARLGJ9AQyGypGbCCxNibIkzUCnIwnIqcjCUiit1EykZuZDnZqM31GVnJkOmfJzZEEZ3wUpGcnRO7goqcuDkyy4ZpOZz5JcMo4c3MjFo0yy+xk5FVDQqByFuKCiZIrcS5FGckRRcpEJgDRLRoS0GUxR0QRlBHRjiGhREnRvsMpwCs95vjlZySVM3xOgO/Gzpg+DixyOqEuCUbx7OjG+TmizfG+TNSu7Gzpi+Dkxs6YvgzWWqdFxkmZLk0hHk1ylZauClHo+b8jprvg+ryY9y6PN1ml3XwaR8Nk0+1sx27T6HVaOr4PJzYNpUcMiKNZwoz6NRmpfA1GyZDjPab1lnkxnJONM6smejjyZrkVFKVFWZx+xqocWVE1Y1EH9Sfm5oC+vyFi7VgYZOxAAAACAY0yQCtLJYrFYQ6NIomPJpFBWkehsSGFZ1yax6J282UgAAAgQhiALAQBCn0ZM0kzNlEsllshhEsRTQBCSKEuhgIB0FFQqCh0FBSoYUFBXv4ce6JpPDUbPR8bo/lxrg9teE34b2GXR8Q19qKWKz6iXgfv8A0H/4Xav6ipXkeMXw5d37PtNFq/mhGN9cHzuTRfAro00Os+LJ30yI+1xr4kmeN/qBfzINd8UEvKboJbvR5eu8hSfIHw/ldF/F3OqPHxPefQ+WzfyU+bPChi+IK0+Cw+GvRrjlZ0Rx7wOfHhN44DvwaS/R0LSV6A48eE6oYqRtHFt4NoYr4CuGXDHv9Hpx0HyPo6oeGtXtA8Jq+RQdTPon4fj+pn/4ipXtCuLBl2Uzqza7diUbOleM4/qXj8RvlW0D57I90mxY9N8j6PrYf6dtXsOjH4D4+dgV8xh8bX+J3Y/Gb/8AE92eg+JdEQWwDzF4na/6m+Px+30eisllwdkHNi0lejqhg2+glk2HJm8hs9hXa/qYy1Gw8+Xkt3+Qvm+RAeh/5Hb/AJGcvK//ALHm5F9Wzw9Zr/ilVlg+ol5X/wDYzfk7/wAj4rJ5en/Yxfm6f9haj7r/AMhfsUtfS7Ph4+c5/uavzFx/sZtR9VPye3/I55eZr/I+Wn5Ld/kYy1l+wj6XP5b5VW48zNl+Szy46i32eho18zo1By5sG70cstNt9H1sPF70vqY6vxfx39Qr5iL+M9LSauq5Fl0P6M44PjMX1X1GgyfLR72mlto+Z8XPbR9JpnuoxfVezp5Wjqizj0/COqLOd9dp41THZKCwqrM5zpA5cGGSf1Cs8uU8/U5biaZsh5upy0iji1WSrZ4ur1N2rOzWZ7s8PPPc2Uc2XUU3ycstZ+y80LMcel+R9FiO3Qv+TXuz7XwWg27eDxfAeL3OP1P0Px3j/i28BHdo8OyjsYow2DbJ14cpAAMNmuUD7FYmyAZLYNkNgOwbJsGwIkzOTKkzKTLEJszlIcmZSZRE5HPORpkZzTZQpTFGVmcnZUDTN9aPkwyI6ErMsiKjimYyZtlOabAznIwnLhlzZhN8Mo5MsvsYuReX+xlIoTkJMTQJFVVktlUJxKM5MlMpxIkqArcLeZORDnQZdUJnVikeXHL+zoxZ/wBhXqR5M8kbMYZv2U8tlVlOPIRdCnMlSIrsxyOnHI4ISOrHIlHbBnRjZyY3wdMGZZrtxs6YM48bOqDJUdETaLowgbVwJ+JWqlZlmjuKj2aOFl2I8TV4Ls8bUaS74Prsun3ejkn4/d/iWVHxObSV6OLJh2n2+bxVv+p5ep8VV/U1qPkp8EdnqavRbPRwvHtNMuTJis5p4eejunwYyNowj9WarLSqyJIlorJznZko/ay6GkUXHiI7FdILMMgAFYBYWArCnYWKwbAGwRJcUBrBG0YkY4myQUqGgGgK28EM09EPsBCGIgBAIAEMQQmQWySiWQzRkMIkVFCoAGAwhUFFUFFE0Oh0FAKgooKCvsvEaioR5Pqcer//AK/aPy3S+UeJJWeg/wDULjircyNa+z1Gu229x5Wp81sv7HyObz85tq2cWXyUsn5JU19BrPPNprceXj83KM27fZ5LyvJLkHjpWFfS4vPOX+ReXyXzL+x8ssmzo0jqpIhr3UlkK/hKfo8aOucfyd2l17lVsD2tH4hTrg9FeHUfR5OLybxez0MHlPk7kFd2PQKPo3joL9FaXMslfZf9nt6XBGa7X/YHkR8Xfo2h4pL/ABPejpkuhPFTA8qGh2+jeOPaqPRjhsJ4EoN8AcccVlvSpRs5dVqVhTakjwdV59421uKr6GWNRfowzZlgjdnxuf8A1NJN/ZnDP/Ucsz2tsK+uy/6heJtb+jh1H+rHFf3PmMurc1f5ORv5WQfRS/1bLI63smP+onL/ACPn/wCNXoNm0D6F/wCoXH/Icf8AUrX+bPnlDd6NI4Ar6H/+QOf+RL8j8vs8Hbt9GctTLH+QPq8OXd7N/n2RfJ8lj8nKP5OqHkJTXsD0tX5XZa3Hz+r1fyys1yyeSXRk9O5LoDzcmW37M07kd8tI7/qVj0ly/qZo58WLc+jvx6a49HXp9F1wz1MWi+q4Mo8WOiv0ax8ff+J70NCvwdeLQJ+ixHzcfH1/ib44fxuej6LLoVCNnzvmZfx4uvwag9fx+s+RpWehqI/Ij4PxPkZLJTfs+w0+p+WrZVZT0lnJm0lej15nLl5M1UaPHso+i0Mbo8fTQ6Pc0aqjnVephVI6EZYUbpHO+u/PhrpibGZyYVM5HLlnwbTkceaXDA488+WeXq8n1O3PI8vVSuLNK8jU5LbPMyyqzv1PDZ5OefYBF72ez4nQfM1x7PF0Sc59ez7bwOn5XAZfQeI8WsW36n02PFsOLTx2JHYshUbNGclRUXZOQnXhEDsVis5tnZLYyWwE2Q2DZm2EVYnLghslsAlIzkxtkNlglszkWyJGoMJnPI6JmEkVGDLgTIcGaStkY5GU5HPll2EYZWck2bZJHNORRlNmE3wXORhORRz5H9jJseR8mbZQ75BMiykyi7BsSYMCWQ1ZbEkUYygYyidkombgRHJRUZUbPGRKFFVpDJRayHOuC4sKtzGpEDTIOiEjqxSOCL5OrFLklR6ONnVBnFiZ1wJR1wZ1Y2cWN8nXjZB1QdGu/wDZlFcGiiZqVpCRspGEUbRJqLuyoxsSRceDWoieGzh1Glu+D07M5w3F1K+W1fjt98HgazRfHfB+gT0248nWeNUr4Nypj871EXF9HN7PsdT4dN3tPNzeMUPRvWceGsdoTxHdmw/GcWSdOjWssZRoy3cmz5JeNdlE2AAZYFhYCsKYCsLAYmAmENI1hEiKs6IRCrgjRCiht0FJ9ggADRPgh9hYrAQABAhDEAgAAE+hFA0VKhkM0ZLCIAbQAIYDABjSCioVBQ6CgFQUUAV5bm0weRtUJ8iogXsqKsSRS4BFbFFWLe3wNttUTXIUNWLbRQEUqLhkeN8Eg1YHRHVt9lryM4dWcXRSju7A9XD57Lja7Pq/Ef6gc9tyPzySpnRp9bPA1TCb/wBft/jtZHPHmaPTjGMo3Z+VeI89JbU3R914/wApjyadt5F0FepmyLHFuzw/IeVWLFKpmXkfKQWOVTR8H5Ty85ZnFXTKro8l5+byuKbPEy+Rlkl7MMk3lluZOwLrRTeR8mixKK3GUU0zVTbVAXGV8HViguzjjwarK0QehsTXY1hi/wAHnPUtDhq5AepHTxXtGiwo8+GqbOnHnvtkV2Q0in+C/wDxUZ/gWHNH/wBjvw5oP/JAcD8Ok+jSHi0vR7OL4pdzR24sOB9ziB87HxdtfU7Mfh04/wBT6HHptO//APJE7IafBt/vED42fh+f6ih4mpf1PtP4eKT/ALRKXj8Xe5Eo+YxeNqPR0x0VLo996SCXDRm9MvRnB4601ejfHipnpLTEanEsUL4ER5HlJrFiu/R8F5jU/LuVn0/ndZUGk/R8JlzPLkkn+TcU/G8Zb/Z9dosqVcnymnj8fKPW0upaoqzx9Qsil7MsjR5+PVMt52zNV6+l9H0GihdHzehndH1Hj+Uc6PQxxSNUc0puL4KhNv8AJzr0c+N30YzLvgymyDHIzizPg7JnJmX1YV5ed9nmal8Hpam1Z5WS5Saouq8jV3TPMWN5JtbWfRy0rn2jTSeLUp9BHF4jx7nNXH2feeN0CxKLo5/G+LjBp0fRY8CgkXUaRhSKaoltoE2xqNISHJ2JcDqyUiLYWVQmYUmQ2NsiTAlshjbJYEtkNliaAzbIbLaIZqREkvosmRoYzRhJHRMwkUYTREWVkZjuKjSUjlyyLlM5skioyySOeci8kjlnMombOecipzZjKRREkQ0U2IonaUkILCLSQ2kRuYt7KG4kPge4XZRDkwTLcSWqMh0ZzQ3IiTKIY0Sx2FaITfJG7/kW7kDaL5OrE+TjgzpxuiUelhfB1wfB5uObO3FKzNHZj7OvEc2JHVHhGVx14zf0cmOXJ1J2jPSUJmsGQkaRVERvHkujOLNEUwAlZSSKVF1MJY7OXNhTs7LRzZn2blTHlZ9OuTyNVp1TPazyZ5eptxZuVl8zrMKTZ5GbH9j3tYvseXlgmbYx5slRm5HXkgjnnGjSMrFYv8i6MspAbVENgUBG4HICxdmMps6NMt9WBpjR0wQljSLXAVRLCxAMQAArGKhgAhiIoEAggABANDZINlQmSxORNgUxDXI6CEUhCsC0BFjsqKCgRVAICqCgryAACAGIYIAAAoGAAAqGACaFyjWCT7CUUFYPkKLcSWmExpj1M8T+r6PRw+f1GKO1X/2eTQUQ/XqT81ny2nfJhKfy/ZnJFcnRF1EqnxdGiSZzOT3m0JFGyiPaODTNaVEVhz+ANXElxAmrBquh8h2BKm0Us8og4qxOJFUtbOJpHyWWP5MNli+NgdsfMZo/k3h57NH8nlfGxOLQHu4/9RZrXZ6mm8/N9tnxjbRpHUzh0MI/RMHmr7meji8tBrmZ+XR8jlj0b4/MZk6JYr9Ux+QxyfM0d2LPhl3kR+WYfL5jux+Y1CXFmbB+nvJgUeMkTx/L6mEcP1lfB8hDzWpfDsM/kMuWFMqPM8pqJZJSR4Cxvc3Xs9zLFzbbOSeFIsVyxVHThlRk1RWJsqx6GPIzqxtyOLCmz1NNibrgxWnqePi3XB9VoE0jw/H4UqPpNJBJHOipK2XBFOKBcHO39d54foxyM0bMZjVYTkc+SVo2mc8xquLNGzjWBOZ6M1ZOPGnIaMsWkTPR0mhSl0aYMKPU0+FKhovT6dRo7XFJExikkNsaiHEcYpDRXCGsolwJMcuSVwLSKbJbHZDZlSbM5FNkNgSyWNshsAbIbG2ZyZUDZLZLZLZqCrJkybE2UTNmEmayMZGoMcjOdm2QwbKiJM58jNZs5skijDIzlyHRNnNMIwmzNlzMWyhPsBWFlQxCsLKABWKyoY0ybCwKbIkxtkNkUmzNlNkNlCDkLAA7ABkFxOiDOZG0HySq7cR24TgxM7cTM1XpYmdMXwcGKR1wkRXVjfJ0xlwccGdEWY6jNbqRpFmEWbRJg3iy1IySKBjXcG8xbJcimOjeyJckRkVa/JqJjjzRR5upitrPSzzir5PH1WaKtWbYx4etX2Z5Od0epq8kW+zyNRNejesuPJP7GEnY8kvsRdmtZTXNjD0IOf8ATZLQwdBcQ0KimEVYE7LOrTx20EIWbxikFWIYgAAAAAAAAAAoEMRAhDEEAhiABMpA0UZNCpFtCoIEikgSGwiWQymZtgUNEWx8lRomOzNNjt/gC7E5Etsl3+ArhAAIgABhQAAAAAAADAKav0KVo1xuPsnLT6BqE17G6M6kLldg1biQ1RtBquROgrNcM1UvqQ4/hCqVgN92VGTTBdEso6IZDohkR5+5pmkMjIPSTTG0jkhlOmE0wFKJKi7OhJMpY0/QHLtY1FnU8RPxhWUYFrGi1EtIDJ40ZSxo6mjOUSDinAhxOmcGZOLssWMXBmcbUzu+NbDnnCpCq6dPJcWeth2uKPBxycZHpabPyk2Yo9aGNei543tFp8kWkdu1SiqCPL+Jt9GOowyS4R7McFvoM2lTXRZVfLSxT/Bpp8E36PbWhT9HVp9FFdoa1HBp9LJ1wezpdK+ODqwaWC9HqafTw4OdrULR4Wq4PVxXEnDjijdJIxb+qtcgwQmc766zxDZEui2jKbpEaYZGjlm0a5ZHJKVyAHyzTDBuRMIts7dPi+wV04Mb4PQxqiMWNKKN6pDUxVgnZLHEai0JyCRlJsajVUxSpEQkOTtgFkthZLYCbM2ymzOTATZDY2yGwG2ZSLbM2VEskpkmoEJlA0agxkjGSOmSMpIqOTIjllwdmZHHM0MMjOXJI2ys5JsDObMJs0kzGTCMZsxkzSZjJlBYWTfIWVDsLJsLKh2Fk2FlDsLJsTYDbJbBslsgTYmwZL6KGAkNAMpCGAXyaQfJi3yXF8masd2JnZjkefjkdeORK078cuTrxyPPxyOrHIysehjZ0RZxY5HVFks1K6YM3gcsGdMGTEdCGyExtkaSyGy2Q0EwKVClN1wRKVMcXZUebq5z5o+f1k81ukfV5sO6zytRonJ9G4y+Sz/K/R5+WOW+UfX5fHNp8HnZ/HtXwbc6+WlCe7lBTR6ubS7X0cWWKXBWXLYCl/YDTAbJcgkZ2FWuTXGjLHZ044gb44mrREUWwJAAAAAAAAAAAACgQxECEMQAIYghobQRGywQ0KihIIaQMF0DAzkZSfJcmYt8gaItIzjJGqkismkVtBNGioDPYGw2SQ0kFeIAAADEMAAAABgAAAAAnu9DjfsqNextL0BUXH2ZzSfRDTKin7Cp2yFUrOhUuxOiBR65BuIn+jN3ZVU2LsSixoIVDVoYcBVRk0b48nJylKVAelDKvydWKcWeMstezpwahLtkHsNx/BLSOWOqizWGVMC9o6KTT9DoCKJaRbTJpjFQ4Jowlie7g6qNI4rQixx7GonNkjyetLA9vByz0k3LgVXn7HfBrghk38HbDx8zt02hanyjFQaTFl4PZw45KKs00+miorg63iSiqRFRjimbvEmuiMcWpHVxQ1Y51gj+C1jjH0W2vwS3fQ1WuKj0MB5+GLZ6ODG+Dn1f1qO3GzVMwjFo1iznarRMOCUxN0Z12nhto58r7NJSMZ8kVxZd1nOoNyO6WNtjjg+1hU4MTs9PBiSoxxY6O2CSQMbxSSKfRCfA7IEOIDQZNmckaNktoCIpjZSomTLKiWyWwEyiWyGymZyAlslsbZDYQNkNlPoxk+SwNsV8kOQWag0Q2Zpjs1EEjJlyZk2akGOY4ch25DjyIo4cxyTZ15jiyFGUmZSNGZyCMZnPI6JmEijNhYn2BUOxWICoLCxCAdhYgKEJjJfRAmJjEAJFIENAAMtIhhCY12IZK1G0JHVjkcUXydGN8ma1Ho42deNnFiOuHRlqOzFLk7IvhHnYpcnbGXBWenVBnRCRxQkbwkSo7YyLs54SNoszWl1YbRoZFjizRd8BjTXZ0zimZ7aDK4xTXJMsUH2i49Cb5NxhnLTY3F8Hl6vSw2uke1a2s4tSk4s2w+O12nSb4PntRFqbR9j5CCpny+qh/uM0xXlOP2E0dMocmMkaZYSRCi7NWrZUIALHBnVCIoQNkgBAU0SAAAAAAAAAAAAABQIYiBCGIAEMQDRTZKAqEwQAgGJsZEgjKbOeUjXIznm+QNFItTMYpstJlZbxkaqRzxTs0jYHQpFKRirK5CvMAB0FwgGAMIYAVAAAAAAAJsqLJYIK0tBaRCAmBtsXIxlB6F7GIC6VEsEwZlGbBMJCXYaVt4BxZaFJgZchyvZVg6Jq4qGSSfZ2Yc9ds4LKi3fY0kj3MWdM64SUjyNNjlKuT29Jo5yrsauBYtwfx2z1sHj5NG60DXoaY8JaZ7kduHSSaO/+LUlwdmLHGMeUPpZHlrRv8Fw0SvlHq7Y/gpKP4JelxxR0kFH+pUNPFS/qdvAcGbTEwgki3VdCtBZnTAkkDkS2S2S9Y1IbkODsimzbBibJ9NTl26WF0erhgl6OTS4WqPRhCjFv61OJiJR/AkmaSQ4xMtfMQkRJM2aoloy052mG02aFQVMYr8G0YL8ExRtFfoGiMDWMWOJYXS9AMC4hobYgYwJv9k2NksmINwnIlklwqrJbEyWGdDZDY2yGDUtktjZDZQSf1OeUuTaf9Tkle4sF2CJRSLBQWAmWUKTM2WyTX1gxmjkynZlOPKXUefnZxZGdmf2cGVlENkNoUmQ2VETZhJ8mk2YyNCH2KwZLKitwtxmxWwjSwsiwQRYCCwQxMYFxU0CiXRSQMQosaizRDtEMSkZSize0QwvyzqgspolkqzkLs6MUqZzo1h2TGpy9LFNcHXCao8zG2dcJkxrHfCdM6Y5EedGfBpDKPD5lelHIbQyo835qQ1qKIs4exHOkbQzo8Ran9lx1VezNX4j3lqEaRzJngrV/s6MWrX5Ivw9aWREOSZxrPZrHJZMT4jqUkkRKXJmpWHLNa53mNb4ObNFuLOhdGeWtjL9ONn6+f10Pqz5jVYn8j4PrNbVM+f1SW5mvpnHjZIHLOLPQy1ZySqzqxjmUHZrCNFJcl7QYcaLtGaRaRTDsllNEhAAAAAAENAAANAAIhDEABSEMQAIYgBdg2NCkioVjXJFMqIFGc2aWY5JAxhkZzyfJrkZi+wY3g+DRNGES+SpjdNFpo50zSMgmN0VRmpGimgPLGAUFABQwEAwKEAwAQDACWA2AAADQAACYFBRKZpX1Az9lbSW6YfLRgwSVIzuipZNyDHjeSVIH/hbw3Wd+HxOTL1Z6GH/TOWfqQX9eDGNujqxaGebo+jwf6Tn24yPa0X+nHhq4sjePjcfgc0/z/wBHdp/9L5Z1e4++w+NWPuC/6O/DGGP/ABj/ANBcj4rSf6anjq0z2dN414O0fSrLD/1j/wBGeRKfSBjzYKOPtA3F+jfJp7/Ji8LiQYyxKXNIzcKOrdtVGUuSDGgKaJYUxWAiUVYrJsLM1VdhsCHJ0xxma1GeLFZ6Wm0/XBOnxHqYMe2uDLUVhxbTZqi+iXyRuIaKSHRLdEVMyBydgjFUqBRLSLUQIUTSKGolKJQ0V6FQwgGIdhTBhYihMllMlgSyGUyWwlJkMolhhLM2WyGUSyGUyH2ApdHPJfY6GYtclVKRQUBQxAJlCZPobBgZZTiynblOHKaiPOz+zgynoajpnn5TUHPJkNjmzJyKhSZlIqTM2aEslg2JlQmQUyGEUMkYRQWIGwKsaITKTKqkUuBITYVVisiwsixdj7M7NIhqQNE0aUG0iyISNIoFAtQI1IuDN4yMVEtBcbOdLsI5eTKXRlHslV3PLwR837MG+CFz+SLHZCbfs1t/ky02Pcdj07JWmDm17NsWZ/kyyYWiIqjK49XFn/Z2Y8tniQyUduDLYZr2ITs1TOLDO/Z1QYc62vgxzS+jLXRlkjaZXGz9ePrHdnhaiNyZ9DqcN2eVm0ty9ljGR4WbH7OKUfsz3s+mqPR5OXDUmdXNzxXJo1wOMafQ5Lg0M0XEgpMqHJ8EXYZJEQdgWAAEAABAAAEQCGILAAAFIQxAAhiAaCgRSRURQnwatGMwqZTMZSsJyMnIIUjN9lt2TQFx6KFHgCirGpECsI1UilkOfcFgIYDoBAFAUAAAAAAAAAAAJAUgJYIGAD9ESfJfoyn2BUXybpfU5o9ndpsfySUfyBzrFvnR24fESzdJnt6LwPyVPYfQ6TxXxJfU5t5HyWH/AEvPJzTPU0n+lHCV7GfYYofHFHTDUVwDI8TSeD+Kvoexi0qxpfVHR/JsUs1jWsXCSj6Rqsy/RwSymUtTt9k1rHpSnuMZKzijrP2aLVX7C41f1F8+0xlnv2YTnZNMdj1iMZ6jccbVlRiExcnuYiox4EyGRLIZbIYMSxA2IhhiGNIlVeLs7oHFj4Z142Zqx36c9LGeXp5HpYnZlqNpCSL22PaZrURVGU2bS4OfIGi7KRKLRiquKNEiImsQKSHQIZRAFEsAAQwGDCxNgJkspkMoTIZTEEqWSymSwwhkMtkMohkPstksCSGjQVFVFEmjRDKJJY2SUAgCwM8px5Uds+Tkyo1EeZqF2ebm4s9TULs8zOjUHFkZjJmmQxbKBslhYVxZpllIlsc3yQygbJbBiYQ7HZIwikwbEhSYDUi4swizSLCtbJciXIiUwq3ILsy3GkOQsWjeC4M4xN4IjcFFpBRaQakCiWoDii0RrE7B7SxhcZuPBnto6GjORKMZ8EwkGV0jnWSiLHuaGVtHr7bPntBnpo93Dl3ErUjLPj7OKao9XJHccWbEZVxNnVhlXswljo1xqgmR6mCZ2458HlY50dEM9ewxZHpxlZTVo4I6j9m8dR+yuVkOeDccmXScXR3LNZOXJcCxix4OrwVF8HhajHUmfR6ydpng6p8s6uOPNapvgmfQ5y+zM5TsqIGkKxplROREQVGkuRJDQwAAgAACAAAgBDECAAAKQhiABDEEBcWZsFIqtWznystyMMkrCMJszsqfJNANDBIKAaGSFlDfYhioIVCoqgoBgAAAUAAKgoYAKgoYAKgoYABSRKLiBEhFSJAfpmM3yb+mYT/sEoj/AGR63jl/vRPIj/ZHr+P/APyxBH3/AIuX+yke3CNxPnfG5KxI9vFm+qOf9dm88fBzSjtZq81oxb3DVT8lB81+wcLF8ZGjcrMZxs0aonsNMowo0UaKSKommIEy2hUDGbBSobRO0mmLWQe6zOhhA2Q2U2QyolsaJZS7JRSRaRMTRIzVPHG2dmPGY4I2z1MGC6M1SwY6PQxKhQwUa7dpmtRtEpmMZl7rI1ETZzzNpsxkFEUWiUWjFVSNEzNFoDRMZCKQ0VRLRQS6Ay9jF/kMoYMAYEsllMl9FEMEDGglJoho1ZDDLJoho1ZmyjNollshgIdcAuxlENGbNZGTKM2SymQyoBIBgTJHNlR1M58qNQeZqEeVqPZ62oXZ5WoNQedl9nPJm+YwkUJFeiV2X/iaRzZOyC8n9jMqBksbJYQxkjCKRM2UicnQGafJakYplJgi3IiUhSZm5BqNouzoxo5IOzswhuOrHE2jEWGJrRGpEUMbERuGpFKRnYbiNN1INxjuHvCt9wpcmcJWzdRtESuPLE45Ro9bJitHJlw0FjPTT2nu6LLuo+fS2nqePydErUfQwW4jLhsenlZtMyrzMmKiFGjryIwYMRdB8tEyM2GLHQs5rHUfs40jSKKxkd0dR+yp57h2ckUW19Ss2THNqJ7rPJ1Ebs9ecLOPNg46NvL/AF4WSHLMHE9PLg5ZzvDyUcdCujqnio55Ro0ibGEYjaorJAABAAARAAAACGJgAAAUhDEEAhiQCkZOVGkznmyhuZDdkOQRYDasnaaJCaAgB0AEsAYFAFgKwh2FisW4CxWAAFhYAAWMQAMBDAAAAAdiAAfIAAARKFlgBntp2dOmz7MsTBijxNMJ/X23itXucY2fZYMW7TKR+Y+K1VaiKs/SdBn3aOPJyd54J8OjPdydE43yY7eQpqQ2yWqJsNFNkJlS5IoirUgslDIp2FiAAAACChNFLolsQRLgxb5NpGTjyAlyaJEpFpEoIvk6cUNzMscLZ6GnxcmaN9Pp65PSxw2mWGFJHTXBlVKQ27JGZqwdBYrE2RuFJkMbZLAFKjSLsy9msDF9VrGNmiiTA1XRRNCKZIFJg2IbAmhDAoVisYgEJjBgTQuihMqUrJYxMIlolotksozcRbCxBEbA2lCbKM5IykjaRjIqMpGZpIzZQhWDEEPsyyI0uiJ8morz88Ls8zPiPXzI83Oag8nNiOOcKPRzHFlKjnbpkPJ6Kl2TsNDOTszbo0kjORUZuRO4bXIqCKTKEkFhFbiMkgbJ/sBEeSylCgZCMZujK7N5KxRx2GorErO/DEww4zuxQoOkaQdD3lKInEjcNOxtDihvojcYsVlNCoKaHXAdEuVBVXtLhmOdysE6Iju+SzLK7MFlB5LQUtlno6HHVHHjVnqaONV0SrHr6aNUa5EZ4XQ8k/2ZVhkOXI6N8kjlyMDOUibFJgmGWqGnyTF8AnyVl14lcqO+OnUsdnn4P7I9jBzFIrN8cb0vJll0dx6PbjgT5HLTJo08l9fI6nR7V0eVlxOLfB9xqdCnHpHi6vQVboo+ZkrM3is9LNp9l8HJJNFRyvFRlNHZtsxywo0jkfAtxbRmwye4aZA0wjRIKJsHIiG2ZuXIORIgtMdkoZRVCoLG2BDFY2QwFN2YyRpJmMmUQ0NKiXIFIC91ESmBMkEUnZrGNowibRlwBnPhiKlySVQAAEFC2lAAgAAAAAAAAABiABgAAAAAAAAAAAAJkPg0Il0Eb6HK4aqLs/RPE6u8EVZ+aYXtyxZ9d4jV8xjZzrrzfx90neNMy/yJwT3aeLDmyNKl0Y+zV8onaRpDJLkiAoQxIZGgAAEAAAD9ESZfozkIsS2CRF8mkQtPaOMbZSVmmOFy6JUa4MVvo9TBh6OfT4uT1cWOkjNFwx0kaOPBSQNGarJqiWXIykzN9WBsmyXIncZaaWIlMdhYPZrEyRrEyN4dGq6MoGqAQhiKAYhgIRQgEJlCKJEMAESyhMJUvskoRUSxMollEklMQQmQzR9GTKJZlI0ZnJlRlIyZpIzZUSxWNktgMhlJiNRXHn9nmZ/Z6udcHnZoGoPNyRs48sT1JwOHNEqPPmuRPovLwzJvg2MpsykzWZjIIzbFuEyQjVMLJiWkESxJ0a7ROIGe4NwSQkQhWXCxxjZrGAbnrfCjtxxOXEqOuBHSLJbHaIkyNxaYXZFj5DQGkKh9IgGYzZUpGE5AG7kTmZSkyFJthW280hJsxgm/R0QgwrownpYJU0edDg6ccmiVXrwzUE81nDDIy97ZkayyWZSZLmTusCZMEJivkI1iy12ZRNY9lZdOH+yPW00ujycXZ6WB00VmvYx1tRoqZyQm9popsPN/W2TGmjz9Tpk0djm2Zy+xUfO6rSd8Hj5tPtvg+wz4FI8nV6ZKzSPmnCjnzI9PUY9t8HnZEzTNccomUkdMomM0ErBoaKaEkGQJl0G0IyY0i3EloQIYCKHYmxWJsAbJbBsmwFNnPJnQ+TOUCjmbZSNHAVBAkDQdCbAT4FuoH2KmBV2AIZVIYAEAwACRAAAAAADEADAQwAAABgJDAAAAAAAAE1wMT6CIXErPV8VqWtSkeSzo0E9uqizGOmv1PQ5FLTQOxx4s8TxWoTwwVnvcPGmZVj7B8Da5Imw2zkyUJsIkVSGJDCgAAgAAAivRlI09EyQa5c77NIEuP2NccXZG22ON+jqw4+RYcdnfixEqNMEKO6PCRhCNGqfBmjZMJMyTByM1RJmE5FSkYyZmqTYrE2TZFapjszTLTI00RrExibwIjeHRojOHRqugJF7KYvYAOhDKEAwAkBiAQhgUSJlEsIkTKBlEMlltEMqJYihBEvoykbS6MJdlEMykaPoykVGcjNsuRk2VASwABMaEF0aixGSKZx5saOyTRhkpmoPNywo87Oj1cyXJ52eN2aR5GZOzFnbmxuzlnFr0aGMjKRc7RlJhGckTQ2ybCLSL4M0x2wjRyQJpswbkODl+ANppejJJ/g1inI0WFv0ZVnjR0QiR8Ul6NsUH+A1PW+PG30jdQkvTOvRabdVo7no+OiOk9eFNuPozcmdeswyi+jhcZINxqnZrHk542dEERVUTJM2Uf0N47QHDK7M3Ftnf8PPQ1p79ER5jxN+hwwSb6PWjpU/RvDSL8FjXLzMeB/g3WGj0/wCMkTPEkFcKgaRia7ENRRKFHgtNEOkS5GTVTYRZk2aQ6CEwXY2NIIqKNYomMTWKKjbGuTuwvk5MaOvCuSp/Ho4/6mqRlj/qjZEcDaJKYiomUbODVYrs9BmGaFmoy+a1eHukeRmxNXwfV58F3weNrNM1dI0leFKJz5Ez0p4X+Dky4n+Co4ZCNJwZm0wzVIpURyFsiL4IaC/2MsRLJZTIZRLZLY2yGwG2TYrE2BpGmabEzCMqNoSsqUpY0ZShR1qmKUEBwSTIo7J40ZuAGCQ9qLcSXwBIDAqgAAIBgAGYAAAAAQAAAAMQAMAAAQxIYAAAAAAAAPoAAykPBLblTHKqI6dkWvsPEaz7RjZ9pp8qlhifl/is0o6hWz9A0GoUsMERt6klwYTN21tRjNoyrBjiDocQ0aGFBRFArHQUEKxioZENBQrKXLI3x6jZydGLH9ghibOrDgdojo6NPi6PRhiqNmODHVWdqcVEDFqkSmXOSMk+TNRcnSM3Ic5KjFyM1VSkZtjbJM1SYITCyKtDshMpEajeBtEwxm8SI3h0aroyh0aIAEMQQDCh0ACGBQhDEFIRQghEsoTRRAMdCKJYmUyWgiRex0K0VCl0c8uzeUlRzyfJRDMpGkjKRUZyMmaSM2VEsQMAhozyOi7Mclvo1GohyM5MqmZyNDkzs450zpz2zmcWaiOeeNM48uM9PbxyYZcdo0PEyxaZzTTR6mbCzhywoI4pN2SpOysi5M0nuCNoWzeONsjEuT0MGNMJjm/jt+h/xpL0exjwRa6NJadV0B5WHC/Z349OmaQ09HZhxdGVkrinpfwh49NXo9dYIv0N6dekG5K08fiiqs9NYoNHBgi4HXGddkbjytfp026PHy6dp9H0mZKV2cGXHGyNx43wtGsIOztliQli5Cs4Q4NfjNoYmafE6COVYkaRxI2+NoFGiII44o1jGCM30SmyxvlvLbRz5KG5Mymyqh0S3QNmcmZqFJiSbEbY69mRi00aQFlr0VjCGyooNjZccbDK4o0iuRRiy4xdlRtjR14uzmxpnViXJUdsOjVWRjjwbxRlyTTCjXahOJUZ0TKNmu1htNo5ZYkzztXpk74Pa2owzY1I1+MvmMul/R5+fT16PqMuBHmajT3dIJXzObDRyzxnuZ9M/wAHn5cLQZee4mbOmcaMZIJjM0XRNFKSRYhMykaNmUijNkNlSaRnuVgDC2HYVQCNINkLktcFSuiMqG5owtkuTA3bTDamYKTNoS/IGc4GM4s7LRlNIDlAqaJKoGABAMQwMgAAAAAFAAAQAAAAAADGJDAAAAAAAAE+hgBm7FRfsGuCNujRS25bPsfF6hvarPiMMts7Po/Dai8qVkV92reKP/BlNM0wzUsMP+B5FwYacrs0xkSQQdGWnRwK0TuE2FXaJM2yo2wKE0UkARNGuODsUVydmHFZnprj1tgxr2ehjxxUejDDjo7IqomHQUkiXJlS6MpMgTkQ5A2Q2FDkyUxMEwqxMVibATZNsGxINLiaRIiaRQG+NG0TLGao51mtolroiPSLCAYgApFuqIRT6IJEAAAAACAAZAmFIALAnRDKZLNKQUgAREyMZGz6ZjM1EZSbMmaSM5FEMykaMzkUZSM2aSRDQRm+wKaFTNQS7FX5NFGwcaNRGEoo58kX6OuSMpQsDz5wMpQSO6eM5ckaKOaSRnJJmkyPYVzZcNxZ5eowuz6FwTgzhzYU30B85PBLcR8LTPanp1fRjLAl6NGOHFBqR6OnRioJM6MTSYHpYqpG6SaOPHkR0wyJirPW0YL8G2NJGW5DU0c3R2wcfwaJwOFZC1kA7N0V0Q5/gw32K2wrSU7ObIrZrTDYFYqBrHGaxxmsYARHGvwaKMaHRMnQCkomEmipzOaUwq20S6M947LFgkzKTLbIYGciGjVoW0Ms6BJo12D2BGDTfZvjiP4zaECVlcII1UEKETVIIjailHnoKNIrkIuETpxR5M4ROnHHkI68aW00REP6loyyuJVImJQA0iGWzNlRDFt3DZUK9lRy5cRxZcC/B6+SjmnFGkeHm0yp8Hk6nTd0fUZca/B52fCn6Ky+Tz6aSfByywyR9Jm06/B52fClZR48otHPJtM786rg4MqdljPQ3omU00YSbTJ3MrKsjZjG9xtdk0gi4/sc6rglIbTZRMDQmMaLAAACgC2AAPcxWwH6AykRRrJEUBAndlNCoMkrsaCikgMgACqAAAUAABAAAAAAACGJDAAAAAAAAE+hifQEp8mtfUxX9ja/qRqMrpnpeIzOOo7PMk+To0M9mdOyNb+v03RahSxQX6PQlzE+X8XqdyirPpYu4L/gy0ykjNqjokjGSsw0UCmKKocg0RrBGLZtjZkxVEtGyVj+JsCMcfsj1dNjuPRyYsDtHqYI7Yk6a5aQjRr/AIkXQnMw2JMxkypSMZSAGyWybGgoZJbIIHYmwZDYA2JMhsEwOiLNYs54M2iwOvGbJGOLk6IoxfUXFFCii/QEh7AQFItvgzTKsgQAAAIAAAARAAAFglkspks0qQAAhMxkavoxkWDKRnI0kZs0jNkSNGZsozaIaNGiGiohoVF0FFiCKHJAuBPk1Bm0S4mrRLAwnE4c0T0pHHljYV5mRUZXydOaJySdSKOiMlt6MZpMcXwDCsJQT9GM8ao7dpEsdoqvKnjpkRTTPQngsw+B2VUwbOnE2ZxwO/Z0YsLJfCetr4BNmixMawsw0hX+TSNjjhZrHEFKMWbRgOEKNo8AQsY9hqmhNhYUUi00jNzol5QNJSVejnyTFLKYTnYEzkYSkXLkyaChPkuyFHkuioLChqJcYhEbClA1UC1ADFQKWM3UClAIwWMuMf0bbf0FEZJIfACbCH7NYLkxRvDsI6ccTpgjDGdMCI2XRSZCGRGiZVmaY7AtszbG2S2VCY0SVHkqJmzJmkyKKjGUbObJiPQ2mU8ZWXj5sPD4PK1OF8n0mTHwzzdRgu+Cj5PU4HuODLiaPptRo3y6PI1ODbZqM9PFyQoxa5OzNGjik/sVDGkSjRIIaL4I6BSKLaQguwCAAAoBgAAAABLRNGgqAzaFRdCoImhpDoaQRygAFUwEuxgAAAAAAAAAACGIYAAAAAAAAAKwJ9mv+Jl7NV0FYtcl4frOy9oNURc/Xv8Ah9R/uJX7Pt8GXdjj/wAH5x4me3N37PudFmuMTDpHqy5Rk0aXaIaMVpKYNWFGsIbjLTFY7N8eI6Menv0dENPRGsZY8R048Brjw0dUIUQxlDDRd7eDVukc2SZKvMqnMh5DCUyHk5I3jaUzNyM3MlyA03FxkcvyFRyER1Nk2Z/IJzA0bMpMTmRKQA2CZDdlRRBvA3ijLHE6YQA6cC4OmKMcKpHRFGUVFDaBDAgl9lMlsBplEFAMQAQAAIAAAYAAgLAmQypEsqkACCFLoxkbPoxmaiMpGTNJGbKJZDLZDKIZNFsVFRNBRVDoqMpCRpJEVRQiWUyWgM2rMZxOnaZyiVXl54Hn5I/Y9fNA4MmPkKwiuCqHtoCqEaKNmSfJrCRWhLFa6M/h/R1p2gIrmjg/RvDBRrBcm3QoyWMpYy1IpSMqhYxqBe4NwVFUK6G2ZyYRW8N5k2Q5hWk5nPLIDlZm1bAblYuwUDSOMKz2hsN1jL+MI5VjGoHV8f6BYypXOoGsYGqxlxgGWagaKJooFbQIUR0VQERDRNGjVktBlmyTRonaEJLk3gjOMTeCIjoxnRFnPA2iEbpjshPgLIq7CyLFYGlhZFhYRVlwZlZcGaiHkM0y8hCRUaIiRSZnNlRlM5Zwv0dMiGBwZsCcHweFq9JbfB9RPlUceXT730ajPT4rU6B8s8jLpXGVn3+o0Fxbo8LWePq3RWXzCW1lp2dGbTbZMw+OmUJoVUaUSwEiiV2WVKVDAAgAAKAAoYBQikICGKihBCopCGuwjjAAKoXYxBYDAVjAAAAAAAAAAAAAAAAKUQJAraJqghbS0Z7h7w22siTsIvczZYfZnWmnjuMt/s+y8dPhcny2gwf7h9ZoMNKJht7SfCLitwowtI2xwoxWoh4q9G+DGVts3wxow26cOI2cKDE6Na3BrExVGq6IqiZZNpFwskjlyMrJlOaeQjUKT5MnIJTszbCtNxEpcEOZEpkQ3LkcZmLYJhHVvDcYKRdhF7hNkjogaNoIyijfGgjpxROvHA5sR142BvBUaoziy0yDVIKJUh7iCWQzRktAJMYqGAwCwABABACGFAIB0IsEyJZTJZVSAMTYQMxkW2QzUGMjN9m8o/oycSozfRLNGiGiiBDYioKHQDsqCrM5Ki3KiW9wGUgSspxsajQAomU0b3RnPkquDLE5MkOT0547MZ4eOg08uUDKSo78mKmYSxFVxP8AsXB8lyw8iUKZWmyfBSZkikwroxs1m+DnhKi5TtBS3FKZkBlG28N5lYWF1bmQ5CJaCBswnLk3qwWn3cgYx5NIws1jpq9G0cVAYLGaKBssZaxgYqJSiarGUsYVjtEo8nT8dh8QY6rFRLUTT46CqKymhUU2S5ETSZLByI3BGiQNEqdBvCE0KiuylEiFGJtFCjE1jEIqKNESkWkBV8AHoVEaFhYCCHYWILAouMqMrGmVGkpWJMhi3FGm4ynIrsHjsqMWzOU6RvLHRy5FQGcstMFmObK65OR6mpVZeWenpZMtxPL1Ud6Zo89xuzky6j9mmXmajR3Jujz8uj280exLPulVmeWG6JR4M8dGO2z0s+KrOFra2EZbKAqU7M7KigJ3DssQxoSHRQADJcgLECYASIqhUEIa7Ch0EcQABVAABAAAAMAAGAAAGKUbHtolOg3WAmIqrFQMCLRA7ApsXboVh1yDFfFaHHDciVkfRvhblMmt4uGnpXR14MW+VHZpNN8tKj18fjFjip0RrHHptH8dSrs9rRy5SOeEOdv4O/S4OTDcn69PD9qOnZROnx9HZLGYrcjCMToxxFGBvCNGW8aQibLgyToiWWvZlppOdHLkyE5MxzTyBVTmZSdkOYtwWKZm2NyM2wobIYNk2EMBWKyIpM0TMk+SkwVqmWjNM0QZXFG8DFG0CI6sZ1QOXEdUGBvEsiJZBSGJDAYhiAQhiIGAAAAAAAABACYxMoTJZTJZVS+iGW+iGBDE+xsT7NREvoyZq+jNlRDM2ashooyZNGjRNFQgHQUVEyRKRbQqIEACYAyGUIqoZMlwaUDiNacM4WzKUDuljM5Yiq86UDNwO+WMxljK05Ng1E6PjFsAhRG4miiEo0F1jQUXQ6Ims6HRptHtBrLaG022D2A1htOnHHglQ56OjHDgGo2jSNdobQmoSKSL2jUQbEpDovaPaDUpD9DokM9EyWUyGgyhkSNGQwM2iaNKJoIkaHQ6IhpFolItAaRNImcS0BouiiEx2GV2FkWFmW1WFk2FlQxWFisBjTJsLCKbEK7Gi6KiaJmSKTBhzZyZVZ0tmUo2Ueflx2ck9Jbuj13jtkyw/XosZrx54qjRwZ4Ue5lxHFmwWjSPAk9smxPUcVZ25tP3wcbwfboIwyS3HHlxnpSxUc+TGaiPMcCGjsyY6OdxKjGhpFuIUVAkVQJFFRm0Q4mzIaAlDAAAAAIAAAOEAAoAACKAAAGAAAAAAJsmzRRsUo0BO4NwmhqIBZSFSC6A0oajboy3P9lxct3QHTj06kz0NLo1uTo5NNGbkvqz6HRYG6bRnXTHRpdP8aTPRjK4qIQxJY0ZuSi+ya1jVYknZ16bs4IZlJ1Z6OjVsxrUj1sHFHalZhgh0dm1Iw6T1GyhN0OcqObJkI2uWWjCeYznkMJTsyrSWSzNyshsLAoTYWZyYWG5EtibIciqbZNibAB2FkjIlUjSJnE1SCKRojNFJhGyZrBnMpGsJExHdjkdMHycWKR0wkXDHbA0MsRsjCGhiGAxDEAgXYCAYCsdkAAWFgABYWACY7EwEyWUyWVUvohlvohgQxPsbE+zURL6M2aPoh9lRLJaKBlGbRLRoyGVEANiKhMQ2xWSiWSymSyBAA0VToe0aKCocCJQNiGVXNKBhKB2SRjKJV1zOJO03cSGgus6JkjWhNFNYKJSiXtHtIiFEtRKUS4xAhQHsNVEraDWKgaxVD2j6BpUOhBYTVUMmwsGrAiw3A1b6M7G5EWEtNslsLEGSZLKZLARNFAQTQDBBDRSEMC0UmZplJkRomOyLBy4ArcG4z3BuIrSx2Z2Oyi7HZFjsCrAkqIDSKSGkMIkTZTMpMBuQLkzsqLLovaTLqi10JovKVzzx2cuTGd81wcmQ0jgnp9zMJ6NLk732TOqKjxdVh2RPJnLlnv66P1Pnc9xk+CozyM52it9iZUZyRHRUmQ2VmqTLTMkykyopkMdktlAAAAAABAAABwgAFAAARQAAAwAAAAAC4hIlOgsAoKRLkTuYDk+SeWylGUn0dWDSyk1aCM8OJy9Hq6PQ78kbR0aXRqlZ6+DDjxxu0Fdek8RiWJSbSOmWDFhjxJHHPXLFipSPG1XlJuTSdnN1e1k1UY8KRx6jU8fV2eA9XnnPiLZ6Pj4Z8+SpwdE1p06DLlnmacHVn12hxuk2jHQeKhGMZPtnu4sEIJGG2kPqkOeQjLJRXByyyNmW208tnPOZLmyGyNFJ2IGS2FDJbByIbAbkQ2NksKGyWwZLKCwEMBrsYl2UgGi7M3wTuIjbcG8wcxbwOlTNscjijM6sLCY9DEzsxo5dOrPQxQKY2xdGxnFUX6Od9RQxIZEMQxAIQxAAABAAAAAAAADAAEyWUyWVUvohlvohgQxPsbE+zURD6IfZb6Mm+SoYCAsAyGWSyozZLKZDKE2TYpshSM1F2AkxoASHQDCmVfBAWUU2S2FiCpkZNGrIaKayaIaNmiWimsaFRq0KgrPaPaXQ6AmikgoaAaGSNMIolhYAIBiAVhYAEFhYhANsmwZNhFWKybCwHYCAgAEAAwAAhhYgIKsdkWFgabhORFibArdyNSMrGmRWu4aZmmUmEaJlWZplJgXZUWZjsDdMqznUilIo0kzKQ7sTAzLQqGkBaY30TZEpFiUpyMZ8lNuxM0y5pqjG3Z1ZIqjmaplKx1GPdE8LXafam6PoXT9nDrcSkuCo+U2tMJI78uBRbOWUTUZrlkjJnRNGLRWagpMKGkVKQmXQqKEAAAAABAAABwgMChAMAEMAIABgAgKAuGpdiKdLsmm+iGk030aQxNvovHjbqzvw4o+wpabSp1Z7Gn02NRts5ceyKLlqowi1ZNa+XY3jxrhnJm1jimos8/NrG5UmYOWTJ0T6X4aZdbknKkGDHmzZOYlaXQ5smZNx4PsPF+IVRcomNdZw4PG+Jc2nKB9XpPEYsUU/Z36bx+LHjTrk6XFRjSMtfDFY1CKS9EyyNFtkMy18oc2+zNmjJZGsZiZTM5MNYmTMpSHNsggTbGrLSXsGlRVLijOQ5Mh2ACDkQNAyaY6YFIpNGYt1BGk2kjBzFkm6MFJ2DWzkLcRZUeSGtcdndgT4ObDE78USpr0NMuj1MMTzdOuj19MlQNJqgNJpEUc7UNDJAzqLtCFYrKGIAAAEADAQDAwFYWMDCxWRJsYLsliTGgalkNG3BLSCaxZDNJGUrKE+jF9mjsVF0SgKoTZZUDIbCUjGUy6Kk1Rm5IznNmLky6NZu+iFYQbfZpSJ6hItC4AYL4DgiwsChCsVjVUBHI0mNDFRaTHtLoyaJcTfahbUUc7iS4nTtRE0gawoOBsT4Gro4AlsVjTVWBKZSGpplJokzlJp8DTW1oODBTZSbGot0IVibGhi4JbJcmNFyaMr5E5NiGi7CyLHZPoVYE2A0UAgKCwsmTJthGlisi2HIF2FkWwtjFVYNk8i5GILKTIpi5MjZNFJo57Y02B0popNHOpM0i2BtYpMlMJFNNMuLMkaIGrRSJRSGAoKKVDGGofRjK9x0PoyaW41InVS48GMrOmlRE0qKzrmkznzUlwbTZy5pcBN1zPI9xOR7+xWtwSkvyaiPP1WNLo8ucT2tRTR5mWK5NRK4ZowaOnIjKioySZVFqKE6KmJpCdBJk2UwMRQUESA6EDAAhWEcgCGVAAAFAAADBdgC7CGAABE4t9G+nh/wCxWKKfZUqj0RcdEVH0Vv29HPCZGTKkZ1uT8dUtSlF8nBlzzlLhkSm5Ps0w6TLmmlGLI1+/wYcOXLJUmfQ+P8Vlm03Hg7vD+GbUXKJ9pofHYoYuYck10keXoPE44xTlHk9/T6bHjiqRpHHjgqSG5JLgxrrjVuKjRzzmhSmzF2TVU2S2LkRkMmQ0KbJqspMykypMzYUqsEikMCHwQ2VIykyrAx0TZSfAEtElNjS5IhKJW0uKG6oDKSMZM1m6OWcuQJnIxT5CUrJjYR0R5NYIxxnVBAb4kd+FHHjR6GnV0Ed2CJ6WDg48ETsjwBpJ8kDEcr6AAAAAAKAAABAABAAAUDENiABMYmCkFgJkZOyXITJbKEyGih8AZtENGrM5MoiTM5THNnNOQwVKZk5mcpMncXBbdk0CLQExVFWDI5KVomBCK5CKGJJlpARQ0i9o1HkgcYWVsNoRVFbUBhsDabNIhooyaJZcjORRLZnNlszkUZsTH7JZAmSNkgMdk2FgaWZS7LvgzkAIpMmwsIuxNk2FgDZLYNk2APsQASgGIBgYyUMBgIZQmFDABUFDABAMC6FQUMEXQbRbTT0J0YGbiKjShUESkWhUMotMBDAaLRCKQVoikQiiooCbCyqbZD7GJhnomzOcuCmZTuisufJI488uDpyHFqHwByufLJeQxm2mzJzZqJWmSdnJk5Nd1mczUK5JxM9p0SRm4lRg0ZSZ0SRz5EyozbGhUyooqhAMAyRLKEESIoQHEMQFZMBDAAAAGC7AF2AwAArTHKhzuXRmmUsiXZmtSFu2mbUpsJy3SPV8b4+WocXRluTfxzabx2XNJUj7PxHhainKPR2eK8SopOUOj6fTQxYcdbEZ11nMZaPS4sMKcUdu6EY0jnnlV8Gbm37M638tpT54Jtsy3lKaM66YqmFD3ITaJpiXRDLZMkTTE3RGSSCcqOec7IHdiIiaIaEuAbFLgzcgHJmMi2yJF1U2PcQyb/YGt8lxME+TeAVsuiJukVf1MckuAjHJM5ZysrJLky7YQkmzRRCETbYAsaOrGYRRvAI6YHo6VdHn40elpV0Qetp10dFGGn4OkokQxHO+oAACAAAKAAABAABAAAUDENiACZMozmC+CwEhhkmS0aEMoglyCUjGUwNHLgylMiU/2ZykUOcjnmzRuzNooyaJpmriTtASLQkhgDFRSKoohRLSGkWkSoSRSiNItEEqJSiUh+gKi6Q9xk2KwNWyZMmxNlEszkW+iWUZMiRq0RJFGJLLaJaIM2SW0ZsAsLEwAqyGOxAAWIVhDsVisVgNsVhYgGAkigAAodAIYDAQDABAKRPIFgRyHP5YFgRz+WHP5YFhZHP5YAaWFozKRlFDJSLSKCh0Uoj2gTQ6KoKKFRSQUUkAIpCoZQhWMlhTsquDNdm0eis9MpRMZrg6mjKaKjgyxODNFnqZYHHkx2EePlg1ZyzTPVzYjhyY3yaiOWNjkWoUKcSowZDRq4iUTQ55xOfJE7pQMMkSo43EaRpJcirgozYAxBAIBMIBBYrA4wEMrIGIZQAAEDBdgC7AYABK0RnI0IaMtZ+NtPpZ5pKkfof+m/F1BXG+DwPA6NT2txP0TxKjhhVLoza688yR0YcccMGtqObPk+3B058qd0edkdzsxrrI1Sb5se6uBwyJQrgyk7kZ1vFNjT/YJcCXZnW8aqy6ZEeC3KkEwIU2S8hEslmdRjmkcqdm2Z2jCCA1iaWQhtkEzZjJmkmYyKFuCyGwTKKaslxNErG4lGK7NoMzaplRfIXXRf1OfL0bJ/UyyLgDhmnuFGNs1lH7FQjyEOEDXaUo0hgQkawRJrjVkHRhjZ6WnVUcmnid+ONAduGVHUpHBB0dMJAbAxIZis0gACAAAKAAABAABAAAUDENiACZKyh1YL4yqhluJDKylszlIcmYTkUKcznnLkc5GMmAOQdmd8mqXBQqEUJlE0KigAig2l0OgJiixBZEMpMkLA0sakZ2FkGu4e4x3D3AU7Yhoe0AAdAUSyWW0TJFGZMkWyWBk0Q0atGcgMpGbNZGcgIYAxAMQPkYEsllshhEvsAfZIFAJFANDoEXQE0FDoZRNDoYUQKgooKAzkhUa0LaBntCjTaG0mqzoKNNobRozoKL2jUeSaiFApQZsoFqARioFqBsoFKBRltDabbRNAZUFF0KiiaKSCikgFQixNAQSy2Syqldm0ejH2aLoqVTZnIbkTYZZTjwc0oHdJfUwlHksHn5sfB588Z7OePB5048sqOCWMylA7pxMXE1Ecjxi+M7PjE4fo1ErgnGjly9nfmVHnZ2VGEmTfBM5CsoiT5FYS7JCHYmwEwgsQrFYHMFgBWTCxDKCxiGQMBDAYmwJZK1BuOjT4PmaMI43Lo+h8Tor28Ga3H0X+n9NsiuD6bHPYjzfGYfjij0JIxXWeCc7M2gaC+DDpE3XBcTKT5KjIy26E+CVzIm+BRl9jK66YxsqWP6ixs2m/qDXDONGLkdGVnJPggmciYMP7FKG0JqnIlyJlKjPcQ1bZnKRXZMsdmjWb5LjAW2jWMqCacY0gbK3WjORpYhq2NLkdclpBTT4FJWNIpIgweKyo4qZukVQGUlSMrOicbMli5IBKzfFjDHjo6scKA2wRqjtgc2OJ0wX7INUjaHBnEtMDeL4KsyiyzFZqh0JFJgKgooTZRIMZLAVhZN8gEVYWKwsodgKwsBjUqJsliF8XKZhPIEmYzNMlPIYTyfsJmTQgUnZDVlbRpAZqHJqlwNL9DoomhbS6Aoz2BtNLCwI2j2lgBDiLaXYnIlE7SXwU5mbkEDlTFvE1YKBBSY1ywUC1Eg1guCqCPRRQqE0NslsoTZnKRUmZyKE5E7rExJANkNGhLAycTOUDdksDncBbDZolgZbQosTAhoho0ZDCIaFRTEAUOgGAIrcSBRVhZKRSQDQxJFJEAFDodACjY9hS4KsCNgbDSxkGfxh8RsmVZBh8QLFTOhMLAzUaKSHYrAaKIHY0UJoVjsonaS0aWSyiB3QAA9wmxCAGJgBRNclpcCotLgJWbQkuS2hVyVMDVozlE1SKcLLBwZ48HnzjR7OTFaOTJpr9FiV5co2Q8dHofxq9Gc8P6NRlwtUZTnR2TxHDqI7bKlcOpzVZ5uae431TOJs0iJi3BIzYQ5MVk+xlBYmwEwgsmwF6AxAAKyBiGADEMKBiGAMllMlkV1aVXR9b4iNUfNaDHuo+p8etlGWtfQYM2w3+bceO89ezWGo/Zm+ty3HqKVgcMdTx2J6un2ZdJbjt2WzWOI4Yaq/Z0x1P17Mt63caRCj9jD+TbqzoxyvkyuunGqNZf1MovgpyIbWGSJy5YnXNnNkdjDWONcmmThEwVMufJMNc0uSNtG6gKUC5DWadD3Ey4J3Fw1TVjSJUiky4mrSChpjSFixG0pIqhpEa0kikhpFJBNTQ6LodEVKjZaxfocFydKiSjKGM3hjKjE1iiBwgbRRMUaIiqRVkjQGsWaGUWXZllaYyUxpgXYg5EUBLGSwJsLFYrCKsLFYWUVYWTY7AdkyY7M5sQqZMyl0W2SyssJIho2kidoGW0pRNNpSiUZbeBUbOPBm1yBFAVQiiQGIoAuxCsAk6M5SKnIxbslQpSEnYmrNIQINIQs3jiHixnRGFEGHxi20dDRlLsASEx3wS2AmyWwbIbKG2QxthRoRQKJaQ6AzoTRpQmgMmiGjVoloDJolo0aJaAzaIZs0Q4gZMhmziQ4hGTJs0cSdoE2Ow2htAadlUKKNKAmhpDoAGkPomwsC0x2RuFuA1TGRFlEFDJGQWmUmZopDBdjsgBgdhZNjIHYWIZQwsQWA7BisOygEOgKEIYASIoQCLXRIWUDZO4GzOUio0Uy1kORzon5qBXa52Q+TlWf9jWf9mozVZvqcOTMa6rPweRm1Feyo6p5TzdVPsznq/2cuXUbvZqM1y6jmzhnwdmSVnJlKjNkSG2LsqJGAFQhMYmBIhiAyAAKyVDAA1AhiGEoGIYQEtFCBrbDq3h/J2Y/NSh7Z5uzcZyhtIbXtrzjl/kzfH5p/wDseDjhZT+pmty3H0kfMX/kWvKbn2fMrK0bwz8mcdJa+qxa+/Z1/wA+odnyuPU0bPWPbRnGtr3YeSvMlZ7mk1W6K5Pg8GovUI+q0GX/AG4mV2vpIZbiU8nHZxYclxRtKVRsYbRnzbV2cUdRudEazNUezzcOo/3H/wAkxde5GQp5KORZ6RyavV7PYw2vR+evZMtR+z59+Rf5Il5F/kRde9LLfscXZ84/JP8AJ0YfIN1yayK+hjGzRQOPSZvko9PHG0XBko0UkKfDGidEOikiSkYXTSLSIKQFUOhIZFVDg3TMImiZFbxZrFnPFm0SDeLNUYxZqmZFDENBVxKITKTIi0UmQirAqxCsLKCxPoLJbAlvkLD2AQwACgHYgAdmcuiyJArMGiqCgyzaCi6CgIopRHQ6KJa4MWuToa4MWuQIolltEsohkstkMoQrBisaImyErLkrKxwsAjjs6ceEvFjOmGMlRnCFFPg0caMZuiCJyMXLkc5GLlyBsmIUXwMCWRRbFRRNDSK2lKJRKiNxLSBooyoTRpQmgMmiGjZohoDFolo2aIaAzoNhbRN0BDgQ4GjZnJhGbiS4lykZOQBtDaTuDcBTVC3kylwZbufZKOjcLcYqRSYGqYyEWgEBoo2NRAUSgqgAdjQikAxoSGBQAFAIBgZAADKAAGAhoARQUAwAkRQihCGAEktlsyl2ygbIkixNFGEkZSR1ONkSxlRyi3G0oGTjRUrHL9jzdRi7PW22Y5sNljL5rULbZyOR7GswVfB5ksVGozXO3ZlNWdLgL4rKjhaoKOieOjJqioxYhy7EVCExiYEiGIDIAArIAADUAxIYAMADNAAAQnKjNysqYQjZBeOVBJ2FUNIjSK5NoImhqVEa11RLrg5Y5OTeM/qZa1vp4/7yPqdDxCJ8pp5f7yPqNC/ojLT28L+qNc06xmGJ/VEavJWIg8/WZrTPOw5am/8AkWpztyas5sc/sF16zz0jh1eexSy8HHqMlkVm8hLnZzSyNBDI2VVZMlFY9Q00RJJmUntZpX1vi891yfT6SpQ/+HwXj9Ts28n13jtSnHsqu7LDkjo3VTVmM+GZ7IkpMkaOarTGiEUgLHZKHZFaR7NUjLF2dKSM1RFfo2iRFGsUQXFGqIjwWiKpDEq/IAUmUiEUiC0MlFLoBgHAihMQxAADoAhDoAKAAAAJaLFQKih7SqERlO0VFksCaENiKB9GTNW+DNlEshlslgZshlshlENktlMhgVFWdGOBlhVnZCIGuKJukZwVF2ETNnLkZvNs55kGEiK5NJIgCl0MgtJgVQ9o0mUolE7R0VQUUTQNFUDRRFCaKEwM2iWjRkMDNolotksCGiJI1oW3gDnZnI6nAzlAI5JGTOqUDNwAwFZq4C2AZdi2m6gafGjPQ5lEpRN9gbCDNRLSHVAUUijOw3AWAkUUAxIpANDBFIoQwoYCChgZCoYDAQwGAgQwKAQwAQhiKEIYAIlxL9lqKoo5mgo1lFEUAqE4miRVIsSuScDCcTvnFHNOKNJXLVEzVm0omM+Cxl5ushdnkZopHsayS55PD1U6ujUZrJ0Q5JGMsjOXJmaKjoyTRzTmZPM2ZubbCNrsCYso0hCYxMCRD4FYGQCArJjJKABiABgIAGAAAVY1wIAGAhgFgAA0WVGbshhH+wyG16Ok/wDyJn0+if1R8xpZJSXJ9Bo8seOTk7Pfxv6Iw10v9oePIvjXJhrMieMivBzS/wByX/JgpUa5v7M57CKyZmkc0sjkVlZz2VP1UkmT0O7Jkwae4yk2ykylFMG1pgyuLR9H47VtUfMrhnp6TNtaKbX3WkyqePv0XPlnlaDULbVnq4/tGx6stSuzRLgVUyl0SyNy1PsEwZF8mbI1GqKfRnB8m1cGK1CxN7jri+DmhHk6YmK01iaLsziaoyqkx7qEgILUikzNGiAdlJklIopMpMlFIIdgAAIABdgMAAAAACAYLsAApKySosAkjNltkMIQgHQEshs0ZlICJSM3JlSIAdgJIqgFRLiaUJgZOJDiashjRWFHZBHLiOyAGsUU0ERlGcooylBG7M5Ack40cs20ztyI48i5CCLt0deOCaOKPEjuxPgDVY1QbUWnwICXFENGobQM4xCcUkaVRE+QMEVSCqBl0JxRlJFtkMCGiaLZLKGool8C3US2AMloGyWwE4ozcUW2Q2UQ4onahtktgNJIqjOx2yChcE2FhDYCsCgAAAV0NSYqspIC4mkUZo0iyi0h1wSmOwAP/omxWBQE2FmVUMmwsuChk2MBoUnQWJhCTHYgAoOCLHZQ6ECY+AJKcuApCYRk5OwVsdclpICfRLkzSSVGUixA5WZyGKRqJWTRxatuN0dzOLVK7NRmvn9bmlyeVObl2etrodnkZFRqMppM58sEauRjkkVHPKKDaimIoFwUhFGUDXBlK0bVwRKIHK5OwUnZq8ZOyiiAADTIGIYAAAAwEhgAAAAAAAwEMAAAAGT0xsKA0xZZKR6uk1EtyPIjF2d2lbU0c/66vp8eofxozzZnKJz4p/7aCcrCObL22YezfI0c8miKzyHLN0dGSSOXI76KioSsczLGzWTsoizaBgzSDIKka48jUkR2CTso9/QalppWfV6KaliPgdNkcZxPrvG6lfHTYXl7LXszfBeOSlAiSJXSB9GfssaRirBBcnR6M0imzFaiodnRE5odnTExWo1iaoyiaojSkAIDNFItEItdgMpEjRRaKRCLXQQwAAEC7AF2AwAAAAAIa7AF2AAKxksB2SFgEA/QhNgKTMZMuTMpMCG+RpC9lpAKh0Oh0BNCaLoVAZNEtGrRDQFYkdcDmxI6YgbRKIiWihNGckatESQHLNHNkidk0YzjwEcVVI6cUjKcfsEZUwO+L4GYwnwap2gHHs1SM49myoCJLgyfJvPoxrkCJIykmdMkZSiUc7CjRxFtAzaM5G7RnJAc8rJ5NZRZO0DPklmjiS4gZuyGatEOJRi7JZo4snaBHIcl7WKmAuQRW0dFRIUVQ6Amgoug2gTQ6KSDgAQ0yQsovd+xORm5C3Aabg3GTkJS5A6EwshPgLIrSwsjcFgaWFkbgsDSxpmdhYRbJbE2JsAbFYmS2UaKRSkjncyfkr2Ede5A5I4/m/Y/mQHTaDckc3yr8illVAroeREbkckswlmVliOx0RMyWZMbmmaiUmYZo2dCRGVFZrwNfDs8HUKj6PyCXJ87qfZqMuKTMZSZtJGMkaCXIxIaDJgAEFIdCQ7ATiiHFGtohlHGA6CjTJBQ6HQCAYAIB0FAICqABAMAEA6CgEMdAAgXYwYFxo6cLSkcSf2OnE3Zht7GOa2IqU+DjxydGrboAnI5skjWRz5OgMJyZlbZciEEUuPY07IkwjZRp2NcCiNjBpFm0UjmTo3hIDWLqSPY0Wr2yirPGvgMeZxzR59itcv0HRZ1PEdlXGz5zxmrW1Js+hwZFLGZrrEtcjRUl7M3wYqxraoE7ZmmaY1yYrUawRvEhLguJitRrE1RlE0RlpaAAIKRcSEXEAKRJSKKRSJRSCGAAAgQAuwKAAAAAAhrsAXYABEiyZAShpiEwhtkSkDZlKQDkzNsTkHYAlyapERXJqkAqHQ6HQE0DRYmv0Bm0ZtGzIaAMaN12YwNbA1izVHPFm8GUURMsiQGTREo8GjCgjjyQ9nLK1I9HJHg4MqpgVjnydUJWjzVKpnXimB2xZomYRlwaXwBcnaIXYrHYDZEkU2ZuSKJoKQbhrkCHEiUTahNAc7iZuJ0tIykkBi4kuJtQmgOdxIcToaM2gMXElxNWiWijPaLaaVyFFGe0NppSEwiB0DolsCuA4I3BuApsVkORO4otyIciHIlyAtyFuMnIN37AtsalyZ2NPkDoT4HZknwOyK0sdmdhYGljszsdgXY7M7HYRdhZCZSKGzORqZyQRhJswlNo3mjlmmBLyNE/M7Jkn+TCSdgdazfsTz8dnG3L8k3KwOmWVmXytGfLJlFliOmGof5OrHmTPIW5M6MORrs0le1CSYstHLizG8pWWMvG8l7PnNR7PpPJL+x89qI9molce0xyROtIwyIqOcLKaJKh8lISRVEQDGkPaBHIqNdjFsZRxAMDTJAMAEAwAAGACAYAIdAAAAwAQDABCfRQn0BmpfY68TRxbXuOnHwZad8JG27g44TNVMC5yRzzkmXOVo53ZQptGLkXP8A5MZRYGkXZaVGeNV2bJpgJcFIW1spIuBMalRMnRDYHWpfUyt/ImKLHuVk6a5exoNS4yirPr9Dn3Ylyfn2nk1li7PrPG6pbUmYro+lXMbMpLkrDPfisco+zFaiIrk3xoxTSZtCaMVqOj0VEiPJpGJitRpE1RnFGiM1TGCQ6IpouJCKTCGUiRlVa6KSITKUgiqAW4dgIF2FguwKAQwAAAIa7AQ7ACWVYgJJZTZnKQREmYSZrJmEuQCy4mai7NYxA0iaIiKNVHgBDHtHQEiZVCaAhkNGjRLiARGxdCbA0izeDOaLN4Mo2IkUmS0BD7KSJa5GpUERNfU4M8ez0JStHJmjaA8yTqZtinyZZINTJxupAetjlwa3wcmLIqOpO0AJlWTTQrAJMxlMrIzmnIo2UzaDs4oSOnGwOhKyZIqMkKTAxkzNsuasyaACWVQnF0BmyGW4kMCGSU2SAg9ADZYEyGNyIcioTZDYpTRDmBTkTuIcibKNdxLZNibAbZDYNksAsdkgiiyl2SikBogsmx2ZFBZNjsCrHZFjsCrGiRplFIpE2NMItCYtwbgMpRMZQs6W0yWkUccsfBhLHyei48GLx/YDjeBtGTwOz1VBV0S8afoK82OF2W8PB3LB7obxpLoqV5WTC0YU4s9XNFJHnZaKzTw5Gjuxz3HlRlTOzT5UVKjyCuz5/UqrPo9UvkujxNXp2rKzXmIyyHRLG0YyxtlRzyMpPk63p20Zy0srKiI8oo1hpmi/4rZEYpcm0Y2XHSs1jhaKM1jE8fHR1KKSIm1RR4YABpkAAAAAAUAAAAAAAAAAAAAAAAAAAAFxM75KTIjZMuzJMtMBtksbZDZVSxVYNjgEFDjwU0INNIsbZmmJyKFNkpkykNMDaPJSxNsmB1Y2jPTXIxYmmj1tC3HJHk4saTaPQ00fumc66R9Xo8v+0kdjdxPI0kqikepCX1M1qI2Ns0x4nZUOzoiqRitRUI0bIzizQxWlItEItGaq0MSKRKFQDBoCkMSGVQMQwhjEMAKXZJS7AYUMAEAwCEAwAVDGiWBEmYzZc5GEpBCbFQ1yWogSkaJCotICork3XRlFGq6AZJTJAQmMTATENiAiZmazM6KHE3gYo2iBtEoiJQEyMmaSM2ETRM4XE1SHJfUDy82M5HGpHqZYHFkhTAnE2pHfimeanUjpxZOQPQbtGdDg7RbjQGE42c04M7WrM5w/QHEvqawy0TkhRg3QHoRyovdZ58MlHRDIUbvkhxsqMrKAzUB7TRABhKJzzR1yRhOIHK0SbSjyZ7eQE2Zs0lGjJs1BMjKRpJmcgjJkM0aIaKJGFBQCYhsQCE+xiKAYhgUhiQwGAAZDAAAaGhLsa7KGNCGghgAAH/0AAoBoQ0wh+iJNIpvg5p5OaCxUsyivRl/MjGRGSDlE4MmFqV8hXrrXwargP5Cn0eGk4vtnRgy1LsqO/NG0eflx1Z6HyXFGGWNoqV5skVitGk8deiYKgy6oc9nLq8alfB042LJDcVl4WbCY/Cetmw/o53joqOVY0vQ/jX4NmqM3KioXxr8BxH0HypIynksI1eSK9Ih5Uc8nZLZRrLIjCeUmUjnnMo4wADTIAAAAAAoAAAAAAAAAAAAAAAAAAACX2NCY12EaJlWZpjsC7JbCxAJlQJY4ugrVsgTkCZVMl9FpCkgMWOLFJCugOiDOnG+jjg+Tswxtoz01y7sKuj1NNDlHDp4HpYOKOddI9bTR4R6MP6nm4clI7IZrRmtR2Y+zqXRxYp2ztj0YrUOKNUiIotdGKsUjREItGa0tDEikQIBhQDQxIZVAxDCGMQwApElICkh0FhYBQUFhYQUFBYWAURI0syyOgOfLI55M0ysxfYRvA2jEyxo6IrgCaGkNoaAuK4KHFcA0AhDEAhMYgEIYASyKNGiWgEjSJn0XEo2iVZnFlWBMmQ+ymQwhqVFbuDILAWR2jjyo6pPg5c3QHHJ0ysUvsZ5OxY5VLsD18DujrkuDzdPkO/5LQCE1Y2CA58mOzjyYT1HGzHJjv0B5bjtLhKvZtkwmLhQHRDIbxlZwxdHRCZR0WO+DLeNTAtmMy9xLAwkQaSMmBOR8HM3yb5HwcrfJqBshlWSyohktFtEtAQA2IBMQ32Iol9iGxAA0IpANDEUAAAEDAAAa7GuxLsa7CGCAF0AwAChgIYAAAASVo53huVnRYtwIj4/qYZMNnTvFdha86Wkt9Dx6FqR6CKUqKjBaZpETxUdby/szlLcVmuCeGzJ4KO+jPIqCOaEKN1Az30NZipWGeNHFPj8HRqc/fJ52XOVkpswkrG8tjX2YRi4h8do6o4N3IOG1FHI8VGM+EdOXJtR5+bMVEZJnNKVsJ5LZHZRIABpkAAAAAAUAAAAAAAAAAAAAAAAAAAAgGBEA0xAuwGAAVQJuiiJhS3FxZkmXFhW8RtExZdlGMkRRrJC2hSguT0NOujkhDlHp6bHyjPS8vR08PqdkI0LTY/odSxfUxWkRybTbFn+1WYOFDxxqRitR7GmyW0epjf1R42l7PYxr6oxWo3iyyImhitQ0aIhGiM1VIYkUiKKEMABDEhlUDEMIYxDACl2SMCrHZNhYFWFk2FhFWFk2FgXZz5mbWc2dgYT5Eo2NKzWMAiscTZExVDbAGxohvkqLA3j0NkxfAwAQxAITGJgJiGxAAmikgaAhoaBiKLRVkJjsAbIZTICHXBLRouiaAzkuDlyrg7ZR4OXLHgDzsnswUqkdOWJzPhgdOHNTPQxZt3s8aEqZ2YMgHrWCZzrKUpgdG4TdmSkaJgRKFmE8R2JWRKIHnyhQJ0dM4foyeMQRuLUgWMagUUmHoEgfQGczCXZrORzTnQE5GcrfJpOdmN8moLsBIZUITKoTQGbEUxMohiKZICYhiABiGBSGJDKAAAyGAAENdjXYl2NdgMAAoYCsYAAAADEBQPoybuVGrI283QqxUMe42+D63RnGVGvy/WiDOUNphknRs57nQp4dyKlcfy89lxnY5YNphkl8ZUbylRjOVnP/ACNw99hKmbMtw8sqOaWSgzU5+bPPyo7JT3HHmZWWRrB0c0p0Cy0ij0Vm2xOXNqv2cc9VXFnLkz7io1zam32cc8m5ik9zBQKiatlqI0qHZRiACs0hgAAAAAAAAAAAAADABAMAEAwAQDAAFQBZEwAuwABjEAxTJmh2NLcXF1h10VFmzxk7AbDiy7I6JcqC617KijFSOrErC6vHC5I9jS4+EceDFbR6+nx1EnVa5/XVh4idG76nKntK3+jna1lW3bNILmzGLtnRiVszVkdelf2PYxv6o8zTwp2epijwjFajWDNkTDGbKJitxKLQJDRkUiiUURQA0h0DCXYDSHRVSMdAEAxAAxk2FgVYWQmWgCwspRHtCIsLG1RDdAVZy52auZzZpWBUOTohE58PJ1wQQ6IZbZlJkC9lx7JSNUgLiV6IRVl0MQAAhMYiBMQwAcRyJuhbrKExFUG0KSKQUIqEyWNslgFlJ2ZXyax6Bi2vqc2VcHRJ1E48k+aCOTLE5ZxOyfJhkQHPFcm0HRj7NsfLA3jkNYTs52qQlOgPRx8nRFHnYsrOvHksDo6JkxbiJSAbjZDiJzKTsCdoqCTBMoKJl0WQwObIceRnoTjaOLNCgOOT5F7JyOmYrI7NcjqTLRzRmWplRsJkb7KTsYJZLNdtkuBRkyWXJUSBJL7LoNoVBSHtKoqEhhQwEBVBRkIPZVD2gShoraFAICqCiiQQ6GEwgGA0wgABphWCGUkX08JKypR+odIynkGLoT+xru4OVT+xspcBKcuTkz47R1SZhN2EcUcPJp8Rb4BOypXFqY7bPLzZKPcy4955mp09XwGa4oZLMczs6o4q9HJqFRWXLNk3wW1ZjN0U1z5X9iVGy5K2C4NYidnsl8GlktFRnuBOx7QoDMOAA0gAAAAAAAAGAhgAAAAAAAAAAAAAAAgGBFAAAQAMCoQ06ARQ3IW4l2CFFXYmhpFURYUYndgj0c0YndgXRGndgh0eni4icGBHbGVRM9N8enOVMzU/sTNkxtyMV1duHlnp6fFZwaaNyR7Wlx8IzR0YcVI7IRpDx41tRo40jFU4M2RhDs39GK1AikJDRFNFISKRFNACGAIYICgEMAEAAEAAACRaJXY10BomMlDsJqZMykaSM5AQznyI6GZTRA8J1wObGjpiEEjJ9msjNoBotdEItAUV6IRa6ABDEACGIAYhsQCfQkN9CRYqkNCQ0UITGJhEsllMkCK5NY9EFXwATf1OOf8AY6Jy4OWXMhiM2ZZFwbtGeRcAcbXJpiZE1yGKXIHU+SdpSdlJFDxxo68ZzxVGsWB0ITRMZFgZtBdItksDGcuRbhT7IsDZSC7MkykwKatGGWFo3smSTQHk58XZxONM9nPBUedPHyzcSsUOwkqJTKjSLNImUWaxA0TEwEBnMkqQqAQDoKAAGFAFAOgQDoKGkOiKQ6HQ6AVBQ6HQCFRVBQE0A6CgEA6CgJoKKoKAkEx0L2WFNvgwyHRVozyx+pUcTnUi4ZV+Tnztxs41qHGTA9vcmuzHK6OPFqd3s3lNSXZBnubNIcmdFKVFZrpjjUjl1WnXJ14JJjzxTLGa8d4UkeRro1Z9BlikeDr/AGaZ/rypzozbsrImZlQmIfsRpCAAAkAADEAA0gGJdjAAAAABgAhgAAAAAAAAAAAAAAAAMCKQDAIQwAqAKAZRLQkimKwppF0TE0SMqqKOzCcsUdeJEV3YmdEZM5sXSOiCM9OnHq6tm2LHciccbZ3YcZiujfTYqo9jTxpI4sGM9LFGkZo64f1LfRnHouzNU4rk1M49mvoxVgKEhoimikJFIigYIYUIAAoAAAgEMAEAxAIpCAIqwbEFgJsljbJYEkNGjFVgEEbRM0jSJEUyGi+yWgJGmD7F7AtGi6MkaLoAAAAQADAQAACkJA+gRYqkNCQ0UITGJgqWSUyQhewb4BkSZRnORi+y5Mj2EOiJrgtCn0Bw5UYxdM6cy4OS6YHVCZvFnDCZ0Rn+wOndQ1M53MW9gdsZmqkcMJm8JgdNibMlIe4CZmbXJbZIAkUJF1wBFibKaJoDOatHJkxnc0Y5Y8G4leZkjRz8ndlgcriVBA1RnFGiAsBIGBMhUVQUAgodBQCodDoKAVAkUCQDSHQ0BFFDAYCodDAAFRQgEAwAQqKACQGAEtEPs1Zm1yUOJU0nElcDu1Q1HnavG9r4PDzKUZN0fUZsalE8jWaak2kB5GLPJS5PQwajd2zy8kHCTDDmaYK+gTTXZOR10ceDPfbOrcpdFZrTBkao6XOziVxNIzKjLUt8nha1ts9/JHceHro0zTFec4JnPkjTOlySMppM1iOYCpKiSoQAAEgAAZAAGkAxLsYAAAAAAwEMAAAAAAAAAAAAAAAABgQAABQAAyoQmUFWBlKxGkosiiK0gbRMIHRElaaxR0Y6OZM3hIyruxtHZjVnnY5q0ejgaaM9N8eunHHk78ETjxdnoYEZdHfgj0d+NcHJhR24+jNVqlwDGuh0ZoWO7OhGMI8mxiqCkJDRFNFISKQU0AIZAAAFAIYAIAAAAACEAxAArAQBYgEAmXFWSaQQQqKQMEQWugaHHoGBkybKkZgaRNV0YxfJtHoAEMQAIYgAQxAD6BdgwXZYpoaEhooQmMTBUskpksIlszkVJkPooyl2SW+xMIkJCBgc+ZcHDI9DMuDhmiiIs2jMw6Y0wOjcG4yTbLQGsZG8JHMjWAHSpDtmcWbRi2QTyJ2bbODKSoATNE+DnumWpAauhJImyosBuKoyyRN30ZzRuJXDlgckoHo5InNOKKjk2lJFtEoAQMYmADBDAQUMdAKgGACHQwABiAimMQwGMlDAYAJgAAAAAAAAIAAe0SL4oDJoldlyasmuQiqtHNqsKcTqSYssLiB8vq9PVtI8qUXFs+o1eHhnh6nEo2Urnw5WvZ6mkybqs8S2m6O/QzfFliPXyJejJSo0T3GOW4mozXRCpHkeSg+aPSwzMNTi+RM0y+T1EpQkOErjybeQwSjL/wCnGrjwzeMrl2SF2BEIAACQAAMgADSBdjEuxgAAADASGAAAAAAAAAAAAAAADAAAYECGAFAAAVAVFokTv0BcmjNq+iXZcEZrRx4NYmbRpAlai7NI2Ys3xqzKtYN7keppnwebCP2R6mmxtonVb49d+Hmj1NPHo8/T43aPX08HwYdHXijwdUEyMUfqbxRmhxs1iiUaxM0CiOiuBmVSkUkUkUqIqUh0WkJgIAABgCAKAAAEAAEAADAQCAAZJQARTCmWAEUzWBIIIqQIQ0QWmht8GYAKXJFM0ACIrk3j0ZotANiAQDEAMBAAAJgmEuiEVWiKRCKRQCaKQfohWbJZbEyowknZDNmiGUZNEstklRm0I0a4MpgZ5WqOOS5OmfZhMDBoVM0HSKJjwaKSIZNsDdSRrBnJFm8GQdcGdeNqjgizog2B18NGGSN9FxsbXBBxSi9wKzeaVmbXICVlRsAA0vgTIsaZrlKUkc+SB1NoidGkefKDM2jsyJHPNAZAx0NIAQwAAAYAAAMBAMApUOhgQKhjAACh0NAKgZQmBIDABCGACAYgAbToSNFVdAYNOyoot1YcUQUqSE2mQ2KL5KMdTjtHz+vxNJn1GWNxPH8hhuLoqPltrs6dM9hU8Li3Zk5bSxK9jT5U+ys9S6PKwZ/2elhlv7NxkoXE6YOLXJnJUYubiajNcWvwKdtI+d1UdkqPsPj3wd/g+Y8piaycfk0y44sZC4GRDFYmIB2BPIwMwoYGkAAAAADAAAAAAAAAAAAAAAYAAAAAMAAAAACAAAoB0IqJFQ4lRRUhIiwMuJKNIxJWoVHVii2ZRx2ehp8LZlqHixNyR7uj0zcTj0+m+yPo9Fp6x9Gem+WeHT0ejhxUioYa9HTCFGGxCNI1QJDM0CNEZrs0RmqtFohFogpFIlFIgpMTGuwCk+xDEA0AIAAAABAAAAMAYEgABAAAAAAAA0IaABgBAAAAAAMBItElAAhiAAYAwEAAAmSimIqmikShpgVYrCxFQMkYNAZshmjIaKMmiGatGciohszmy5GMijKTMZGsmZSAzGC7YyiWQy2SyARvBmCNIsDpizqxnDGXJ24XwQdMVwU+iYlvoDCaMX2bzMX2QAmwJbAGxbiGxWa5StdwmzOwbNImbMZI1bIYGdDSGCAliKfZIDAAABiGAAABTAYEAMAAYAMAEyhMCQGACEMAEAAAh2A9oE+yvRNcl1wBEiYdlyRnHsDo7Ry6nDuXR0xkOaTKPmdVpaukeJqscoWfZ6nCpejwPIaNu6RYleHhybe2exosydHjZcEsZ06PLsq2ajFe/wD26OPO9rOnTZFM5dYvt/8ATbNdenaljf8AweF5PBunaPY00tsa/Rz6qCmmyo+VyR2OiTo1kduT/wCnMvYZAh+xAIYhgQFDA0gAAAAAAAAoYAAAAmAwAVDAAAAAAAKGAAABAAAAAAFAUnRIEVTZNiuwJWmsOToxwsxxKzuwwujLUa4sG6uD1dLpuuCdLp7rg9rS6brgypabTcrg9rTY9sTLDgpdHbBbUZ6b49UkaRIXZaZh0xYhdlUShJFoSRaRmhotEopGRSKRKLQDAAATEU+yQpoAQAAAACAAAAYAwJAACAAAAAAABoQ0AwACAChgAAAABRJQAIYgAGAMBAAADJKEFIYPsRQ7HZIFQw9AVXAGTJaLaCuDQyaMpI3aMpoqMJGEmbTOebKM2ZyLbIkBC7GJFFEsiXRozORBJcWZtjTIN4yO3DI81SOzDMD0YSNL4OWEzZS4IFMxZrJmTIJZDZbM5ARfICXYzXKUCbE2S2aQ2yWAmAmxWDJYDbEAAMAAAGIYAAAuwqkMEMgQwGADAYAJjoTAkBgAhDoAEIYUAjRdED3cFgPY7M3LkNwwVIyrk0FQBF17KciaE0A27OPU4N66OpOhNlZr5nW6F88HlPA8R9lnwrJ6PH1ei74KzWGhnVG2aO9mGPD8Z1Q5NRKygtosj+kv+DSZhklUGaZfOeQ//L/9ORHVr3eT/wCnKioPYh+xBCGIYEjoEgNIKEMQAMKAAAACAAAAAAAAAAAYAAAABQAAEAAAAAAUAmMXZFCQ6HFDaJWo2wo9XTQujzMB7OkXRmtR7egw3XB7mnw0jz/GwtI9rFGkZqrhCkVQ0NIz06cehIpIEi0jDYSLURxiWkZolIdDoCUBSJRSMikUiUUgKQPsEOgJfYhiAAAAoAAAQAAADAGESAAAAAAAAAANCKQQ6AAIoAAAAAYAMQwAQxAAMAYCAAABDBlVLENksB2NEjQRaK9ExK9FGbChvsfo0IaMMh0S6MMhUceQ55HRkOWTKJZMhkyKJiUSikAmZyNWZTAzYrCRNkFp8nRinRyI2gyD0cczpjL6nBikdcHwQaNkMolkEMzkavozkgMvYOQS7MnI1ylU2KyHISZpGljJjyaJAQ0ZtG7RDiBmASVCAoBIYAMQwAa7ENdhVpcDoF0BADAYAMS7GACZRLAmgGACAAAQAACEyg2lgzoSRrtE40aokon2UmQPaKUS/QNEHPJUZuRtkMVHcVDg7Iz4d3o1UdopzosZrys2CvRio7T0si3nJkxmmHDknRx5sn1Z0ah7bPMz5asrLzNY7yHOvZrnlukZL2aQCH7EAhiGBDlTopCcfYk+aOsiRT4Rk50b7biZTgLI0UZ2zZK0cidM2hkM4Y1caElZUXuL2JEqyM9pMuDSbr2YSlZDI0irCSoweVx6CORyJtMi5So0gtyM9jl6NYpxQ0yIk6ZceTKdtmuPr2NMafH9bMJSqVGryUqMJcysqYtOykrIii0dMYNxpWZOXNGz6M1C2TAlKy0rKjiNfjogxcf0JRNnEzl9SNQ0jfHi3meBbz29DpN9cGLfxuT9LR+P319T6DR+J6+pvoNDVcHvafT7TnbcbkmubS6P4l0dkY0bKFA4mL1W5zGRaK2BVGb1WpJCRaJKJtVaZaZmi0NFDoSKIFt/Q6GAAFgAFbg3EgQVYrEADsLEADsLEANOwsQBDsQAAUFAABQUAwFQUMa7AVAkMAAdCGAUFAMBUOgAAoVjEAAAAAAABQUAAITY2SwE2S2UyGAWUmSNdFFplXwQhvoIiWSmCyESXI0i6K3WRKNmiiDRdHHkxWc08VHoTRz5ENHA40Q0dM4mMkXRkkWkJFpAJxM5QNhMaOWWMylGjqkjGa5Awbo2gZNclRlQR1RnR0QzHnqZrGZcNejHJZVnJCZtGfBMNatmUpFOXBlIYaynIx32zWSMto8AuSiOhbxqNU6LUznUjSLsbRtusaVkxRrFFEPHYviNhPsDH4yXGjZkMDOhWW0S0ArDcDQqKrRT/Y1KzNFpBWiKSIRa6CHtHQxNkCCrAaAWwTiaEsCKFRZLAkRQqIJsN42iH2XVXvE3ZBQ2gGhDGopMLJsdhBKG4hw2GiZM3ZUZN2YZDoSMcsSs1x5M20z37/Y88TOHA1HPnw7k+Dwdbj2s+ln/AFZ4mux3ZdpkeFkjyRReoe2VGO87TxgS4M3M1rcP4b5CMVItMbxUT0BT6Mm/sU5eiJG5SNN/At27gxTdm1VGwprEn+CnjUTF5JLpMcJTm62sgpycejN5pfs9TS6N5mriezg8BHIlaRmq+R3zl6Z16bA8lXFn2WP/AE1jXqJ2YvA48f4I1HyuHxHy9xO3H4FL/E+qx+NjDqjT+KokHy68Il6Rjl8Uo+j6x4UYT0ykwj5KXi//ANSJ6HZB8H2UdBFqzn1Ggiscuugj881KeOdUc252fQeR0aWQ8ieFRkErOL4LTFVDR1ZXY0EUabeCISdA8gpcGMpMjToxy3Gk8V+jHS237PZ0+m+SuDNajl0mDrg+k8dg6MMGi2+j19Jh21wYrcevo8VUeljjRw6ZNUd8DnW4pioGIzW4qiGaLohoypDSCiqIBFCSKQDGIYDGJDAAAAAAAgAAAAAAIAAAAAAAAAAAAAAYhgA12Ia7AAAAAa6EADAAAYCGACGIAAAAAAAAAABMTGxASyWWyQJGgEUUFisLCE1yNIVjTKLSBoVg2UZyRz5EdEjCYHPJGE4nTIykiowSKoaQ6AmgaLoVAYyRjOJ0yRnKIHK4mcuDrcTKUAMEzSMh7KKUSo1hI3jI5om0WBumDiSmaKgMpRMJI65LgwlEg55EG0okbQJijaCISouLA2RSZluDcUbp2D7IiygpUJooKCM2hNGtEtFGdCo0oTQVCRSQUNIKpFIlDCKsBWFkDGiSkA2AAAhNFCAigoqgoghoya5OhrgxkuQqRjoVAAWFAIgsLARUNsm7BgiopIzyRNSJKyo8/NAx20d+SFnNKARzSR5+pxbkz1JRMZYtwHyGswPf0c3wv8H1+Txym7Mn4uP4R2njD5mOJ/g2WLg9uWgUTCWnUQy8qeHg5cmKj2pYkc2XAgPEf9ikrCjTFG50JVhRwNu0jpx6ecuNrPS0WmjNqz6DB4zHsT4NLXzmn8Z8j5ievpvBwdWkexj0MIHXjxwj7A5MHiIY6qjtjpo4+jbdFLsieRfkzVJujN5GiZZI/kh5IP2RXViyJ9sqcl+Tzcmfb/VmL1UmQejOa/JKaZ5zztm2PLbIPSg+Dl1Le2RrilaCcFJBHyPkccnJvafP6hSWT+rPv9Toozi2fO63QxUmyo+cdiTdnVmxKMqMKW42hxZqpEcJCUuSaNasPhb9F6dbpHq4NKpURXBpNO7/AKn0njtM5VaHpPHrjg9/R6NQrgy0WPRr8Hbg0lejsxYEdePCl+DNanrDDgSOhQSNNqiS6OdbiHEijarE4mW2YUNhQCodDoAEMBkAAAAxiQwAAAAAAIAAABgCAIAAAAAAAAAAAAAAYhgA12Ia7AAAAABDABiGAAAAMQAAAAAAAAAAAAmIpiAliKEUSyWWyGArFYmyGyou+R2ZWVbKNNwORFibAcpGMmU2ZyYEszZTJZUSkFFAAhUMAIaJcTRoVAZbSHE6K4M5IDLYTtNWiGiogakTIzcqYHVGZamcamUpsDtUrG4o5oTOhS4AiUTGSo6HREkQcrbX5EpGsombiUVGTNEYotMDeLLTMYyLTA0RSRCZpEoK/ZLRpQmgM2iWjRomgqaCiqFQCAGICrCyRkFDRI7AqwJsdgUAhgAABAPoykuTYloDKhUaUJoCKJLaE0USIYggEikDVFiFYmxMCoTjZlOB0ImUUwjhnEjadOSJzz4AVIUktvZz5MjRzz1DSOs8YaZ6V8nlZ50zTNqGzgy5LfZUEsrsxnk4Jk+SJPgI89G+JVKzn6nydMWlEy1y9bQZEppNn1WlnF448nwmmzSjm/R9LodVaSs1CvoJNKPBzTzNFOe7GjizuS5KR0R1DY8mWRwYZvdydbpozVc2XPNHM9VkOnPA5dn5M1WuPLKf9jSzGCSKcmRVNmkJ0zn5NIXYHqYJujoTs5NP0dcSIJwuDPG12BuLdHvLbtOTVY4yxsD4TWY3GbPMbe+j6LyOD7NpHgyxS+aqNhU2hxhJvo68OBv0duLRN+iDl0mKW7o+i0WBurRjpdBJP+p7mk0jVcEadej064PYxYUqOfT4XGrPQjEl8VcIpGqdGSsq2YaOUjNyZT5FRmtRUWUQikzKxEuykgY1QiihDAqEAxEAIYgGiiEWAAAAAABAAAANACAIAAAAAAAAAAAAAAYhgA12IAGD7AQANCABgAAMBDAAAAAAAAAAAAAABiBsAAKAPYEMzZozNoozkyGy5IzaZUMoSGUAihAQyWaMh0Bm0S0aMiRUQAUACYAAAADAVGckbUS0BlRLibUhNIqOWUTCSOycTCUeQOfkqNl7RqIDi6NFJkUFgbKQ7TMNxakBo0mQ4DTL4AwcSXZu4ohxAiLNYszqhxZR0RNYmEWbRkqAsTGmhNoCWSUxU7CihMva6JaCs32IbJbCAZKY0yCkMSBgOwskaApFEIoChkoogfAqAaAmiWjR1QmFZNEtGjRLRUZsTKaYnFhCQpMdMUkywQMEmOioV0OyWKwjOZyZWdczkypgcGZnBmyUduezzM7Z0jDmy5HZzuVseVvcYuRUaNozmxORDYRz5o1PghSd0dGaP2MGqZhY2xunZ6eh1DWSmzyIyOnBNxlZvkr7bTZozhFGupx3C0eH47UtySbPoXKMsUf+DRHlY8clJnXCMi1si+TSOTG+jNVjkgcs8b9HfklEyW2RmrHC4tAos7J416M9lGarKMDeEBxijaNBWmP6mymkc+6jOU3+QjreYjJkUo0cbyManb5A5dXp1OLdHhz0j+Z8H1UtssZxfx4vLdFV5+m0bvo9vS6JcWjTT6aNrg9jT4Eq4IrPBooJLg9HBpYr0a48apcHTCKRlWbxKK4HBcmskiUjLSqQhiIAAAy0QDEAAAegUAAFQAAEAOhDAAAAAAAAGhDRAAAANACAAAAAAAAAAABgAAAAAAAAAAAmAAAAAAADsBDAAAAALAQDsLEADsLEABYWIAGAAUITRXsllGbQmkUyWEZyFbCXYIoYADAl9EstkMolkjYghUJlMlgSxDYmAxoSGgKXREi/RDKJExiYRLIaRbJYEOKJotiAhkGjIYAhoSGgKTLTIRSA0XI6RCZRRnNEouZKQDTZakyEuCkBomy0zNFIosqNWQUnyBtS2mckir4IkyKykYs2kZtAQUhUNIgtAyRhANCGgGhoSGgKGIZFAWAAAAAAyaKEVBSDagACZRRFI0ZNFKlxQmi6JaKMpIzkbSRlJBGUjOUbRrJEUB52fDJ3R5eo086fB9Lsi1yc+XTRknSOk8YfHZ8UkzklFpn0+p0LdtI8jPoZp3QHmWDZpkwzi+TGXBWWuVcnLPg68rOPI+TJEw5lR1xg0rOXDzkPWhjvGuDfJT0E3DJyz6OGqTxpfo+chBwdnXiyt8Wao9LPqVFXZz4ter7OPWbnHh+jy4znGXMvZitR9PLWxfs1waqLPmPnl/7HZpM745M1X0yyRkDo4MOf9nVHImZWNEihwjuNFibIrB2S4tnQ8TGsYRyPGydrTO94+DCWPkDONvg2x4G5WViwtyPTwabhcBpngw0enhx0kLHgo6oY6IrSC4NOEKKHIyobsSJVl0ZaAABACGACAYgAQxAAABUAABADEMAAAAAAAAaENEAAAA0AhgAAAAAAAAAAMBAAwEADAQAMTATAAAAGAgAYAAAAAAAAAACAAAAAGCEBYGMQFASyiGAmQymSwjOQxSGaADGD6AlkMtkMCWSymJhCZLKZLAliY2JgNDQkNAUuiWUhMCKEy2iWiohkstoloCGIpoQEMijRoloCQGIBlIgpFFopMhDsByJooKAENAMoZSJGBVjsmwsC93AWRY7IGyWigrgDOhNFtEsgkBMEFUNEoYRSGiUUgGhiGQMAGAgCgAAAChAMAExDAomhNF0FFGLREom7Rm0BhKJDRu4kOIHO+C041yKaMnZtlrKOOS6OPUaWEouomyk0zVSi1TCPmdXoHy0jw9RpJxkz73NjhKPR5Gs0iabSNMvk8sjkm+TXJIwfLJGY0wOsls9jDmTikeKuOTp0+X7dm+VevkaceDLDKp9jUt0ESo7XZqjry/7kaOHJpWdum+7o9D+JuXRitR86tJJv2dWDTSjR7UdBXo0/iV6M1Y4McXH2dmJsmePYEJUZrT1tNzR3QgmjzdLPo9TFNUQZzgRRtOSMm7ZAmuDJx+xuo8E7fsBrgityPXwRW1cHmYI/ZHrYf6oitkl+ClQkOiKpDl0SijKlGJbVDigkZaQAAACGACAYisgllUSxQAABQAAQAxDAAAAAAAAGhDRAAAAAAAAAAAAAAAAADAAAAAAAAAAExiYCAAAYCABgAAAAAAAAAAIAAAAAYIQFgYxAUBLGyWBLJZTJZUS0AMChgAAS+iWWyGBDExsTCEyWUyWBLExsTAaGhIaApACGgJE0UJlRDJZbJYENEtFsQENEtGjRDAhollshlACYgAtMpMhFIC0OgiVTKJGAAMAABhYgsAGmSNEFoqyEyrChkMoTIjNoSVF0JgIYgQFIaEhoChiAgZRJQAAAAgGIoAAAAAAoaGSiiiWiGjRkMKzaJaNGiWgOTJ2Rss3njbdiX1NMud4mRsaZ1710Q1ZUYXxyY5kpR6OmWJmUoNFZfmMnZIPgW405yrfQY57ZWZ7xWan4r1sGoT4OuWT6ni6XmZ6rj9ELYrr0OWpf/T3sWoSS6PlcU/jfZ6On1W+lZGo9rNr44/wcz8tGX4PK8hOk+Tx1na9mK1JX0uXWqf4Ijms8OGpv2d2DJdExcfQ6SV0erhlweHo59Hr4p8GV/Ws5CTtkt2VFcgbxXAbfsaY42jaODcxqIxKmejifBnj0nujpjhpGVyrTKRKjRa6I0BpgKjKq3BdktDSIpgOhUQIB0FFQgHQUNQeiGWQwQgACKAAAAYh2AAAAAAAANCAgYBYWAAAAAAAAAAAAAAMAAAALCwABWFgMljv9ktgABYAMBDAAALAAFY7AACwsAALEAAAADBdiYWUUhkbv2PcUDJZXYmgIfZL7GyGwAZNjsqGABRQmQy2SwIJZTRLATJZQmgiGJlNCaAS6KQugsC0UjPcWpANolj3CbKiWSUxUBDEW0TQEPolmu0hxKrJkPo1kjKQMJ9CQnIW4DRFIzUilIGOiBVGUJmm4IAAChAJsLAYWCQ9gCGFBRAxiAB2HYrHZAUTIuxNWBlY0U4US+AKQ0RuKTCqQxJWOhiGUuiR2QMBWFgMBWMoQDCgExLsbQl2UNDEhgJiY2JjRLEwYrGhNmM1ZbYqs0jmaph8m02lA5skC6inqFRhPOmjLIqMG+S7Efn0kZuJsKjq4MVDkrYXtKozWorTRqZ6e/wCqR5uPhnVGdhqKyM30D+3/ANOXI+DXRTphuPS1eH5EePm0mz0fRYo/LRlq9H3wRuPnYw2noaX0U9H+jq0+mquBrT0dH0j2cELPP0mGqPa02IxQ4aezeGm56OrFh/R0xwmGXLjwUdeOFGixlRjTMo1h/UYR6HQdEgOgoBUOh0FECoEOgIAAAgAfYAQIAAAJZRLAQhgUIAAAAAABiGAAAAAAAAAAA0AICAAAAAAAAAABgAgAGAgAQ2IAExg+wEAAUMBWFgMBWOwCgAAAAAAAAAAAAExMbJZYpMaENMC0DEmDCM5GUuzV+zKXbAXspE0UUUh2SOwgfRLKbJYEMllMTAgTKEVlLEyhASJooQEezSJNFLoBgAigEMQAIYgGyZDJZYsZSMZo3kZSQViyaLaJoIEikC7Gugq4miZkmXFhK1QxRLoIzaFRpQqAEXfBBVgIBioAAKCgAAoKAC4kUUuAHIykjV8ktAZUUhtE9AaJ0PcZbhphV2Fk2NMIoBWMgYyRgUFisYUMldjYghjEAA+xMZLAlkspksDGUuSlImS5BcGhbfBhkXBr+iJK0B5+bg4cmTa7PWyYbXRwajTcdBH58OibHZ6HnNomwslkpFqRtids5k+TfE+SNSuma4DC9rKityL+Og3HseNnuaPYnp/kPC8a9rR9PpV8lEbjh/8AH36NIaHb6PYWAr4TNrUcWHT7fR6OCG0UcdG0I0zOq6sXB0xfByQdG8ZGLUbAuwiWomRSXA6GhFaFBQDogVBQwAVBQwZBIDAgQABABQAAiWWQwEAAUFCGACAYgAYhgAAAAAAAAAANAIdkAAWFgABYWAAFhYAAAAMQ2IAYgAAEAAAhvsllDsLJsLAoZKZS6AYAFgABYWAAFhYAAWFgDIZQmWKhhYMmwLTHZKHYRLZm+zRmbKBDAAALFYWEMTCxAAmMGBnQFUIqJEUICRFCAkaAYAIAKAQAAgAABksYMozkjOSNmjNoDFomjVomgIoY6ACei4shjiwOiDNUc8WbxYQ6E0WgaAzaEmU0SuwKGCQwEAwAQDABAMQDXIUCKXIGbRnI2kZSGjJsaYMQGiZSM10WiChkhYFDJGBVjJsYDYgYl2A12MS7GAn2JjfYmBLIZTJfYCaIZZLNDNyDcDiKqAqrRjkw2jaxN2EfkthYwPQ85WJjoTCJXZpGdGYpukRqO/T5NzO9RtHi6WbUj2ccrojpz47NM9ns+g8dmuuT5pS2nq+NzP6kbnj6qM7LTOPBNyOuJitxtBG0YGeNHTCJi39BGJrFAolpGFXE0RmikyamNBCTAqmMmxgMBAQMGIAAAAgBDABAAEB6JZRLAVCGBQgAAAAAAAAAAAAAAAAAAAAACAAAAAAAAAABgAgAH0AgAQ2IAABpASyWUyGUJsLJbBMC0y0Zo0QFCH6EAAAAAAAAAAAEyKJkWKzkybKmZtgaJjszTLQQMllEgMQxMoQrBiCCxkjAYDSG0BAihFRIihASIpiAkRRLAAAQAIYigEMQAADAlkNGjJaAyaJaNGiGgIEU0JgQwRVE9AaRZtFnOmaxYR0RZREDRAQ0RXJs0RQAAwAQDABAMAEJlCYAh2SFgNktFIe0DBxJ2nQ4EOIEANoQDCxBYFDJGBVgIAKBCGA0MQwE+xMbJYEskpkgAqGIonaJobZEmAmTfIm3ZFuyj8toKAD0PMbRLRSCXQGZORccCb5Bc9kWHgvcerin0cGKCTOtNrozW+fHXus9Txrf1PHwpyPf8ZifHBm1158fQaOLdcHoKD/BHj8K4s9L4kYt/WnPCLOmCHHGrNYxRi+qIotIaiUkZVFArNNobUZQLoAA1oAAQDGSFgUBG79DTAoBAQMBAAxAAASyiWAgGACEMQAACAYAhgIBiAAAAAAAAAAIAAAAAAAAAAABWADfQgAAYgAAGhDQEsiRq0ZyRRkxIcyGwNUzWJzKRtFgbeiRrodcASBQASAwAQAAATItA0WK55WQzeUSHFAQi0KgCARQAC6JYxFEklEsIBokdgWhsixpgAFJFbUEZMlmskZSATES2wTKKJY0JgIQwAQigAkRYqKEA6ACWJjZLAlkMtksCGSWxUBNEtGlBQGZpFhtQ+gjaDNkzlTNYyYGwmiVIdgIAAAABgIBgAhMsTQGbEaNEtANGioxbYKbA3pGckJSY7sDKSZDOlxVGUogZ8jG4ipoBjItjTAoZKZSaAaGLgAGhiGAmJlEsCWQy2JgQ2KymhUVSZDRYgM3EW1FslsqPygAA9DzAG7ARBLQkqNNotrJa1IrGzsxRbObDjbZ6mlwN+jNrc8b6XA3R9J43T1XBw6LTPjg+i0OCq4MW/rpPHp6SG2jsa/RnhhRvRm39bniUjRISRokZ1TSHQ0iqMiQHQERNAVQUBNBRVCoCQKoVATQx0ACAYAIBgAgGACEUJgSAwAkBiAQDEADEMABgDAQAAAAAAAAAAAAAAAAAAAIAAABgDAQAAANCGgB9kMt9kMDGZmzSZmwBG8OjBG8QNl0UyV0UwEACAAAGAgAAGhsSGyxWbJZbIYEsQ2IIYAACEMRRJLKJYQgAAGMQwKTLTMkzRMIJGUkbszkgMGhI0kiGAEsYigAAAAAAAAAAEMRRLJZYgIZDNGiWgIYhsQAACABiGEUi0QikBoiiUUAAIAGAAAwEMBghFRATRLRq0Q0Bk0JotokAQ7JCwLTKqzKy1IBuKIcSrDsDNommjWiXEDO2K2W4kuLQDi2y12RFFgNDQIYAJjEwJZLKZLCpENiKEyGymQwE2Q2N2S7CY//2Q==",
 			"media_type": "Image",
@@ -287,6 +290,7 @@ func TestValidProfilePicturePatch(t *testing.T) {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
@@ -314,6 +318,7 @@ func TestInvalidPatchPasswordWeak(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/5", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -339,6 +344,7 @@ func TestValidPatchPassword(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/account/5", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
 	router.ServeHTTP(w, req)
 
@@ -352,17 +358,27 @@ func TestInvalidDeleteID(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "1",
-	}
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/account/-1", nil)
+	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 5))
 
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
+	router.ServeHTTP(w, req)
+
+	// Проверка ответа
+	assert.NotEqual(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "error")
+	defer tearDown()
+}
+
+func TestInvalidDeletePassword(t *testing.T) {
+	setup()
+	router := setupRouter()
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/account/-1", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("DELETE", "/api/account/5", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", "Bearer invalid")
 
 	router.ServeHTTP(w, req)
 
@@ -372,21 +388,31 @@ func TestInvalidDeleteID(t *testing.T) {
 	defer tearDown()
 }
 
-func TestInvalidDeletePassword(t *testing.T) {
+func TestValidDelete(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "1",
-	}
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/account/7", nil)
+	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 7))
 
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
+	router.ServeHTTP(w, req)
+
+	// Проверка ответа
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "error")
+	defer tearDown()
+}
+
+func TestInvalidGetClasses(t *testing.T) {
+	setup()
+	router := setupRouter()
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/account/5", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("GET", "/api/account/8/classes", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", "Bearer invalid")
 
 	router.ServeHTTP(w, req)
 
@@ -396,74 +422,155 @@ func TestInvalidDeletePassword(t *testing.T) {
 	defer tearDown()
 }
 
-func TestValidDelete(t *testing.T) {
+func TestValidGetClasses(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/account/13/classes", nil)
+	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 13))
+
+	router.ServeHTTP(w, req)
+
+	// Проверка ответа
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "error")
+	defer tearDown()
+}
+
+func TestLogin(t *testing.T) {
+	setup()
+	router := setupRouter()
+
+	// Структура данных для запроса входа
+	loginData := map[string]string{
+		"username": "test_acc",
 		"password": "Password123!",
 	}
 
 	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
+	data, _ := json.Marshal(loginData)
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/account/7", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("POST", "/api/account/login", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
 
 	router.ServeHTTP(w, req)
 
 	// Проверка ответа
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.NotContains(t, w.Body.String(), "error")
+	assert.Contains(t, w.Body.String(), "access_token")
+	assert.Contains(t, w.Body.String(), "refresh_token")
 	defer tearDown()
 }
 
-func TestInvalidGetClasses(t *testing.T) {
+func TestInvalidLogin(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "not_the_password",
+	// Структура данных для запроса входа
+	loginData := map[string]string{
+		"username": "test_acc",
+		"password": "1",
 	}
 
 	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
+	data, _ := json.Marshal(loginData)
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/8/classes", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("POST", "/api/account/login", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
 
 	router.ServeHTTP(w, req)
 
 	// Проверка ответа
-	assert.NotEqual(t, http.StatusOK, w.Code)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "error")
 	defer tearDown()
 }
 
-func TestValidGetClasses(t *testing.T) {
+func TestRefreshAndLogout(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "Password123!",
+	acct, err := account.GetByID(8)
+	if err != nil {
+		t.Fatalf("Failed to get account: %v", err)
+	}
+	tokens, err := acct.IssueTokens()
+	if err != nil {
+		t.Fatalf("Failed to issue tokens: %v", err)
 	}
 
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
+	// Обмен refresh-токена на новую пару
+	data, _ := json.Marshal(map[string]string{"refresh_token": tokens.RefreshToken})
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/13/classes", bytes.NewBuffer(data))
-	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req, _ := http.NewRequest("POST", "/api/account/refresh", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
 
+	var refreshed account.TokenPair
+	if err := json.Unmarshal(w.Body.Bytes(), &refreshed); err != nil {
+		t.Fatalf("Failed to parse tokens: %v", err)
+	}
+
+	// Старый access-токен отозван вместе со старой парой
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/account/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
 	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
 
-	// Проверка ответа
+	// Выход по новому токену отзывает сессию
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/account/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+refreshed.AccessToken)
+	router.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.NotContains(t, w.Body.String(), "error")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/account/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+refreshed.AccessToken)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	defer tearDown()
 }
+
+func TestRefreshTokenSingleUse(t *testing.T) {
+	setup()
+	defer tearDown()
+
+	acct, err := account.GetByID(8)
+	if err != nil {
+		t.Fatalf("Failed to get account: %v", err)
+	}
+	tokens, err := acct.IssueTokens()
+	if err != nil {
+		t.Fatalf("Failed to issue tokens: %v", err)
+	}
+
+	// Из одновременных обменов одного refresh-токена проходит ровно один
+	const requests = 5
+	var wg sync.WaitGroup
+	errs := make([]error, requests)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = account.Refresh(tokens.RefreshToken)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.ErrorIs(t, err, account.ErrInvalidToken)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+}
@@ -0,0 +1,395 @@
+package tests
+
+import (
+	"api/assignment"
+	"api/qwiz"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAssignmentWindow(t *testing.T) {
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	duration := int32(60)
+	penalty := 0.25
+
+	// Без ограничений отправка принимается в любой момент
+	open := assignment.Assignment{}
+	assert.Nil(t, open.Deadline(now))
+	p, err := open.SubmitPenalty(now, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, p)
+
+	notYet := assignment.Assignment{OpenTime: &future}
+	assert.ErrorIs(t, notYet.CheckStart(1, now), assignment.ErrNotOpen)
+	_, err = notYet.SubmitPenalty(now, now)
+	assert.ErrorIs(t, err, assignment.ErrNotOpen)
+
+	// Время на попытку считается от её начала, с запасом DurationGrace
+	timed := assignment.Assignment{Duration: &duration}
+	assert.Equal(t, now.Add(time.Minute), *timed.Deadline(now))
+	_, err = timed.SubmitPenalty(now, now.Add(time.Minute+assignment.DurationGrace/2))
+	assert.NoError(t, err)
+	_, err = timed.SubmitPenalty(now, now.Add(2*time.Minute))
+	assert.ErrorIs(t, err, assignment.ErrTimeOver)
+
+	// После срока - отказ или штраф
+	closed := assignment.Assignment{CloseTime: &past, Duration: &duration}
+	assert.ErrorIs(t, closed.CheckStart(1, now), assignment.ErrClosed)
+	assert.Equal(t, past, *closed.Deadline(past.Add(-time.Second)))
+	_, err = closed.SubmitPenalty(now, now)
+	assert.ErrorIs(t, err, assignment.ErrClosed)
+
+	late := assignment.Assignment{CloseTime: &past, LatePenalty: &penalty}
+	assert.NoError(t, late.CheckStart(1, now))
+	assert.Nil(t, late.Deadline(now))
+	p, err = late.SubmitPenalty(now, now)
+	assert.NoError(t, err)
+	assert.Equal(t, penalty, p)
+
+	result := qwiz.Result{Score: 4, MaxScore: 4}
+	result.ApplyPenalty(penalty)
+	assert.Equal(t, 3.0, result.Score)
+	assert.False(t, result.Passed(1))
+}
+
+func TestAssignmentLimits(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"qwiz": map[string]interface{}{"name": "limited", "public": true},
+		"questions": []map[string]interface{}{
+			{"body": "1+1?", "answers": []map[string]interface{}{{"body": "2", "correct": true}, {"body": "3"}}},
+		},
+	})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/qwiz", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	location := w.Header().Get("Location")
+
+	var qwizID int32
+	_, err := fmt.Sscanf(location[strings.LastIndex(location, "/")+1:], "%d", &qwizID)
+	assert.NoError(t, err)
+	var timedID, lateID int32
+	err = db.Get(&timedID, `INSERT INTO assignment (qwiz_id, class_id, max_attempts, duration, score_policy)
+		SELECT $1, class_id, 2, 60, 'last' FROM student WHERE student_id=13 LIMIT 1 RETURNING id`, qwizID)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	err = db.Get(&lateID, `INSERT INTO assignment (qwiz_id, class_id, close_time, late_penalty)
+		SELECT $1, class_id, $2, 0.5 FROM student WHERE student_id=13 LIMIT 1 RETURNING id`,
+		qwizID, time.Now().UTC().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+
+	student := bearer(t, 13)
+	start := func(assignmentID int32, code int) qwiz.GetAttemptData {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("%s/attempt?assignment_id=%d", location, assignmentID), nil)
+		req.Header.Set("Authorization", student)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		var started qwiz.GetAttemptData
+		_ = json.Unmarshal(w.Body.Bytes(), &started)
+		return started
+	}
+	solve := func(url, body string, code int) qwiz.SolveQwizData {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", student)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		var solved qwiz.SolveQwizData
+		_ = json.Unmarshal(w.Body.Bytes(), &solved)
+		return solved
+	}
+
+	// На задание со временем решают только через попытку, начатую в его рамках
+	solve(fmt.Sprintf("%s/solve?assignment_id=%d", location, timedID), `{"answers":[1]}`, http.StatusBadRequest)
+
+	first := start(timedID, http.StatusCreated)
+	if assert.NotNil(t, first.Deadline) {
+		assert.Equal(t, first.StartTime+60000, *first.Deadline)
+	}
+	solved := solve(location+"/solve", fmt.Sprintf(`{"answers":[1],"attempt_id":%d}`, first.ID), http.StatusOK)
+	assert.True(t, *solved.AssignmentComplete)
+
+	// Засчитывается последняя попытка, после неё попыток не остаётся
+	second := start(timedID, http.StatusCreated)
+	solved = solve(location+"/solve", fmt.Sprintf(`{"answers":[2],"attempt_id":%d}`, second.ID), http.StatusOK)
+	assert.False(t, *solved.AssignmentComplete)
+	assert.Equal(t, 0.0, *solved.AssignmentScore)
+	start(timedID, http.StatusForbidden)
+
+	// После срока работа принимается со штрафом
+	solved = solve(fmt.Sprintf("%s/solve?assignment_id=%d", location, lateID), `{"answers":[1]}`, http.StatusOK)
+	assert.Equal(t, 0.5, solved.Penalty)
+	assert.Equal(t, 0.5, solved.Score)
+	assert.False(t, solved.Passed)
+}
+
+func TestAssignmentMaxAttemptsConcurrent(t *testing.T) {
+	setup()
+	defer tearDown()
+
+	var qwizID, assignmentID int32
+	if err := db.Get(&qwizID, "SELECT id FROM qwiz LIMIT 1"); err != nil {
+		t.Fatalf("Failed to find a qwiz: %v", err)
+	}
+	err := db.Get(&assignmentID, `INSERT INTO assignment (qwiz_id, class_id, max_attempts)
+		SELECT $1, class_id, 1 FROM student WHERE student_id=13 LIMIT 1 RETURNING id`, qwizID)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	quiz, err := qwiz.GetByID(qwizID)
+	if err != nil {
+		t.Fatalf("Failed to get qwiz: %v", err)
+	}
+	assign, err := assignment.GetByID(int(assignmentID))
+	if err != nil {
+		t.Fatalf("Failed to get assignment: %v", err)
+	}
+
+	// Из одновременных начал при одной оставшейся попытке проходит ровно одно
+	studentID := int32(13)
+	const requests = 5
+	var wg sync.WaitGroup
+	errs := make([]error, requests)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = qwiz.StartAttempt(quiz, qwiz.Solver{AccountID: &studentID}, assign)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.ErrorIs(t, err, assignment.ErrNoAttemptsLeft)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+
+	// Попытки, начатые вне задания, тоже упираются в лимит, когда их отправляют в его рамках
+	err = db.Get(&assignmentID, `INSERT INTO assignment (qwiz_id, class_id, max_attempts)
+		SELECT $1, class_id, 1 FROM student WHERE student_id=13 LIMIT 1 RETURNING id`, qwizID)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	if assign, err = assignment.GetByID(int(assignmentID)); err != nil {
+		t.Fatalf("Failed to get assignment: %v", err)
+	}
+	attempts := make([]*qwiz.Attempt, requests)
+	for i := range attempts {
+		if attempts[i], err = qwiz.StartAttempt(quiz, qwiz.Solver{AccountID: &studentID}, nil); err != nil {
+			t.Fatalf("Failed to start attempt: %v", err)
+		}
+	}
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = attempts[i].Submit(qwiz.Solver{AccountID: &studentID}, assign, nil, &qwiz.Result{})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded = 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.ErrorIs(t, err, assignment.ErrNoAttemptsLeft)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestAssignmentCRUD(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	request := func(method, url, token, body string, code int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", token)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		return w
+	}
+	createQwiz := func(public bool) int32 {
+		data, _ := json.Marshal(map[string]interface{}{
+			"qwiz": map[string]interface{}{"name": "assigned", "public": public},
+			"questions": []map[string]interface{}{
+				{"body": "1+1?", "answers": []map[string]interface{}{{"body": "2", "correct": true}, {"body": "3"}}},
+			},
+		})
+		w := request("POST", "/api/qwiz", bearer(t, 13), string(data), http.StatusCreated)
+		location := w.Header().Get("Location")
+		var id int32
+		_, err := fmt.Sscanf(location[strings.LastIndex(location, "/")+1:], "%d", &id)
+		assert.NoError(t, err)
+		return id
+	}
+	publicID, privateID := createQwiz(true), createQwiz(false)
+	teacher := bearer(t, classTeacher(t, 5))
+	open := time.Now().UTC().Add(time.Hour).UnixMilli()
+
+	// Чужую закрытую викторину выдать нельзя, как и выдать задание не своему классу
+	request("POST", "/api/assignment", teacher,
+		fmt.Sprintf(`{"assignment":{"qwiz_id":%d,"class_id":5}}`, privateID), http.StatusForbidden)
+	request("POST", "/api/assignment", bearer(t, 13),
+		fmt.Sprintf(`{"assignment":{"qwiz_id":%d,"class_id":5}}`, publicID), http.StatusForbidden)
+	request("POST", "/api/assignment", teacher,
+		fmt.Sprintf(`{"assignment":{"qwiz_id":%d,"class_id":5,"open_time":%d,"close_time":%d}}`, publicID, open, open),
+		http.StatusBadRequest)
+
+	w := request("POST", "/api/assignment", teacher,
+		fmt.Sprintf(`{"assignment":{"qwiz_id":%d,"class_id":5,"open_time":%d,"max_attempts":3}}`, publicID, open),
+		http.StatusCreated)
+	var created assignment.GetAssignmentData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	assert.Equal(t, open, *created.OpenTime)
+	assert.Equal(t, assignment.ScoreBest, created.ScorePolicy)
+	assert.Equal(t, assignment.DefaultPassThreshold, created.PassThreshold)
+	url := w.Header().Get("Location")
+
+	// Перенос срока и снятие ограничения попыток
+	request("PATCH", url, teacher, fmt.Sprintf(`{"new_close_time":%d}`, open-1000), http.StatusBadRequest)
+	request("PATCH", url, bearer(t, 13), `{"new_open_time":0}`, http.StatusForbidden)
+	w = request("PATCH", url, teacher,
+		fmt.Sprintf(`{"new_open_time":0,"new_close_time":%d,"new_max_attempts":0,"new_score_policy":"last"}`, open),
+		http.StatusOK)
+	var updated assignment.GetAssignmentData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &updated))
+	assert.Nil(t, updated.OpenTime)
+	assert.Equal(t, open, *updated.CloseTime)
+	assert.Nil(t, updated.MaxAttempts)
+	assert.Equal(t, assignment.ScoreLast, updated.ScorePolicy)
+
+	w = request("GET", "/api/class/5/assignments", teacher, "", http.StatusOK)
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"id":%d`, created.ID))
+
+	request("DELETE", url, bearer(t, 13), "", http.StatusForbidden)
+	request("DELETE", url, teacher, "", http.StatusNoContent)
+	request("GET", url, teacher, "", http.StatusNotFound)
+}
+
+func TestGradebookCSV(t *testing.T) {
+	best := 0.75
+	submitted := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	g := assignment.Gradebook{
+		Assignments: []assignment.GradebookAssignment{{ID: 1, QwizName: "Fractions"}, {ID: 2, QwizName: "Tables, part 2"}, {ID: 3, QwizName: "=HYPERLINK(\"x\")"}},
+		Students: []assignment.GradebookStudent{{ID: 7, Username: "ann", Cells: []assignment.GradebookCell{
+			{AssignmentID: 1, Completed: true, BestScore: &best, Attempts: 2, SubmitTime: &submitted},
+			{AssignmentID: 2},
+			{AssignmentID: 3},
+		}}, {ID: 8, Username: "@bob", Cells: []assignment.GradebookCell{{AssignmentID: 1}, {AssignmentID: 2}, {AssignmentID: 3}}}},
+	}
+	var out strings.Builder
+	assert.NoError(t, g.WriteCSV(&out))
+	assert.Equal(t, "student_id,username,assignment_id,qwiz_name,completed,best_score,attempts,submit_time\n"+
+		"7,ann,1,Fractions,true,0.75,2,2024-03-01T12:00:00Z\n"+
+		"7,ann,2,\"Tables, part 2\",false,,0,\n"+
+		"7,ann,3,\"'=HYPERLINK(\"\"x\"\")\",false,,0,\n"+
+		"8,'@bob,1,Fractions,false,,0,\n"+
+		"8,'@bob,2,\"Tables, part 2\",false,,0,\n"+
+		"8,'@bob,3,\"'=HYPERLINK(\"\"x\"\")\",false,,0,\n", out.String())
+}
+
+func TestGradebook(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	var classID, studentID, qwizID int32
+	err := db.QueryRow("SELECT class_id, student_id FROM student LIMIT 1").Scan(&classID, &studentID)
+	if err != nil {
+		t.Fatalf("Failed to find a student: %v", err)
+	}
+	if err := db.Get(&qwizID, "SELECT id FROM qwiz LIMIT 1"); err != nil {
+		t.Fatalf("Failed to find a qwiz: %v", err)
+	}
+	now := time.Now().UTC()
+	var currentID, oldID int32
+	err = db.Get(&currentID, "INSERT INTO assignment (qwiz_id, class_id) VALUES ($1, $2) RETURNING id", qwizID, classID)
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	err = db.Get(&oldID, "INSERT INTO assignment (qwiz_id, class_id, close_time) VALUES ($1, $2, $3) RETURNING id",
+		qwizID, classID, now.Add(-48*time.Hour))
+	if err != nil {
+		t.Fatalf("Failed to create assignment: %v", err)
+	}
+	_, err = db.Exec(`INSERT INTO attempt (qwiz_id, seed, shuffle_questions, shuffle_answers, start_time, account_id,
+		assignment_id, submit_time, score, max_score) VALUES
+		($1, 0, false, false, $2, $3, $4, $2, 1, 4), ($1, 0, false, false, $2, $3, $4, $2, 3, 4), ($1, 0, false, false, $2, $3, $4, NULL, NULL, NULL)`,
+		qwizID, now, studentID, currentID)
+	if err != nil {
+		t.Fatalf("Failed to create attempts: %v", err)
+	}
+	_, err = db.Exec("INSERT INTO completed_assignment (assignment_id, student_id) VALUES ($1, $2)", currentID, studentID)
+	if err != nil {
+		t.Fatalf("Failed to complete assignment: %v", err)
+	}
+
+	get := func(url string, token string, code int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Set("Authorization", token)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		return w
+	}
+	teacher := bearer(t, classTeacher(t, classID))
+	url := fmt.Sprintf("/api/class/%d/gradebook", classID)
+	get(url, bearer(t, studentID), http.StatusForbidden)
+	get(url+"?from=yesterday", teacher, http.StatusBadRequest)
+
+	// Задание, закрытое позавчера, в журнал за последние сутки не попадает
+	w := get(fmt.Sprintf("%s?from=%d", url, now.Add(-24*time.Hour).UnixMilli()), teacher, http.StatusOK)
+	var gradebook assignment.GetGradebookData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &gradebook))
+	if assert.Len(t, gradebook.Assignments, 1) {
+		assert.Equal(t, currentID, gradebook.Assignments[0].ID)
+	}
+	found := false
+	for _, s := range gradebook.Students {
+		if s.ID != studentID {
+			continue
+		}
+		found = true
+		if assert.Len(t, s.Cells, 1) {
+			assert.True(t, s.Cells[0].Completed)
+			assert.Equal(t, 3, int(s.Cells[0].Attempts))
+			assert.Equal(t, 0.75, *s.Cells[0].BestScore)
+			assert.Equal(t, now.UnixMilli(), *s.Cells[0].SubmitTime)
+		}
+	}
+	assert.True(t, found)
+
+	w = get(url+"?format=csv", teacher, http.StatusOK)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(",%d,", oldID))
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"api/account"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStudentCannotCreateClass(t *testing.T) {
+	setup()
+	router := setupRouter()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"class": map[string]interface{}{
+			"name": "test class",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal create class data: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/class", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.JSONEq(t, `{"error":"Forbidden"}`, w.Body.String())
+	defer tearDown()
+}
+
+func TestForeignClassesForbidden(t *testing.T) {
+	setup()
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/account/11/classes", nil)
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.JSONEq(t, `{"error":"Forbidden"}`, w.Body.String())
+	defer tearDown()
+}
+
+func TestNotOwnerCannotDeleteClass(t *testing.T) {
+	setup()
+	router := setupRouter()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/class/5", nil)
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	defer tearDown()
+}
+
+func TestClassRoleValidation(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	// Отдельный администратор: общие аккаунты фикстуры нужны другим тестам с их ролями
+	admin, err := account.New(fmt.Sprintf("admin_%d", time.Now().UnixNano()%1e12), "Admin#123", account.Admin, nil)
+	if err != nil {
+		t.Fatalf("Failed to create admin account: %v", err)
+	}
+	defer admin.Delete()
+
+	// Администратор может создать класс другому учителю, но не ученику
+	data, _ := json.Marshal(map[string]interface{}{
+		"class": map[string]interface{}{"teacher_id": 13, "name": "test class"},
+	})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/class", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, admin.ID))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// В класс добавляются только ученики
+	teacherID := classTeacher(t, 5)
+	data, _ = json.Marshal(map[string]interface{}{"student_ids": []int32{teacherID}})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PUT", "/api/class/5", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, teacherID))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"api/broker"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// receive ждёт следующее сообщение подписки.
+func receive(t *testing.T, messages chan string) string {
+	select {
+	case m := <-messages:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a broker message")
+		return ""
+	}
+}
+
+func subscribe(t *testing.T, b broker.Broker, topic string) (chan string, broker.Subscription) {
+	messages := make(chan string, 10)
+	sub, err := b.Subscribe(topic, func(payload []byte) { messages <- string(payload) })
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	return messages, sub
+}
+
+func TestMemoryBroker(t *testing.T) {
+	b := broker.NewMemoryBroker()
+
+	first, sub := subscribe(t, b, "live.000001.evt")
+	second, _ := subscribe(t, b, "live.000001.evt")
+	other, _ := subscribe(t, b, "live.000002.evt")
+
+	for _, m := range []string{"1", "2", "3"} {
+		assert.NoError(t, b.Publish("live.000001.evt", []byte(m)))
+	}
+	for _, messages := range []chan string{first, second} {
+		assert.Equal(t, "1", receive(t, messages))
+		assert.Equal(t, "2", receive(t, messages))
+		assert.Equal(t, "3", receive(t, messages))
+	}
+	assert.Empty(t, other)
+
+	sub.Unsubscribe()
+	assert.NoError(t, b.Publish("live.000001.evt", []byte("4")))
+	assert.Equal(t, "4", receive(t, second))
+	assert.Empty(t, first)
+
+	claimed, _ := b.Claim("live.000001", time.Minute)
+	assert.True(t, claimed)
+	claimed, _ = b.Claim("live.000001", time.Minute)
+	assert.False(t, claimed)
+	exists, _ := b.Claimed("live.000001")
+	assert.True(t, exists)
+	assert.NoError(t, b.Release("live.000001"))
+	exists, _ = b.Claimed("live.000001")
+	assert.False(t, exists)
+}
+
+func TestPostgresBroker(t *testing.T) {
+	setup()
+	defer tearDown()
+
+	// Два брокера со своими соединениями LISTEN - как два экземпляра API
+	first := broker.NewPostgresBroker(db, os.Getenv("DATABASE_URL"))
+	defer first.Close()
+	second := broker.NewPostgresBroker(db, os.Getenv("DATABASE_URL"))
+	defer second.Close()
+
+	messages, _ := subscribe(t, second, "live.000003.evt")
+	assert.NoError(t, first.Publish("live.000003.evt", []byte(`{"event":{"type":"question"}}`)))
+	assert.Equal(t, `{"event":{"type":"question"}}`, receive(t, messages))
+
+	// Больше лимита NOTIFY - сообщение идёт через таблицу
+	large := strings.Repeat("x", 10000)
+	assert.NoError(t, first.Publish("live.000003.evt", []byte(large)))
+	assert.Equal(t, large, receive(t, messages))
+
+	claimed, err := first.Claim("live.000003", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, _ = second.Claim("live.000003", time.Minute)
+	assert.False(t, claimed)
+	exists, _ := second.Claimed("live.000003")
+	assert.True(t, exists)
+	assert.NoError(t, first.Release("live.000003"))
+}
@@ -1,13 +1,17 @@
 package tests
 
 import (
+	"api/account"
+	"api/class"
 	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClassInfo(t *testing.T) {
@@ -27,7 +31,6 @@ func TestValidCreateClass(t *testing.T) {
 
 	// Структура данных для запроса создания класса
 	createClassData := map[string]interface{}{
-		"teacher_password": "pAssword1234&",
 		"class": map[string]interface{}{
 			"teacher_id": 11,
 			"name":       "test class",
@@ -47,6 +50,7 @@ func TestValidCreateClass(t *testing.T) {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 11))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
@@ -62,12 +66,31 @@ func TestGetClass(t *testing.T) {
 	setup()
 	router := setupRouter()
 
+	// Без авторизации класс не читается
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/class/5", nil)
 	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var before int
+	if err := db.Get(&before, "SELECT count(*) FROM class"); err != nil {
+		t.Fatalf("Failed to count classes: %v", err)
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/class/5", nil)
+	req.Header.Set("Authorization", bearer(t, classTeacher(t, 5)))
+	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)          // Мы ожидаем, что статус будет OK
 	assert.NotContains(t, w.Body.String(), "error") // Также ожидаем, что в теле ответа не будет слово "error"
+	assert.Contains(t, w.Body.String(), `"ID":5`)
+
+	// Чтение не создаёт новых классов
+	var after int
+	if err := db.Get(&after, "SELECT count(*) FROM class"); err != nil {
+		t.Fatalf("Failed to count classes: %v", err)
+	}
+	assert.Equal(t, before, after)
 	defer tearDown()
 }
 
@@ -77,8 +100,7 @@ func TestAddStudentsToClass(t *testing.T) {
 
 	// Структура данных для запроса добавления студентов в класс
 	addStudentsData := map[string]interface{}{
-		"teacher_password": "pAssword1234&", // Замените на реальный пароль
-		"student_ids":      []int32{13},     // Замените на реальные ID студентов
+		"student_ids": []int32{13}, // Замените на реальные ID студентов
 	}
 
 	// Преобразование структуры данных в JSON
@@ -94,6 +116,7 @@ func TestAddStudentsToClass(t *testing.T) {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, classTeacher(t, 5)))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
@@ -109,17 +132,10 @@ func TestGetStudentClasses(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "Password123!",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/13/classes", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("GET", "/api/account/13/classes", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 13))
 
 	router.ServeHTTP(w, req)
 
@@ -133,17 +149,10 @@ func TestGetTeacherClasses(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"password": "pAssword1234&",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/api/account/11/classes", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("GET", "/api/account/11/classes", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, 11))
 
 	router.ServeHTTP(w, req)
 
@@ -152,3 +161,85 @@ func TestGetTeacherClasses(t *testing.T) {
 	assert.NotContains(t, w.Body.String(), "error")
 	defer tearDown()
 }
+
+func TestInviteUsable(t *testing.T) {
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+	maxUses := int32(2)
+
+	assert.True(t, (&class.Invite{}).Usable(now))
+	assert.True(t, (&class.Invite{ExpireTime: &future, MaxUses: &maxUses, Uses: 1}).Usable(now))
+	assert.False(t, (&class.Invite{ExpireTime: &past}).Usable(now))
+	assert.False(t, (&class.Invite{MaxUses: &maxUses, Uses: 2}).Usable(now))
+}
+
+func TestClassInvite(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	request := func(method, url, token, body string, code int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", token)
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code)
+		return w
+	}
+	var studentID int32
+	err := db.Get(&studentID, `SELECT id FROM account WHERE account_type='student'
+		AND id NOT IN (SELECT student_id FROM student WHERE class_id=5) LIMIT 1`)
+	if err != nil {
+		t.Fatalf("Failed to find a student outside the class: %v", err)
+	}
+	teacher, student := bearer(t, classTeacher(t, 5)), bearer(t, studentID)
+	createInvite := func(body string) string {
+		w := request("POST", "/api/class/5/invite", teacher, body, http.StatusCreated)
+		var invite map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &invite))
+		return invite["link"].(string)
+	}
+
+	request("POST", "/api/class/5/invite", student, `{}`, http.StatusForbidden)
+	request("POST", "/api/class/5/invite", teacher, `{"max_uses":0}`, http.StatusBadRequest)
+
+	// Код на одно вступление
+	link := createInvite(`{"max_uses":1}`)
+	request("POST", link, teacher, "", http.StatusForbidden)
+	// Администратор проходит политики маршрута, но учеником не становится
+	admin, err := account.New(fmt.Sprintf("admin_%d", time.Now().UnixNano()%1e12), "Admin#123", account.Admin, nil)
+	if err != nil {
+		t.Fatalf("Failed to create admin account: %v", err)
+	}
+	defer admin.Delete()
+	request("POST", link, bearer(t, admin.ID), "", http.StatusBadRequest)
+	request("POST", link, student, "", http.StatusOK)
+	request("POST", link, student, "", http.StatusGone)
+
+	// Новый код заменяет старый, вступление ждёт одобрения
+	_, err = db.Exec("DELETE FROM student WHERE class_id=5 AND student_id=$1", studentID)
+	assert.NoError(t, err)
+	approvalLink := createInvite(`{"require_approval":true}`)
+	request("POST", link, student, "", http.StatusNotFound)
+	// Код можно ввести в любом регистре
+	code := approvalLink[strings.LastIndex(approvalLink, "/")+1:]
+	request("POST", "/api/class/join/"+strings.ToUpper(code), student, "", http.StatusAccepted)
+	request("POST", approvalLink, student, "", http.StatusAccepted)
+
+	w := request("GET", "/api/class/5/invite", teacher, "", http.StatusOK)
+	assert.Contains(t, w.Body.String(), `"uses":1`)
+	w = request("GET", "/api/class/5/requests", teacher, "", http.StatusOK)
+	assert.Contains(t, w.Body.String(), fmt.Sprintf(`"student_id":%d`, studentID))
+
+	request("POST", fmt.Sprintf("/api/class/5/requests/%d/accept", studentID), teacher, "", http.StatusNoContent)
+	request("DELETE", fmt.Sprintf("/api/class/5/requests/%d", studentID), teacher, "", http.StatusNotFound)
+	var inClass bool
+	assert.NoError(t, db.Get(&inClass, "SELECT EXISTS(SELECT 1 FROM student WHERE class_id=5 AND student_id=$1)", studentID))
+	assert.True(t, inClass)
+	request("POST", approvalLink, student, "", http.StatusConflict)
+
+	request("DELETE", "/api/class/5/invite", teacher, "", http.StatusNoContent)
+	request("GET", "/api/class/5/invite", teacher, "", http.StatusNotFound)
+	request("POST", approvalLink, student, "", http.StatusNotFound)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"api/crypto"
+	"crypto/sha512"
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncodePassword(t *testing.T) {
+	hash, err := crypto.EncodePassword("Password123!")
+	if err != nil {
+		t.Fatalf("Failed to encode password: %v", err)
+	}
+
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$"))
+	assert.True(t, crypto.VerifyPassword("Password123!", hash))
+	assert.False(t, crypto.VerifyPassword("Password123?", hash))
+	assert.False(t, crypto.NeedsRehash(hash))
+
+	// Соль случайная, поэтому два хеша одного пароля различаются
+	otherHash, _ := crypto.EncodePassword("Password123!")
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestVerifyLegacyPassword(t *testing.T) {
+	// Старая схема: SHA-512 от пароля и ещё несколько раундов SHA-512 без соли
+	bytes := sha512.Sum512([]byte("Password123!"))
+	for i := 0; i < 5; i++ {
+		bytes = sha512.Sum512(bytes[:])
+	}
+	legacyHash := hex.EncodeToString(bytes[:])
+
+	assert.True(t, crypto.VerifyPassword("Password123!", legacyHash))
+	assert.False(t, crypto.VerifyPassword("Password123?", legacyHash))
+	assert.True(t, crypto.NeedsRehash(legacyHash))
+}
+
+func TestNeedsRehashOnParamsChange(t *testing.T) {
+	hash, _ := crypto.EncodePassword("Password123!")
+
+	params := crypto.Argon2Params
+	defer func() { crypto.Argon2Params = params }()
+	crypto.Argon2Params.Iterations++
+
+	assert.True(t, crypto.VerifyPassword("Password123!", hash))
+	assert.True(t, crypto.NeedsRehash(hash))
+}
+
+func TestValidateEmail(t *testing.T) {
+	assert.True(t, crypto.ValidateEmail("treminov@edu.hse.ru"))
+	assert.False(t, crypto.ValidateEmail("treminov"))
+	assert.False(t, crypto.ValidateEmail("Treminov <treminov@edu.hse.ru>"))
+	assert.False(t, crypto.ValidateEmail("treminov@edu.hse.ru\r\nBcc: x@y.z"))
+}
+
+func TestTOTP(t *testing.T) {
+	// Тестовый вектор RFC 6238 для SHA-1: секрет "12345678901234567890", T=59 -> 94287082 (8 цифр)
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	at := time.Unix(59, 0)
+
+	code, err := crypto.TOTPCode(secret, at)
+	assert.NoError(t, err)
+	assert.Equal(t, "287082", code)
+
+	step, ok := crypto.VerifyTOTP(secret, code, at)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), step)
+
+	// Соседний интервал принимается, дальний - нет
+	_, ok = crypto.VerifyTOTP(secret, code, at.Add(30*time.Second))
+	assert.True(t, ok)
+	_, ok = crypto.VerifyTOTP(secret, code, at.Add(90*time.Second))
+	assert.False(t, ok)
+
+	uri := crypto.TOTPURI("Qwiz", "teacher", secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Qwiz:teacher?"))
+	assert.Contains(t, uri, "secret="+secret)
+}
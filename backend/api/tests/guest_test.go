@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"api/guest"
+	"api/live"
+	"api/qwiz"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGuestNickname(t *testing.T) {
+	nickname, err := guest.CheckNickname("  Guest 42 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "Guest 42", nickname)
+
+	_, err = guest.CheckNickname("")
+	assert.ErrorIs(t, err, guest.ErrInvalidNickname)
+	_, err = guest.CheckNickname(strings.Repeat("a", guest.MaxNicknameLength+1))
+	assert.ErrorIs(t, err, guest.ErrInvalidNickname)
+	_, err = guest.CheckNickname("???")
+	assert.ErrorIs(t, err, guest.ErrInvalidNickname)
+
+	// Замены букв цифрами и разделители фильтр не обходят
+	_, err = guest.CheckNickname("Sh1t_Happens")
+	assert.ErrorIs(t, err, guest.ErrBlockedNickname)
+	_, err = guest.CheckNickname("A-d-m-i-n")
+	assert.ErrorIs(t, err, guest.ErrBlockedNickname)
+	_, err = guest.CheckNickname("Admiral")
+	assert.NoError(t, err)
+
+	g := guest.Guest{ID: 5}
+	assert.Equal(t, int32(-5), g.ParticipantID())
+	assert.True(t, guest.IsGuestID(g.ParticipantID()))
+}
+
+func createGuest(t *testing.T, router http.Handler, url string, nickname string) guest.GetGuestData {
+	data, _ := json.Marshal(map[string]interface{}{"nickname": nickname})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created guest.GetGuestData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	return created
+}
+
+func TestGuestLive(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostAuth := bearer(t, qwizCreator(t, 18))
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18})
+
+	created := createGuest(t, router, "/api/live/"+code+"/guest", "Visitor")
+	assert.Less(t, created.ID, int32(0))
+	assert.NotEmpty(t, created.GuestToken)
+
+	player := dialLive(t, server, code)
+	defer player.Close()
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "join", GuestToken: created.GuestToken}))
+	joined := readEvent(t, player)
+	assert.Equal(t, "joined", joined.Type)
+	assert.Equal(t, created.ID, joined.Participant.ID)
+	assert.Equal(t, "Visitor", joined.Participant.DisplayName)
+	readEvent(t, player)
+
+	// Второй гость с тем же ником в ту же сессию не войдёт
+	other := createGuest(t, router, "/api/live/"+code+"/guest", "visitor")
+	second := dialLive(t, server, code)
+	defer second.Close()
+	assert.NoError(t, second.WriteJSON(live.Command{Type: "join", GuestToken: other.GuestToken}))
+	assert.Equal(t, live.ErrNameTaken.Error(), readEvent(t, second).Error)
+
+	// Токен одной сессии в другой не действует
+	otherCode := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18})
+	third := dialLive(t, server, otherCode)
+	defer third.Close()
+	assert.NoError(t, third.WriteJSON(live.Command{Type: "join", GuestToken: created.GuestToken}))
+	assert.Equal(t, "error", readEvent(t, third).Type)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/live/"+code+"/guest", strings.NewReader(`{"nickname":"Fuck0ff"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGuestSolve(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	created := createGuest(t, router, "/api/qwiz/18/guest", "Visitor")
+
+	data, _ := json.Marshal(map[string]interface{}{"answers": []int{1}, "guest_token": created.GuestToken})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/qwiz/18/solve", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var solved struct {
+		Guest *guest.GetGuestData `json:"guest"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &solved))
+	if assert.NotNil(t, solved.Guest) {
+		assert.Equal(t, created.ID, solved.Guest.ID)
+		assert.Empty(t, solved.Guest.GuestToken)
+	}
+
+	// Попытку гостя отправляет только он
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/qwiz/18/attempt", strings.NewReader(`{"guest_token":"`+created.GuestToken+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var started qwiz.GetAttemptData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &started))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/qwiz/18/solve", strings.NewReader(fmt.Sprintf(`{"answers":[1],"attempt_id":%d}`, started.ID)))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	data, _ = json.Marshal(map[string]interface{}{"answers": []int{1}, "attempt_id": started.ID, "guest_token": created.GuestToken})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/qwiz/18/solve", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	data, _ = json.Marshal(map[string]interface{}{"answers": []int{1}, "guest_token": "wrong"})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/qwiz/18/solve", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
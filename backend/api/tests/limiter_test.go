@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"api/account"
+	"api/audit"
+	"api/limiter"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	policy := limiter.Policy{FreeAttempts: 3, BaseDelay: time.Second, MaxDelay: 5 * time.Second, Window: time.Minute}
+
+	assert.Equal(t, time.Duration(0), policy.Delay(3))
+	assert.Equal(t, time.Second, policy.Delay(4))
+	assert.Equal(t, 2*time.Second, policy.Delay(5))
+	assert.Equal(t, 4*time.Second, policy.Delay(6))
+	assert.Equal(t, 5*time.Second, policy.Delay(7))
+	assert.Equal(t, 5*time.Second, policy.Delay(100))
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	l := limiter.NewMemoryLimiter(limiter.Policy{FreeAttempts: 1, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour})
+
+	delay, err := l.Fail("account:1")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), delay)
+
+	delay, _ = l.Fail("account:1")
+	assert.Equal(t, time.Minute, delay)
+
+	retryAfter, _ := l.Check("account:1")
+	assert.True(t, retryAfter > 0 && retryAfter <= time.Minute)
+
+	// Другие ключи не затронуты
+	retryAfter, _ = l.Check("account:2")
+	assert.Equal(t, time.Duration(0), retryAfter)
+
+	assert.NoError(t, l.Reset("account:1"))
+	retryAfter, _ = l.Check("account:1")
+	assert.Equal(t, time.Duration(0), retryAfter)
+}
+
+func TestLoginLockout(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	accountLimiter, ipLimiter := account.AccountLimiter, account.IPLimiter
+	policy := limiter.Policy{FreeAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour}
+	account.AccountLimiter = limiter.NewMemoryLimiter(policy)
+	account.IPLimiter = limiter.NewMemoryLimiter(limiter.DefaultPolicy)
+	defer func() { account.AccountLimiter, account.IPLimiter = accountLimiter, ipLimiter }()
+
+	acct, err := account.GetByID(13)
+	if err != nil {
+		t.Fatalf("Failed to get account: %v", err)
+	}
+
+	credentials := map[string]interface{}{"username": acct.Username, "password": "Wrong#123"}
+	for i := 0; i < 3; i++ {
+		w := postJSON(router, "/api/account/login", credentials)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	}
+
+	w := postJSON(router, "/api/account/login", credentials)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	// Во время блокировки не помогает и верный пароль
+	w = postJSON(router, "/api/account/verify", map[string]interface{}{"username": acct.Username, "password": "Password123!"})
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	entries, err := audit.GetAllByAccountID(acct.ID, 10)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, entries) {
+		assert.Equal(t, audit.LoginLocked, entries[0].Event)
+	}
+}
@@ -1,3 +1,423 @@
 package tests
 
-// Not ready yet... TODO
+import (
+	"api/live"
+	"api/question"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLiveQwizStateMachine(t *testing.T) {
+	q := question.Question{Body: "1+2?", Answers: choices([]int{3}, "1", "2", "3")}
+
+	running := live.NewRunningLiveQwiz(q, live.QwizOptions{}, []int32{1, 2})
+	assert.Equal(t, []string{"1", "2", "3"}, running.CurrentAnswers)
+	assert.Equal(t, uint8(2), running.CorrectAnswer)
+
+	assert.NoError(t, running.Answer(1, 2))
+	assert.ErrorIs(t, running.Answer(1, 0), live.ErrAlreadyAnswered)
+	assert.NoError(t, running.Answer(2, 0))
+	assert.ErrorIs(t, running.Answer(3, 0), live.ErrNotParticipant)
+	assert.ErrorIs(t, running.Answer(2, 5), live.ErrAlreadyAnswered)
+
+	next := running.Next(&q, live.QwizOptions{ShuffleAnswers: true}, []int32{1, 2}).(*live.RunningLiveQwiz)
+	assert.Equal(t, 1, next.QuestionNumber)
+	assert.ErrorIs(t, next.Answer(1, 9), live.ErrInvalidAnswer)
+	assert.NoError(t, next.Answer(1, next.CorrectAnswer))
+
+	finished := next.Next(nil, live.QwizOptions{}, []int32{1, 2}).(*live.FinishingLiveQwiz)
+	assert.Equal(t, uint(2), finished.Scores[1])
+	assert.Equal(t, uint(0), finished.Scores[2])
+	assert.ElementsMatch(t, []int32{1, 2}, finished.Participants)
+}
+
+func TestLiveQuestionTypes(t *testing.T) {
+	third := "3"
+	multiple := question.Question{Type: question.Multiple, Answers: choices([]int{1, 3}, "1", "2", "3")}
+	running := live.NewRunningLiveQwiz(multiple, live.QwizOptions{}, []int32{1, 2, 3})
+	assert.Equal(t, []uint8{0, 2}, running.CorrectAnswers)
+	assert.NoError(t, running.Respond(1, live.Response{Choices: []uint8{2, 0}}))
+	assert.NoError(t, running.Respond(2, live.Response{Choices: []uint8{0}}))
+	assert.ErrorIs(t, running.Respond(3, live.Response{Text: &third}), live.ErrInvalidAnswer)
+	assert.Equal(t, []int{2, 0, 1}, running.Distribution())
+
+	// true/false не перемешивается
+	trueFalse := question.Question{Type: question.TrueFalse, Answers: choices([]int{2}, "True", "False")}
+	next := running.Next(&trueFalse, live.QwizOptions{ShuffleAnswers: true}, nil).(*live.RunningLiveQwiz)
+	assert.Equal(t, []string{"True", "False"}, next.CurrentAnswers)
+	assert.NoError(t, next.Answer(1, 1))
+
+	text := question.Question{Type: question.Text, AcceptedAnswers: []string{"Paris"}}
+	next = next.Next(&text, live.QwizOptions{}, nil).(*live.RunningLiveQwiz)
+	assert.Empty(t, next.CurrentAnswers)
+	paris := " PARIS"
+	assert.NoError(t, next.Respond(2, live.Response{Text: &paris}))
+	assert.ErrorIs(t, next.Answer(1, 0), live.ErrInvalidAnswer)
+
+	finished := next.Next(nil, live.QwizOptions{}, nil).(*live.FinishingLiveQwiz)
+	assert.Equal(t, []bool{true, true, false}, finished.AcceptedAnswers[1])
+	assert.Equal(t, []bool{false, false, true}, finished.AcceptedAnswers[2])
+	assert.Nil(t, finished.Responses[3][0])
+	assert.Equal(t, []uint8{3, 1}, finished.Responses[1][0].Choices)
+}
+
+func TestLiveOrderingMatching(t *testing.T) {
+	ordering := question.Question{Type: question.Ordering, Answers: choices(nil, "a", "b", "c")}
+	running := live.NewRunningLiveQwiz(ordering, live.QwizOptions{}, []int32{1, 2})
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, running.CurrentAnswers)
+	assert.NoError(t, running.Respond(1, live.Response{Sequence: running.CorrectSequence}))
+	wrong := append([]uint8(nil), running.CorrectSequence...)
+	wrong[0], wrong[1] = wrong[1], wrong[0]
+	assert.NoError(t, running.Respond(2, live.Response{Sequence: wrong}))
+	assert.Equal(t, []uint8{1, 2, 3}, running.Responses[1][0].Sequence)
+
+	matching := question.Question{Type: question.Matching, Answers: pairs("cat", "meow", "dog", "woof")}
+	next := running.Next(&matching, live.QwizOptions{ShuffleAnswers: true}, nil).(*live.RunningLiveQwiz)
+	assert.ElementsMatch(t, []string{"meow", "woof"}, next.CurrentMatches)
+	assert.ErrorIs(t, next.Respond(1, live.Response{Sequence: []uint8{0}}), live.ErrInvalidAnswer)
+	assert.ErrorIs(t, next.Respond(1, live.Response{Sequence: []uint8{0, 0}}), live.ErrInvalidAnswer)
+	assert.NoError(t, next.Respond(1, live.Response{Sequence: next.CorrectSequence}))
+
+	// Частично верный ответ очков не даёт
+	finished := next.Next(nil, live.QwizOptions{}, nil).(*live.FinishingLiveQwiz)
+	assert.Equal(t, []bool{true, true}, finished.AcceptedAnswers[1])
+	assert.Equal(t, []bool{false, false}, finished.AcceptedAnswers[2])
+}
+
+func TestLiveScoring(t *testing.T) {
+	var limit int16 = 10
+	q := question.Question{Body: "1+1?", Answers: choices([]int{1}, "2", "3"), TimeLimit: &limit}
+	options := live.QwizOptions{Scoring: live.ScoringSpeed}
+
+	running := live.NewRunningLiveQwiz(q, options, []int32{1, 2, 3})
+	assert.Equal(t, 10*time.Second, running.TimeLimit)
+	assert.NoError(t, running.AnswerAt(1, 0, running.StartTime))
+	assert.NoError(t, running.AnswerAt(2, 0, running.StartTime.Add(5*time.Second)))
+	assert.ErrorIs(t, running.AnswerAt(3, 0, running.StartTime.Add(11*time.Second)), live.ErrTimeUp)
+	assert.Equal(t, uint(750), running.Score(2, options))
+
+	finished := running.Next(nil, options, nil).(*live.FinishingLiveQwiz)
+	assert.Equal(t, uint(1000), finished.Scores[1])
+	assert.Equal(t, uint(750), finished.Scores[2])
+	assert.Equal(t, uint(0), finished.Scores[3])
+
+	// Серия: 1 + 1.1 + 1.2 (+ 1.3), ошибка обнуляет множитель
+	streak := live.QwizOptions{StreakBonus: true}
+	assert.Equal(t, uint(3), streak.Score([]bool{true, true, true}, nil, nil))
+	assert.Equal(t, uint(5), streak.Score([]bool{true, true, true, true}, nil, nil))
+	assert.Equal(t, uint(3), streak.Score([]bool{true, true, false, true}, nil, nil))
+
+	_, err := live.ParseScoring("fastest")
+	assert.ErrorIs(t, err, live.ErrInvalidScoring)
+}
+
+func createLive(t *testing.T, router http.Handler, auth string, body map[string]interface{}) string {
+	data, _ := json.Marshal(body)
+	req, _ := http.NewRequest("POST", "/api/live", strings.NewReader(string(data)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", auth)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created struct {
+		Code string `json:"code"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	return created.Code
+}
+
+func TestLiveHostControls(t *testing.T) {
+	q := question.Question{Body: "2+2?", Answers: choices([]int{1}, "4", "5")}
+	running := live.NewRunningLiveQwiz(q, live.QwizOptions{}, []int32{1, 2, 3})
+
+	assert.NoError(t, running.Answer(1, 0))
+	assert.NoError(t, running.Answer(2, 1))
+	assert.Equal(t, []int{1, 1}, running.Distribution())
+	assert.True(t, running.Answered(1))
+	assert.False(t, running.Answered(3))
+
+	// Пауза не входит во время ответа
+	running.TimeLimit = 10 * time.Second
+	start := running.StartTime
+	running.Pause(start.Add(2 * time.Second))
+	assert.ErrorIs(t, running.AnswerAt(3, 0, start.Add(3*time.Second)), live.ErrPaused)
+	running.Unpause(start.Add(12 * time.Second))
+	deadline, _ := running.Deadline()
+	assert.Equal(t, start.Add(20*time.Second), deadline)
+	assert.NoError(t, running.AnswerAt(3, 0, start.Add(15*time.Second)))
+
+	running.Remove(2)
+	finished := running.Next(nil, live.QwizOptions{}, nil).(*live.FinishingLiveQwiz)
+	assert.ElementsMatch(t, []int32{1, 3}, finished.Participants)
+
+	next := live.NewRunningLiveQwiz(q, live.QwizOptions{}, []int32{1})
+	next.Revealed = true
+	assert.ErrorIs(t, next.Answer(1, 0), live.ErrAnswersClosed)
+}
+
+func dialLive(t *testing.T, server *httptest.Server, code string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/live/" + code + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Failed to dial live session: %v", err)
+	}
+	return conn
+}
+
+func readEvent(t *testing.T, conn *websocket.Conn) live.Event {
+	var event live.Event
+	if err := conn.ReadJSON(&event); err != nil {
+		t.Fatalf("Failed to read event: %v", err)
+	}
+	return event
+}
+
+func TestLiveSession(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostAuth := bearer(t, qwizCreator(t, 18))
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18})
+
+	host := dialLive(t, server, code)
+	defer host.Close()
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "host", AccessToken: strings.TrimPrefix(hostAuth, "Bearer ")}))
+	assert.Equal(t, "hosting", readEvent(t, host).Type)
+
+	player := dialLive(t, server, code)
+	defer player.Close()
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "join", AccessToken: strings.TrimPrefix(bearer(t, 13), "Bearer "), DisplayName: "Student"}))
+	assert.Equal(t, "joined", readEvent(t, player).Type)
+	assert.Equal(t, "participants", readEvent(t, player).Type)
+	assert.Len(t, readEvent(t, host).Participants, 1)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	questionEvent := readEvent(t, player)
+	assert.Equal(t, "question", questionEvent.Type)
+	assert.NotEmpty(t, questionEvent.Question.Answers)
+	readEvent(t, host)
+
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "answer", Answer: 1}))
+	assert.Equal(t, "answer_accepted", readEvent(t, player).Type)
+
+	// Вопрос в викторине один, следующий шаг - итоги
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	finished := readEvent(t, player)
+	assert.Equal(t, "finished", finished.Type)
+	assert.Len(t, finished.Scores, 1)
+	assert.Equal(t, int32(13), finished.Scores[0].ID)
+}
+
+func TestLiveSessionTimeUp(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostAuth := bearer(t, qwizCreator(t, 18))
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18, "time_limit": 1, "scoring": "speed"})
+
+	host := dialLive(t, server, code)
+	defer host.Close()
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "host", AccessToken: strings.TrimPrefix(hostAuth, "Bearer ")}))
+	assert.Equal(t, "hosting", readEvent(t, host).Type)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	questionEvent := readEvent(t, host)
+	assert.Equal(t, "question", questionEvent.Type)
+	assert.Equal(t, 1, questionEvent.Question.TimeLimit)
+	assert.NotNil(t, questionEvent.Question.Deadline)
+	assert.Equal(t, "dashboard", readEvent(t, host).Type)
+
+	// Ведущий больше ничего не шлёт: по истечении секунды викторина завершается сама
+	assert.NoError(t, host.SetReadDeadline(time.Now().Add(5*time.Second)))
+	assert.Equal(t, "finished", readEvent(t, host).Type)
+}
+
+func TestLiveResume(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostAuth := bearer(t, qwizCreator(t, 18))
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18})
+
+	host := dialLive(t, server, code)
+	defer host.Close()
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "host", AccessToken: strings.TrimPrefix(hostAuth, "Bearer ")}))
+	assert.Equal(t, "hosting", readEvent(t, host).Type)
+
+	player := dialLive(t, server, code)
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "join", AccessToken: strings.TrimPrefix(bearer(t, 13), "Bearer "), DisplayName: "Student"}))
+	joined := readEvent(t, player)
+	assert.Equal(t, "joined", joined.Type)
+	assert.NotEmpty(t, joined.ResumeToken)
+	readEvent(t, player)
+	readEvent(t, host)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	questionEvent := readEvent(t, player)
+	readEvent(t, host)
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "answer", Answer: 1}))
+	assert.Equal(t, "answer_accepted", readEvent(t, player).Type)
+	player.Close()
+
+	// Переподключение: тот же участник, ответ на текущий вопрос сохранён
+	player = dialLive(t, server, code)
+	defer player.Close()
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "resume", ResumeToken: "wrong"}))
+	assert.Equal(t, "error", readEvent(t, player).Type)
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "resume", ResumeToken: joined.ResumeToken}))
+	snapshot := readEvent(t, player)
+	assert.Equal(t, "snapshot", snapshot.Type)
+	assert.Equal(t, "question", snapshot.State)
+	assert.Equal(t, int32(13), snapshot.Participant.ID)
+	assert.Len(t, snapshot.Participants, 1)
+	assert.Equal(t, questionEvent.Question.Answers, snapshot.Question.Answers)
+	assert.True(t, *snapshot.Answered)
+
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "answer", Answer: 1}))
+	assert.Equal(t, "error", readEvent(t, player).Type)
+}
+
+func TestLiveDashboard(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostAuth := bearer(t, qwizCreator(t, 18))
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18})
+
+	host := dialLive(t, server, code)
+	defer host.Close()
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "host", AccessToken: strings.TrimPrefix(hostAuth, "Bearer ")}))
+	assert.Equal(t, "hosting", readEvent(t, host).Type)
+
+	player := dialLive(t, server, code)
+	defer player.Close()
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "join", AccessToken: strings.TrimPrefix(bearer(t, 13), "Bearer "), DisplayName: "Student"}))
+	readEvent(t, player)
+	readEvent(t, player)
+	readEvent(t, host)
+
+	// Участникам команды ведущего недоступны
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "reveal"}))
+	assert.Equal(t, "error", readEvent(t, player).Type)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	readEvent(t, player)
+	assert.Equal(t, "question", readEvent(t, host).Type)
+	dashboard := readEvent(t, host)
+	assert.Equal(t, "dashboard", dashboard.Type)
+	assert.Equal(t, 0, dashboard.Dashboard.Answered)
+	assert.Len(t, dashboard.Dashboard.Unanswered, 1)
+
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "answer", Answer: 1}))
+	readEvent(t, player)
+	dashboard = readEvent(t, host)
+	assert.Equal(t, 1, dashboard.Dashboard.Answered)
+	assert.Equal(t, 1, dashboard.Dashboard.Distribution[0])
+	assert.Empty(t, dashboard.Dashboard.Unanswered)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "reveal"}))
+	reveal := readEvent(t, player)
+	assert.Equal(t, "reveal", reveal.Type)
+	assert.NotNil(t, reveal.Correct)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "kick", ParticipantID: 13}))
+	assert.Equal(t, "kicked", readEvent(t, player).Type)
+}
+
+func TestLiveHistory(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	hostID := qwizCreator(t, 18)
+	hostAuth := bearer(t, hostID)
+	code := createLive(t, router, hostAuth, map[string]interface{}{"qwiz_id": 18, "shuffle_answers": true})
+
+	host := dialLive(t, server, code)
+	defer host.Close()
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "host", AccessToken: strings.TrimPrefix(hostAuth, "Bearer ")}))
+	readEvent(t, host)
+
+	player := dialLive(t, server, code)
+	defer player.Close()
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "join", AccessToken: strings.TrimPrefix(bearer(t, 13), "Bearer "), DisplayName: "Student"}))
+	readEvent(t, player)
+	readEvent(t, player)
+	readEvent(t, host)
+
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	questionEvent := readEvent(t, player)
+	assert.NoError(t, player.WriteJSON(live.Command{Type: "answer", Answer: 1}))
+	assert.Equal(t, "answer_accepted", readEvent(t, player).Type)
+	assert.NoError(t, host.WriteJSON(live.Command{Type: "next"}))
+	finished := readEvent(t, player)
+	assert.Equal(t, "finished", finished.Type)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/live/history/qwiz/18", nil)
+	req.Header.Set("Authorization", hostAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var sessions []live.GetSessionRecordData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &sessions))
+	if !assert.NotEmpty(t, sessions) {
+		return
+	}
+	assert.Equal(t, code, sessions[0].Code)
+	assert.Equal(t, int32(1), sessions[0].ParticipantCount)
+
+	// Участник отчёт ведущего не видит
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/api/live/history/%d", sessions[0].ID), nil)
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/api/live/history/%d", sessions[0].ID), nil)
+	req.Header.Set("Authorization", hostAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var report live.GetReportData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Len(t, report.Questions, 1)
+	if assert.Len(t, report.Participants, 1) {
+		p := report.Participants[0]
+		assert.Equal(t, int32(13), *p.AccountID)
+		assert.Equal(t, int32(finished.Scores[0].Score), p.Score)
+		if assert.Len(t, p.Answers, 1) {
+			// Вариант сохраняется в исходном порядке вопроса, а не в перемешанном
+			choice := report.Questions[0].Answers[*p.Answers[0].Choice-1]
+			assert.Equal(t, questionEvent.Question.Answers[0], choice)
+			assert.NotNil(t, p.Answers[0].ResponseTime)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/api/live/history/host/%d", hostID), nil)
+	req.Header.Set("Authorization", hostAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
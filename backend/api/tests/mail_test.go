@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"api/account"
+	"api/mailer"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordMailer запоминает письма вместо отправки.
+type recordMailer struct {
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (m *recordMailer) Send(msg mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+var tokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+func (m *recordMailer) lastToken(t *testing.T) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.messages) == 0 {
+		t.Fatal("No mail sent")
+	}
+	match := tokenPattern.FindStringSubmatch(m.messages[len(m.messages)-1].Body)
+	if match == nil {
+		t.Fatal("No token in mail")
+	}
+	return match[1]
+}
+
+func postJSON(router http.Handler, url string, body interface{}) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.txt")
+	m := &mailer.FileMailer{Path: path}
+
+	err := m.Send(mailer.Message{To: "treminov@edu.hse.ru", Subject: "Сброс пароля", Body: mailer.Link("/reset-password", "abc")})
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "To: treminov@edu.hse.ru")
+	assert.Contains(t, string(data), "Subject: Сброс пароля")
+	assert.Contains(t, string(data), "/reset-password?token=abc")
+}
+
+func TestEmailVerificationAndPasswordReset(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	mail := &recordMailer{}
+	defaultMailer := mailer.Default
+	mailer.Default = mail
+	defer func() { mailer.Default = defaultMailer }()
+
+	suffix := time.Now().UnixNano() % 1e12
+	username := fmt.Sprintf("mail_%d", suffix)
+	email := fmt.Sprintf("%s@example.com", username)
+
+	w := postJSON(router, "/api/account", map[string]interface{}{
+		"username":     username,
+		"password":     "Password123!",
+		"account_type": "Student",
+		"email":        email,
+	})
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	acct, err := account.GetByUsername(username)
+	if err != nil {
+		t.Fatalf("Failed to get account: %v", err)
+	}
+	defer acct.Delete()
+	assert.False(t, acct.EmailVerified)
+	// Неподтверждённый адрес не занимает его: другой аккаунт может на него зарегистрироваться
+	assert.Nil(t, acct.Email)
+	taken, err := account.ExistsEmail(email, 0)
+	assert.NoError(t, err)
+	assert.False(t, taken)
+
+	// Пока адрес не подтверждён, ссылка для сброса пароля не отправляется
+	sent := len(mail.messages)
+	w = postJSON(router, "/api/account/password/reset", map[string]interface{}{"email": email})
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, sent, len(mail.messages))
+
+	verifyToken := mail.lastToken(t)
+	w = postJSON(router, "/api/account/email/verify", map[string]interface{}{"token": verifyToken})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Токен одноразовый
+	w = postJSON(router, "/api/account/email/verify", map[string]interface{}{"token": verifyToken})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = postJSON(router, "/api/account/password/reset", map[string]interface{}{"email": email})
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	resetToken := mail.lastToken(t)
+
+	// Слабый пароль отклоняется и не сжигает ссылку
+	w = postJSON(router, "/api/account/password/reset/confirm", map[string]interface{}{"token": resetToken, "new_password": "weak"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = postJSON(router, "/api/account/password/reset/confirm", map[string]interface{}{"token": resetToken, "new_password": "NewPassword123!"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = postJSON(router, "/api/account/login", map[string]interface{}{"username": username, "password": "NewPassword123!"})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -3,8 +3,13 @@ package tests
 import (
 	"api/account"
 	"api/assignment"
+	"api/audit"
+	"api/authz"
 	"api/class"
+	"api/guest"
+	"api/live"
 	"api/media"
+	"api/parent"
 	"api/question"
 	"api/qwiz"
 	"api/utils"
@@ -18,6 +23,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"testing"
 )
 
 var db *sqlx.DB
@@ -66,8 +72,13 @@ func setupRouter() *gin.Engine {
 
 	account.DB = db
 	assignment.DB = db
+	audit.DB = db
+	authz.DB = db
 	class.DB = db
+	guest.DB = db
+	live.DB = db
 	media.DB = db
+	parent.DB = db
 	question.DB = db
 	vote.DB = db
 	qwiz.DB = db
@@ -89,9 +100,40 @@ func setupRouter() *gin.Engine {
 	class.RegisterRoutes(r)      // маршруты для классов
 	vote.RegisterRoutes(r)       // маршруты для голосования
 	media.RegisterRoutes(r)      // маршруты для медиа
+	live.RegisterRoutes(r)       // маршруты для живых викторин
+	parent.RegisterRoutes(r)     // маршруты для связей родителей с учениками
 	account.RegisterRoutes(r)    // маршруты для аккаунтов и заданий
 	question.RegisterRoutes(r)   // маршруты для вопросов
 	qwiz.RegisterRoutes(r)       // маршруты для викторины
 
 	return r
 }
+
+// bearer выдаёт токены аккаунту и возвращает значение заголовка Authorization
+func bearer(t *testing.T, accountID int32) string {
+	acct, err := account.GetByID(accountID)
+	if err != nil {
+		t.Fatalf("Failed to get account %d: %v", accountID, err)
+	}
+	tokens, err := acct.IssueTokens()
+	if err != nil {
+		t.Fatalf("Failed to issue tokens: %v", err)
+	}
+	return "Bearer " + tokens.AccessToken
+}
+
+func qwizCreator(t *testing.T, qwizID int32) int32 {
+	q, err := qwiz.GetByID(qwizID)
+	if err != nil {
+		t.Fatalf("Failed to get qwiz %d: %v", qwizID, err)
+	}
+	return q.CreatorID
+}
+
+func classTeacher(t *testing.T, classID int32) int32 {
+	cl, err := class.GetByID(classID)
+	if err != nil {
+		t.Fatalf("Failed to get class %d: %v", classID, err)
+	}
+	return cl.TeacherID
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"api/account"
+	"api/qwiz"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newParent(t *testing.T) *account.Account {
+	parent, err := account.New(fmt.Sprintf("parent_%d", time.Now().UnixNano()%1e12), "Parent#123", account.Parent, nil)
+	if err != nil {
+		t.Fatalf("Failed to create parent account: %v", err)
+	}
+	return parent
+}
+
+func TestParentLinkFlow(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	parent := newParent(t)
+	defer parent.Delete()
+	parentAuth := bearer(t, parent.ID)
+
+	// Без подтверждённой связи родитель не видит задания и попытки ученика
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/account/13/assignments", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/account/13/attempts", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	data, _ := json.Marshal(map[string]interface{}{"student_id": 13})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/parent/link", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var created struct {
+		Location string `json:"location"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+
+	// Родитель не может подтвердить приглашение сам
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", created.Location+"/accept", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", created.Location+"/accept", nil)
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"accepted"`)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/account/13/assignments", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/account/13/classes", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/account/13/attempts", nil)
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var attempts []qwiz.GetAttemptRecordData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &attempts))
+
+	// Изменять аккаунт ученика родитель не может
+	data, _ = json.Marshal(map[string]interface{}{"new_account_type": "parent"})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("PATCH", "/api/account/13", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", parentAuth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
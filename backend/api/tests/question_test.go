@@ -1,11 +1,14 @@
 package tests
 
 import (
+	"api/question"
 	"bytes"
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -50,7 +53,6 @@ func TestCreateQuestion(t *testing.T) {
 
 	// Структура данных для запроса создания викторины
 	createQwizData := map[string]interface{}{
-		"creator_password": "Password123!",
 		"question": map[string]interface{}{
 			"body":    "q2",
 			"answer1": "True",
@@ -73,6 +75,7 @@ func TestCreateQuestion(t *testing.T) {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, qwizCreator(t, 20)))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
@@ -114,7 +117,6 @@ func TestPatchQuestion(t *testing.T) {
 
 	// Структура данных для запроса изменения пароля
 	createQwizData := map[string]interface{}{
-		"creator_password": "Password123!",
 		"new_embed": map[string]interface{}{
 			"data":       "/9j/4AAQSkZJRgABAQAAAQABAAD/2wCEAAoHCBUVFRgVFRYYGBgZFRgYFRoVFRgYFRgYGBUZGRgYGBgcIS4lHB4rHxgYJjgmKy8xNTU1GiQ7QDs0Py40NTEBDAwMEA8QGhISGjEhGh0xMTQxMTQxNDQ0NDE0MTExND8/NDE0NDE/NDQ0Pz8xNDExNDExMTE0MTExNDQxMTExMf/AABEIAOEA4QMBIgACEQEDEQH/xAAbAAACAwEBAQAAAAAAAAAAAAADBAACBQEGB//EADUQAAIBAwIEBAQGAgIDAQAAAAABAgMEESExBRJBUWFxgZETobHwBhQiQsHRMuFS8XKSsiP/xAAYAQADAQEAAAAAAAAAAAAAAAABAgMABP/EABwRAQEBAQEBAQEBAAAAAAAAAAABEQIhEjFBUf/aAAwDAQACEQMRAD8AvWpxa0Zn3ENMpjdWo2Z9fOdBItmF1UaY9TqKa+TM6c5LoM2c9c7b5+RmlsOQodslLqmaUINarDz/AKE+JLqgSFrDvp598fyZ9KfMuULeTeTnD6eZMrmQP69DY0cwjlap/I1JQSSOWdNcqXUPcP8AT2wS6p5PSNxNb+Bk14czRoTTflr9QCpZnFdtQ80OtK11yuMTlxReyC1aXPNeZp1qCTXkG9DzNYc4YWNRi0tXoNxsG5t9DTtrZJ+gl6UnLtGg+3bH9+Zf8qll48hynBYwdlT0Nz0WxgX7ai/4PJ1puUj13H44WDzEaWo+hg1CD0+Ze5ejwEjDRbAq8GELCUEw0FrkBN/tXUZo/pis7maDOskksavd7s5DYXipZyGoPbvncGCPCmt312CcvRdisI8z29WNQjGPXOhq0A+HI6E/Mr/iQGjkPThJeICdPm1SwzYlCL7521QGVP0FlCsS5oyxsAjozdq0VrnsZVxb52Y0pLGlw65XLhvt7Fr+Ca0RjW6lDroa06inFrXxMzyl8lnAXhbSfqD4nRal4ZC8MknhY6opvgT9e1sGmsvp/QG5mnlHLaqlHAGdRNkev1XFHotjtrFOTl4A51OnodsZfqZvwLDFvQXO3genSWSU6Y2oL5CXpTmAU6OAkYINFFWT3TyLUo65L1FklJ6Emgwl/WVxWhzLJ5mdDDf31PX3Uf0mBXo6tlJfC4RUMAL9Yg/FDjhoL16XPEadB0wqE++/QfhBvUE6ai+wzbvm9x6We1SNKTfUchSUdy0JJaL3AVZ92DabBHcY0RSLb8hdbhY5D+tg3J4/MhTm8PmQ2M9fcXcI9vvqZNfi0c7rY8vc8Rb3kzMuuINrCyDmJ+PbLi8Wu4rPiEUeIjxBx2z7nJXTk9W/cNjePaUr2Em84wFjWX7Xp5mdZ8Bc6DqxktOmfAw43Uk3HOMf2DB+semvKXNqJW9NwkvMFwriOW4yefM3IU4vVrpp6g+hxalUb6nXPU7CmkVqw7CncVTuN2Gs8mbNa4NThk1kNZu0oaYCcpynstS2SdhuUSKNFmykmT/FP4LEkpHEzkpDEsBrMxLrTJsXM9GYVzqx5+BAmslLnRPsGUPERvpaNZDA6jCuJ5ljx7mlaU8JGdGm3LRP2Nq2pYWpS3wkmKTb6fQSnDXMjSnPAnUSbzzGg2qQivIJzItCHqXdLt26m1sU512Id+H5HTNjyFaba1YtOXN4FpSKc2SvPKNvrsYY3LxXYtjTBrcC4S69SFOOMzeFkbAlcsOM1oL4aa5W+pavGMk2tH/I9+LPwvOxcHNr9eqw+2/1EqcU4c2dRbgwpTUoYeOup6bhF45xS36CnDb2Eac+dbrEdOqM7hdVxn+nbP8AJHqKx7GEn1OqJ2CeMhIa/fQStuk/ha5foHtlhoJViVo7rzGbXoaD09C7fQ5brT0LyfgJT81zv5FZFv6OJE/6r/HMFpI41qcm9DaFIX9TCZiqLefc07/GMCWxQobl0yZ11NvZI0pRASpRRoGs+3pPOq+Q3OeEEn5oDOCD6BCs2y1Cis5eRrCXQ5Gccf7HhavCWNi0ovwORhnb71CqixL4bNB/LS8CF/hy/wCRDfVD5fP8YOQGLik1utQEUdfNc/QyRq8Fv5UKkJxxmDysmNGeoaNTGGPoPS/i38SzvOTnx+jOMeJncNpObjBfu0M6Ly3k1eGVpU5QlHeLyS6PI9FP8HTUctSwjy8IOFbk2w+u57if48nGHI0eKqVJVqrntl5JWqSPVWtbOE3pjU0ow0MCwg4y1PTW8M4EppAJU9C9pRzIanT00DWdDVGLT0YYSRJxDRp5RWdPALG5pXJ2JecMe2AaJ3xefi4Cq8Bci11PC8zBWbVWWLVIY+/mOuJk8Wr8iz4DS6FK3vEYwz3MSvxGW6mJX1w3Lu2Jy0RXjlK1pfnp1Gop9cFeI1qlN4cnt3+ZmQnJNSTxh5QzcudR871/jUt8E+lHxCb3k/crG6n3fuBnBFJPGhrwH017fic1j9TPQ2PEYzWOp4paYY3aV2nmL8CPXKnPT2/OQzPjvucE+T/RG5sVJN4MatZ8vc9hzKa0XoL1LPmWxadZU7NeO5EWUeyN+twzXb5A1YvdfQb7hfkhRg+xoUqbZoWXC5z6aeRq0uDvr9BOu4ecvN1LZ9S1lbYeeh6Orw5L/oTnJRythJ7Rvi8Kyi9O/obnD7rO+Dylat22GOGXTlJJPX/Y/wAaX6x7SMnPCXqadnaYL8KsVGKb6mryJLQ3zhOuinw32KypdcdBlplXB4eoOh5us25guws9gtzUbbQtF5IV0z8XQC5p5GEzjaFsNPWfKGmTy3H6jk3HxweqvP0nkbmDqVMLuU4hOnn61HlkhS9WWsdGbnFLR05a9jGu4bY7nTzMc9DtIZnBPbm18j6Lxi2t4WkHHl5m3nC8D5zQbW+4/Xv5ygot5XQtImzqu77C8JJhqjQFJao3XhoNCPM0jYtLF4TwIcPoZfsejsqWF7nN1VeQvgPt8yDnwyEz4FF65jp39sFpTn3D0qPfxGY26ezKUlJxm3/kkHp0/tDkeHvv8jTt+HZXT2FLKQoTklgfhOWNma1rYKP7cmlG1i1jl+QvUPOnkLl56Mwrqg5N+Z9Dr8H5uyKU+AR3f0DzAvT5zbcNm3jDedUem/Dv4ZnzqU1hLsews+EQh0Tfc1qUIxL8pdVSlbqMfQHPpnYbrT007dBNh6LFXgWqyzoMTkhPXmZGqcsivHV5KRgN3MFlvxF+U57PXZz+JzHDkkdi8MzMnjM2keWp3TpVOd7f2ew43TzB4R4qqsycX6D89Yn0f4p/+0VNdhngfCqNanOFRqEox5llYMKnXnCXL+3xKzqzbym4rPTqW57S6mse8p8s5Rj/AIptJ+JSFObX+LfbQ9ZUtrZ0oTk2puaU1laRz28j0MLrhkEuV5xjGev9lp2neXzuHBK0487holn0EZUcvD7n0Tiv4pc4OjRow5Jac2NUjztHhqbbe5Pro05c4VbJJGtGGF0FVUhBC9W/0eOxK7T8zGhz+RDJ/OPsQXKpsbib21Y3aWmXroEt7TXZm7YcNT3K1C3A7fhsmsp507GvaWLxqM29pyr9PzGlobC/UVp0Mf0GSBqTCZBjfXjrQVR+/AHEJz4DAuixwitJd31KRqr5lJ10kynJRZVdRdyyKxucvRDEHlDVgZas5UjhvH1D8jzkDXaI9c4fm+sy4e/3uJ84xcyxlCaZz9Ovn8Gi/p9dSTRSMiasBqleClHB4m/sXCbfjlHuc6GbxCx51nqNITHllaxe/uXhThDVtNY2e4zOm45T8tBK4paBlCyQO4VOWvNp2xv4AJUafTGPLU5Kl4AJRZWFyGIzhBbrPTQFO9k3hL16lVSJKODeBkijTe51Uo9mEjq9Qijk2l/qnw49mQtyrs//AGIDTPo9jabOSXublCmksIzrDl6Nm1SpJrTJbnnxz9X1bkytwfI8jCp+BdY7G+SqQprqE+GiSrIo6mdhLzTSpOKBy0RJso2LYM/Vef5MpVhzI64FOfCw/QM6w3zKHSpqCwaFu1LHYz3cp46jljUTy+xXSXkebSRlXE9Xj78hq8ul9TEua+dUS7qvHN0GvMXciSec6lG0czqi8JBYMBGeMhYPJmoikdOQjkIo9TbhcIXdnGS21yYtxY8udD1WANSin0B9eteXjJ0ALt8nprmxj2YjVtcbFfot5YcqXKLz8jXqWvcTqUtQ89anZSOvYLQfRoLhHJRxqgtItocO8zIYX0rhVBtrRnq7egkvJCHD3nDSWxp50OmRyb65JxQKbj1BV6mE0xCdx6g6uDD+Iv5HOSKEVeFleoX6GQSdNt7nYU0kCneoFK88fcU/MMSmthK6g2v9nHdanHVTEtUz1hOcoTw3o2btk2oZT36iF5BSWceJajdqMOVsPPTfId9WeTKlVbYW7uoyejQlKfiL1dW55HlMC6gtOul1CQnnr0J2K4N8R/8AQzbzE4xyF6abmzxq04VVtnULGRm20X1NGnHYSlEJgif1ORiLgaHWg2Z9el4Gq4AqlDQcK85e5MipHvuenu7cxbihqx5SVmprsRPwGZUHktTpvrgOlK6diGh8NeBDaPj7FbxSR2pUSEKF3zaLY5VqPx2Otx4lxPOcCc4M7OeMvPYXqXC7idUeY48bZAz8H1Bymu5yVUU2JOp4g/iMrldWSdSOyFtNyrKrqGp1BWUE9cnc4FtU+v8ADcpruZt3BZ3CuoL1p527im1i3kGtUCoVZNNDtzTyhSksBivNKzm8jdpJ9exScMss9sIGKXrwSd5h4j5Mct25b9xW1tdctmvRppAsL9DUYaIZggUWi+PESwujRXiX5QEZ4DQmH5LrqidcSc6Z3mNjWs67hJGJc53a8sI9XcRys46Hm7u4UZNNZQ0LWPVmvEDGo879MGl8Sm+xydrCW2Oo4Eub7yQe/Jw8CGwNewtLrlWhr0qvMsmZaWiWHN+hp068X+mPkdEc1K3MXqZ1SD6mvXjp3M25iDqGl8Z85lFJjDgBmuxOn5DnMpFo5OSELm/jBAxmmpo5zZMF8ZW2Hjv9oYpcSi9cmxpGo30ZVJCkbqL6llWBh5+r1YZM6tDGR2VRdRG+qJRNIbQYS1GYQbfoK2iystmnQSXsHBvQ9Cnjp2HI7C8JllNC2FnRjPiWiChPsdVQWwfofl+8l0mBhJfMNB5MGpFNB4plFELDsbG1yc8LU85xPDeq9j0V1S5o9uhgXEJw0ays9jNK89Whh6FYZ8dEzVrW8JPK0FpWso+QYNA5n3+ZAnw/P2IEMe255S1ei8xmhVXTbbIinzeCQSdVKOmiH56RxoSq6blZQTFrd/uYWM8vUrulDr0PoJVqLNZ4F68AXkdeeuk1kwrqi5aanqri338zKnQ1Yh5WG7fHvkvGmac6AGVMFppCsYtBY1X3LSgRQBpsSdRvdsDNZD8hxxYNEGOmiCfmGluW+GT4WgZQqkLuedx+jXkzPlba+xp29N4QwGaUwpWFHQMo6CWEtqQQamwcYhoQ2Bjbg1MPF4BU4FatTALBl9MTmsGLc18PHQZdfOgjcLm0YDyBuEZ/9AJ02s4fTqsoLGk4vKfQK9d+xjkMLsvd/wBkHPy8SGbxsTqJ6LZEguZ67IHCnnbr8gs5LGEUkc61WrphI7TqY3Fo9X7F5SwsPuHQw5CvkNLbUQhNJ6BI1c7jTrwLytWhnYQuKPgaKkKzg2CjyyJwBSgaM6W4vOAtxSUlyeCI6aDzgDaF0QXT8TihgNyk+Hr6A0YHGnkNCgi0IDdKDNP0KVhbjlCiGhR2Gfh4KllD5NCSii7aKSb7dReoDssHPiLIOUn26lGnuiZ5JhyNXoAm8vle5Si3sy1Sm3qt+gWkKShJdQnw84YxGHMtUXVMFpoWUC3wE/YbVMtCn1F0+M38uyGnr2IDQxSlvL/xFupCF3OJD/Fen1Jcf5EIBoFS3GIkIZqv39DkdjhBg5CqbsUq7kITv6eAyBMhAGji39Dq39DpAmFhsOUtiENP0tMUtw1QhChCkNy8d/VfUhAX8aB9I+b+hRbLy/khCZ/4i6ff7RyOy8zpA/wYFT3j5fwFe78o/VkILTOR6ev/ANMP09SEFOoQhDA//9k=",
 			"media_type": "Image",
@@ -127,6 +129,7 @@ func TestPatchQuestion(t *testing.T) {
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PATCH", "/api/question/18/0", bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, qwizCreator(t, 18)))
 
 	router.ServeHTTP(w, req)
 
@@ -140,17 +143,10 @@ func TestValidQuestionDelete(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса изменения пароля
-	passwordData := map[string]string{
-		"creator_password": "Password123!",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, _ := json.Marshal(passwordData)
-
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/api/question/20/0", bytes.NewBuffer(data))
+	req, _ := http.NewRequest("DELETE", "/api/question/20/0", nil)
 	req.Header.Set("Content-Type", "application/json") // Задаем заголовок Content-Type
+	req.Header.Set("Authorization", bearer(t, qwizCreator(t, 20)))
 
 	router.ServeHTTP(w, req)
 
@@ -159,3 +155,338 @@ func TestValidQuestionDelete(t *testing.T) {
 	assert.NotContains(t, w.Body.String(), "error")
 	defer tearDown()
 }
+
+// choices - варианты ответа с верными номерами correct (с 1).
+func choices(correct []int, bodies ...string) []question.Answer {
+	answers := make([]question.Answer, len(bodies))
+	for i, body := range bodies {
+		answers[i] = question.Answer{Position: int16(i), Body: body}
+		for _, c := range correct {
+			answers[i].Correct = answers[i].Correct || c == i+1
+		}
+	}
+	return answers
+}
+
+func TestQuestionTypes(t *testing.T) {
+	multiple := question.Question{Type: question.Multiple, Answers: choices([]int{1, 3}, "1", "2", "3")}
+	r, err := multiple.ParseResponse(json.RawMessage(`[3, 1]`))
+	assert.NoError(t, err)
+	assert.True(t, multiple.Grade(r))
+	r, _ = multiple.ParseResponse(json.RawMessage(`[1]`))
+	assert.False(t, multiple.Grade(r))
+	_, err = multiple.ParseResponse(json.RawMessage(`[1, 1]`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+	_, err = multiple.ParseResponse(json.RawMessage(`[4]`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+
+	trueFalse := question.Question{Type: question.TrueFalse, Answers: choices([]int{2}, "True", "False")}
+	r, err = trueFalse.ParseResponse(json.RawMessage(`false`))
+	assert.NoError(t, err)
+	assert.True(t, trueFalse.Grade(r))
+	r, _ = trueFalse.ParseResponse(json.RawMessage(`1`))
+	assert.False(t, trueFalse.Grade(r))
+
+	text := question.Question{Type: question.Text, AcceptedAnswers: []string{"New York", "NYC"}}
+	assert.Empty(t, text.AnswerBodies())
+	r, err = text.ParseResponse(json.RawMessage(`"  new   york "`))
+	assert.NoError(t, err)
+	assert.True(t, text.Grade(r))
+	r, _ = text.ParseResponse(json.RawMessage(`"Boston"`))
+	assert.False(t, text.Grade(r))
+	_, err = text.ParseResponse(json.RawMessage(`1`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+
+	answer := 0.3
+	numeric := question.Question{Type: question.Numeric, NumericAnswer: &answer, Tolerance: 0.05}
+	r, err = numeric.ParseResponse(json.RawMessage(`0.34`))
+	assert.NoError(t, err)
+	assert.True(t, numeric.Grade(r))
+	r, err = numeric.ParseResponse(json.RawMessage(`"0,3"`))
+	assert.NoError(t, err)
+	assert.True(t, numeric.Grade(r))
+	r, _ = numeric.ParseResponse(json.RawMessage(`0.4`))
+	assert.False(t, numeric.Grade(r))
+
+	// Вопросы без типа - single
+	single := question.Question{Answers: choices([]int{2}, "1", "2")}
+	r, err = single.ParseResponse(json.RawMessage(`2`))
+	assert.NoError(t, err)
+	assert.True(t, single.Grade(r))
+}
+
+// pairs собирает варианты matching: body и его пара по очереди.
+func pairs(values ...string) []question.Answer {
+	answers := make([]question.Answer, len(values)/2)
+	for i := range answers {
+		match := values[2*i+1]
+		answers[i] = question.Answer{Position: int16(i), Body: values[2*i], Match: &match}
+	}
+	return answers
+}
+
+func TestOrderingMatching(t *testing.T) {
+	// Верный порядок - b, c, a; показываются по алфавиту: a, b, c
+	ordering := question.Question{Type: question.Ordering, Answers: choices(nil, "b", "c", "a")}
+	assert.Equal(t, []uint8{2, 0, 1}, ordering.ListOrder())
+	r, err := ordering.ParseResponse(json.RawMessage(`[2, 3, 1]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 3}, r.Sequence)
+	assert.True(t, ordering.Grade(r))
+	r, _ = ordering.ParseResponse(json.RawMessage(`[2, 1, 3]`))
+	assert.InDelta(t, 1.0/3, ordering.Score(r), 1e-9)
+	assert.False(t, ordering.Grade(r))
+	r, _ = ordering.ParseResponse(json.RawMessage(`[1, 2, 3]`))
+	assert.Equal(t, 0.0, ordering.Score(r))
+	_, err = ordering.ParseResponse(json.RawMessage(`[1, 1, 2]`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+	_, err = ordering.ParseResponse(json.RawMessage(`[1, 2]`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+
+	// Пары показываются по алфавиту: meow, moo, woof
+	matching := question.Question{Type: question.Matching, Answers: pairs("cat", "meow", "dog", "woof", "cow", "moo")}
+	r, err = matching.ParseResponse(json.RawMessage(`[1, 3, 2]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, matching.Score(r))
+	r, _ = matching.ParseResponse(json.RawMessage(`[1, 2, 3]`))
+	assert.InDelta(t, 1.0/3, matching.Score(r), 1e-9)
+	_, err = matching.ParseResponse(json.RawMessage(`[1, 1, 2]`))
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+
+	// Остальные типы засчитываются целиком
+	single := question.Question{Answers: choices([]int{1}, "1", "2")}
+	r, _ = single.ParseResponse(json.RawMessage(`1`))
+	assert.Equal(t, 1.0, single.Score(r))
+}
+
+func TestShuffledLayout(t *testing.T) {
+	// Показаны в порядке 3, 1, 2: ответ 2 - исходный первый вариант
+	single := question.Question{Answers: choices([]int{1}, "1", "2", "3")}
+	layout := question.Layout{Answers: []uint8{2, 0, 1}}
+	r, err := single.ParseResponseIn(json.RawMessage(`2`), layout)
+	assert.NoError(t, err)
+	assert.True(t, single.Grade(r))
+	_, err = single.ParseResponseIn(json.RawMessage(`4`), layout)
+	assert.ErrorIs(t, err, question.ErrInvalidResponse)
+
+	// Верный порядок - 1, 2, 3, показан как 3, 1, 2
+	ordering := question.Question{Type: question.Ordering, Answers: choices(nil, "a", "b", "c")}
+	r, err = ordering.ParseResponseIn(json.RawMessage(`[2, 3, 1]`), layout)
+	assert.NoError(t, err)
+	assert.True(t, ordering.Grade(r))
+
+	// Один seed - один порядок; true_false не перемешивается
+	assert.Equal(t, single.ShuffledLayout(rand.New(rand.NewSource(1))), single.ShuffledLayout(rand.New(rand.NewSource(1))))
+	trueFalse := question.Question{Type: question.TrueFalse, Answers: choices([]int{1}, "True", "False")}
+	assert.Equal(t, []uint8{0, 1}, trueFalse.ShuffledLayout(rand.New(rand.NewSource(1))).Answers)
+	matching := question.Question{Type: question.Matching, Answers: pairs("a", "1", "b", "2", "c", "3")}
+	assert.ElementsMatch(t, []uint8{0, 1, 2}, matching.ShuffledLayout(rand.New(rand.NewSource(1))).Matches)
+}
+
+func TestSolution(t *testing.T) {
+	// Верный ответ нумеруется так же, как варианты в раскладке
+	multiple := question.Question{Type: question.Multiple, Answers: choices([]int{1, 3}, "1", "2", "3")}
+	assert.Equal(t, []int{1, 3}, multiple.SolutionIn(multiple.DefaultLayout()).Correct)
+	assert.Equal(t, []int{1, 2}, multiple.SolutionIn(question.Layout{Answers: []uint8{2, 0, 1}}).Correct)
+
+	// Решение ordering и matching - правильный ответ в формате ParseResponseIn
+	ordering := question.Question{Type: question.Ordering, Answers: choices(nil, "b", "c", "a")}
+	solution := ordering.SolutionIn(ordering.DefaultLayout())
+	assert.Equal(t, []int{2, 3, 1}, solution.Sequence)
+	raw, _ := json.Marshal(solution.Sequence)
+	r, err := ordering.ParseResponse(raw)
+	assert.NoError(t, err)
+	assert.True(t, ordering.Grade(r))
+
+	matching := question.Question{Type: question.Matching, Answers: pairs("cat", "meow", "dog", "woof", "cow", "moo")}
+	layout := matching.ShuffledLayout(rand.New(rand.NewSource(3)))
+	raw, _ = json.Marshal(matching.SolutionIn(layout).Sequence)
+	r, err = matching.ParseResponseIn(raw, layout)
+	assert.NoError(t, err)
+	assert.True(t, matching.Grade(r))
+
+	text := question.Question{Type: question.Text, AcceptedAnswers: []string{"NYC"}}
+	assert.Equal(t, []string{"NYC"}, text.SolutionIn(text.DefaultLayout()).AcceptedAnswers)
+}
+
+func TestCreateTypedQuestions(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	createQwizData := map[string]interface{}{
+		"qwiz": map[string]interface{}{"name": "typed quiz", "public": true},
+		"questions": []map[string]interface{}{
+			{"type": "multiple", "body": "Even?", "answer1": "2", "answer2": "3", "answer3": "4", "correct_answers": []int{3, 1}},
+			{"type": "true_false", "body": "1 > 2?", "correct": 2},
+			{"type": "text", "body": "Capital of France?", "accepted_answers": []string{"Paris"}},
+			{"type": "numeric", "body": "Pi?", "numeric_answer": 3.14159, "tolerance": 0.01},
+		},
+	}
+	data, _ := json.Marshal(createQwizData)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/qwiz", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	location := w.Header().Get("Location")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", strings.Replace(location, "/qwiz/", "/question/", 1)+"/1", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"type":"true_false"`)
+	assert.Contains(t, w.Body.String(), `"answer1":"True"`)
+
+	solve := func(answers string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", location+"/solve", strings.NewReader(`{"answers":`+answers+`}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w = solve(`[[1, 3], false, " paris ", 3.14]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"results":[true,true,true,true]`)
+
+	w = solve(`[[1], true, "London", 3]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"results":[false,false,false,false]`)
+
+	w = solve(`[1, false, "Paris", 3.14]`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Для multiple нужен хотя бы один верный вариант
+	invalid := map[string]interface{}{
+		"question": map[string]interface{}{"type": "multiple", "body": "?", "answer1": "a", "answer2": "b", "correct_answers": []int{}},
+	}
+	data, _ = json.Marshal(invalid)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", strings.Replace(location, "/qwiz/", "/question/", 1), bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestQuestionAnswers(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	send := func(method, url string, body interface{}) *httptest.ResponseRecorder {
+		data, _ := json.Marshal(body)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewBuffer(data))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", bearer(t, 13))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := send("POST", "/api/qwiz", map[string]interface{}{
+		"qwiz": map[string]interface{}{"name": "many answers", "public": true},
+		"questions": []map[string]interface{}{
+			{"type": "multiple", "body": "Primes?", "answers": []map[string]interface{}{
+				{"body": "2", "correct": true}, {"body": "4"}, {"body": "5", "correct": true}, {"body": "6"}, {"body": "7", "correct": true},
+			}},
+			{"body": "Old style", "answer1": "a", "answer2": "b", "correct": 2},
+		},
+	})
+	assert.Equal(t, http.StatusCreated, w.Code)
+	location := w.Header().Get("Location")
+	questionURL := strings.Replace(location, "/qwiz/", "/question/", 1)
+
+	var got question.GetQuestionData
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", questionURL+"/0", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Len(t, got.Answers, 5)
+	assert.Equal(t, "7", got.Answers[4].Body)
+	assert.Equal(t, "2", got.Answer1)
+	assert.Equal(t, "6", *got.Answer4)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", location+"/solve", strings.NewReader(`{"answers":[[5, 1, 3], 2]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Contains(t, w.Body.String(), `"results":[true,true]`)
+
+	// Замена вариантов и правка устаревшим new_answers
+	w = send("PATCH", questionURL+"/1", map[string]interface{}{
+		"replace_answers": []map[string]interface{}{{"body": "x"}, {"body": "y", "correct": true}, {"body": "z"}},
+		"new_answers":     []map[string]interface{}{{"index": 1, "content": nil}},
+	})
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", questionURL+"/1", nil)
+	router.ServeHTTP(w, req)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	if assert.Len(t, got.Answers, 2) {
+		assert.Equal(t, "y", got.Answers[0].Body)
+	}
+
+	// Без верного варианта single не сохраняется
+	w = send("PATCH", questionURL+"/1", map[string]interface{}{
+		"replace_answers": []map[string]interface{}{{"body": "x"}, {"body": "y"}},
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSolveOrderingMatching(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"qwiz": map[string]interface{}{"name": "history", "public": true},
+		"questions": []map[string]interface{}{
+			{"type": "ordering", "body": "Oldest first", "answers": []map[string]interface{}{
+				{"body": "Rome"}, {"body": "Byzantium"}, {"body": "Austria"},
+			}},
+			{"type": "matching", "body": "Capitals", "answers": []map[string]interface{}{
+				{"body": "France", "match": "Paris"}, {"body": "Italy", "match": "Rome"},
+			}},
+		},
+	})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/qwiz", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	location := w.Header().Get("Location")
+
+	var got question.GetQuestionData
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", strings.Replace(location, "/qwiz/", "/question/", 1)+"/0", nil)
+	router.ServeHTTP(w, req)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	if assert.Len(t, got.Answers, 3) {
+		assert.Equal(t, "Austria", got.Answers[0].Body)
+	}
+
+	// Rome, Byzantium, Austria - 3, 2, 1 по алфавиту; во втором вопросе пары перепутаны
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", location+"/solve", strings.NewReader(`{"answers":[[3, 2, 1], [2, 1]]}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"results":[true,false]`)
+	assert.Contains(t, w.Body.String(), `"scores":[1,0]`)
+
+	// У matching пара обязательна
+	data, _ = json.Marshal(map[string]interface{}{"question": map[string]interface{}{
+		"type": "matching", "body": "?", "answers": []map[string]interface{}{{"body": "a"}, {"body": "b"}},
+	}})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", strings.Replace(location, "/qwiz/", "/question/", 1), bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"api/account"
+	"api/crypto"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTwoFactorLogin(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	username := fmt.Sprintf("totp_%d", time.Now().UnixNano()%1e12)
+	teacher, err := account.New(username, "Password123!", account.Teacher, nil)
+	if err != nil {
+		t.Fatalf("Failed to create teacher account: %v", err)
+	}
+	defer teacher.Delete()
+	auth := bearer(t, teacher.ID)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/account/2fa", nil)
+	req.Header.Set("Authorization", auth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var enrollment struct {
+		Secret     string `json:"secret"`
+		OtpauthURI string `json:"otpauth_uri"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &enrollment))
+	assert.Contains(t, enrollment.OtpauthURI, "otpauth://totp/")
+
+	code, _ := crypto.TOTPCode(enrollment.Secret, time.Now())
+	data, _ := json.Marshal(map[string]string{"code": code})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/account/2fa/confirm", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", auth)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var confirmation struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &confirmation))
+	assert.Len(t, confirmation.RecoveryCodes, 10)
+
+	// Без второго фактора токены не выдаются
+	w = postJSON(router, "/api/account/login", map[string]string{"username": username, "password": "Password123!"})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), `"two_factor_required":true`)
+
+	w = postJSON(router, "/api/account/verify", map[string]string{"username": username, "password": "Password123!"})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	recovery := confirmation.RecoveryCodes[0]
+	w = postJSON(router, "/api/account/login", map[string]string{"username": username, "password": "Password123!", "code": recovery})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Код восстановления одноразовый
+	w = postJSON(router, "/api/account/login", map[string]string{"username": username, "password": "Password123!", "code": recovery})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestStudentCannotEnrollTwoFactor(t *testing.T) {
+	setup()
+	router := setupRouter()
+	defer tearDown()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/account/2fa", nil)
+	req.Header.Set("Authorization", bearer(t, 13))
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -1,8 +1,6 @@
 package tests
 
 import (
-	"bytes"
-	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -48,25 +46,14 @@ func TestAddVote(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса создания викторины
-	createQwizData := map[string]interface{}{
-		"voter_id":       "13",
-		"voter_password": "Password123!",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, err := json.Marshal(createQwizData)
-	if err != nil {
-		t.Fatalf("Failed to marshal create qwiz data: %v", err)
-	}
-
 	// Выполнение запроса POST
 	w := httptest.NewRecorder()
-	req, err := http.NewRequest("POST", "/api/vote/20", bytes.NewBuffer(data))
+	req, err := http.NewRequest("POST", "/api/vote/20", nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
@@ -82,25 +69,14 @@ func TestDeleteVote(t *testing.T) {
 	setup()
 	router := setupRouter()
 
-	// Структура данных для запроса создания викторины
-	createQwizData := map[string]interface{}{
-		"voter_id":       "13",
-		"voter_password": "Password123!",
-	}
-
-	// Преобразование структуры данных в JSON
-	data, err := json.Marshal(createQwizData)
-	if err != nil {
-		t.Fatalf("Failed to marshal create qwiz data: %v", err)
-	}
-
 	// Выполнение запроса POST
 	w := httptest.NewRecorder()
-	req, err := http.NewRequest("DELETE", "/api/vote/20", bytes.NewBuffer(data))
+	req, err := http.NewRequest("DELETE", "/api/vote/20", nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", bearer(t, 13))
 
 	// Отправка запроса через маршрутизатор
 	router.ServeHTTP(w, req)
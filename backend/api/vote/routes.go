@@ -20,13 +20,11 @@ func voteInfo(c *gin.Context) {
 	c.String(http.StatusOK, `
 GET /vote/<qwiz_id> - get list of voter ids by qwiz id
 
-PUT /vote/<qwiz_id> - vote for a qwiz
-voter_id: i32 - required
-voter_password: String - required
+POST /vote/<qwiz_id> - vote for a qwiz
+Authorization: Bearer <access_token> - required
 
 DELETE /vote/<qwiz_id> - delete vote
-voter_id: i32 - required
-voter_password: String - required
+Authorization: Bearer <access_token> - required
 `)
 }
 
@@ -60,47 +58,17 @@ func getVotesHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, fromVotes(votes))
 }
 
-// PutVoteData structure to bind the PUT request body
-type PutVoteData struct {
-	VoterID       string `json:"voter_id"`
-	VoterPassword string `json:"voter_password"`
-}
-
-// addVoteHandler handles the PUT request to add a vote
+// addVoteHandler handles the POST request to add a vote
 func addVoteHandler(c *gin.Context) {
-	var data PutVoteData
-	if err := c.BindJSON(&data); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
-		return
-	}
-
-	voterIDInt, err := strconv.ParseInt(data.VoterID, 10, 32)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Voter ID"})
-		return
-	}
-
 	qwizID, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Qwiz ID"})
 		return
 	}
 
-	acct, err := account.GetByID(int32(voterIDInt))
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	if ok, err := acct.VerifyPassword(data.VoterPassword); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	} else if !ok {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
+	voterID := account.Current(c).ID
 
-	exists, err := Exists(int32(voterIDInt), int32(qwizID))
+	exists, err := Exists(voterID, int32(qwizID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
 		return
@@ -111,8 +79,8 @@ func addVoteHandler(c *gin.Context) {
 		return
 	}
 
-	// This will handle inserting a new vote, assuming NewVoteData is equivalent to PutVoteData
-	_, err = FromVoteData(NewVoteData{VoterID: int32(voterIDInt), QwizID: int32(qwizID)})
+	// This will handle inserting a new vote
+	_, err = FromVoteData(NewVoteData{VoterID: voterID, QwizID: int32(qwizID)})
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrQwizNotFound):
@@ -128,47 +96,17 @@ func addVoteHandler(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
-// DeleteVoteData structure to bind the DELETE request body
-type DeleteVoteData struct {
-	VoterID       string `json:"voter_id"`
-	VoterPassword string `json:"voter_password"`
-}
-
 // deleteVoteHandler handles the DELETE request to remove a vote
 func deleteVoteHandler(c *gin.Context) {
-	var data DeleteVoteData
-	if err := c.BindJSON(&data); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
-		return
-	}
-
 	qwizID, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Qwiz ID"})
 		return
 	}
 
-	voterIDInt, err := strconv.ParseInt(data.VoterID, 10, 32)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Voter ID"})
-		return
-	}
-
-	acct, err := account.GetByID(int32(voterIDInt))
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	if ok, err := acct.VerifyPassword(data.VoterPassword); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
-		return
-	} else if !ok {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
+	voterID := account.Current(c).ID
 
-	vote, err := GetByVoterIDQwizID(int32(voterIDInt), int32(qwizID))
+	vote, err := GetByVoterIDQwizID(voterID, int32(qwizID))
 	if err != nil {
 		if errors.Is(err, ErrQwizNotFound) {
 			c.Status(http.StatusNotFound)
@@ -197,7 +135,7 @@ func RegisterRoutes(r *gin.Engine) {
 	{
 		voteGroup.GET("", voteInfo)
 		voteGroup.GET("/:id", getVotesHandler)
-		voteGroup.POST("/:id", addVoteHandler)
-		voteGroup.DELETE("/:id", deleteVoteHandler)
+		voteGroup.POST("/:id", account.RequireAuth(), addVoteHandler)
+		voteGroup.DELETE("/:id", account.RequireAuth(), deleteVoteHandler)
 	}
 }